@@ -0,0 +1,208 @@
+package vk_sdk
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AttachmentType is type of media attachment.
+type AttachmentType string
+
+const (
+	AttachmentPhoto         AttachmentType = "photo"
+	AttachmentVideo         AttachmentType = "video"
+	AttachmentAudio         AttachmentType = "audio"
+	AttachmentDoc           AttachmentType = "doc"
+	AttachmentWall          AttachmentType = "wall"
+	AttachmentMarket        AttachmentType = "market"
+	AttachmentMarketAlbum   AttachmentType = "market_album"
+	AttachmentPoll          AttachmentType = "poll"
+	AttachmentAudioPlaylist AttachmentType = "audio_playlist"
+)
+
+// Attachment is reference to media object in format {type}{owner_id}_{media_id}[_{access_key}]
+// that is used by Messages_Send_Request.Attachment, Wall_Post_Request.Attachments and others.
+//
+//    attachments := vk_sdk.Attachments{
+//        vk_sdk.PhotoAttachment(photo),
+//        vk_sdk.DocAttachment(doc),
+//    }.String()
+//
+//    req := vk_sdk.Messages_Send_Request{Attachment: &attachments}
+type Attachment struct {
+	Type      AttachmentType
+	OwnerID   int
+	ID        int
+	AccessKey string
+}
+
+// String returns attachment in API format.
+func (a Attachment) String() string {
+	s := string(a.Type) + strconv.Itoa(a.OwnerID) + "_" + strconv.Itoa(a.ID)
+
+	if a.AccessKey != "" {
+		s += "_" + a.AccessKey
+	}
+
+	return s
+}
+
+// ParseAttachment parses attachment from API format.
+func ParseAttachment(s string) (a Attachment, err error) {
+	i := strings.IndexAny(s, "-0123456789")
+
+	if i <= 0 {
+		return a, fmt.Errorf("attachment %q: no type", s)
+	}
+
+	a.Type = AttachmentType(s[:i])
+
+	parts := strings.SplitN(s[i:], "_", 3)
+
+	if len(parts) < 2 {
+		return a, fmt.Errorf("attachment %q: no media id", s)
+	}
+
+	if a.OwnerID, err = strconv.Atoi(parts[0]); err != nil {
+		return a, fmt.Errorf("attachment %q: owner id: %w", s, err)
+	}
+
+	if a.ID, err = strconv.Atoi(parts[1]); err != nil {
+		return a, fmt.Errorf("attachment %q: media id: %w", s, err)
+	}
+
+	if len(parts) == 3 {
+		a.AccessKey = parts[2]
+	}
+
+	return a, nil
+}
+
+// Attachments is list of attachments.
+type Attachments []Attachment
+
+// String returns comma-separated attachments in API format.
+func (as Attachments) String() string {
+	ss := make([]string, len(as))
+
+	for i, a := range as {
+		ss[i] = a.String()
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// ParseAttachments parses comma-separated attachments from API format.
+func ParseAttachments(s string) (Attachments, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	ss := strings.Split(s, ",")
+	as := make(Attachments, 0, len(ss))
+
+	for _, attachment := range ss {
+		a, err := ParseAttachment(strings.TrimSpace(attachment))
+
+		if err != nil {
+			return nil, err
+		}
+
+		as = append(as, a)
+	}
+
+	return as, nil
+}
+
+// newAttachment create and return new Attachment with optional access key.
+func newAttachment(t AttachmentType, ownerID, id int, accessKey *string) Attachment {
+	a := Attachment{
+		Type:    t,
+		OwnerID: ownerID,
+		ID:      id,
+	}
+
+	if accessKey != nil {
+		a.AccessKey = *accessKey
+	}
+
+	return a
+}
+
+// derefInt returns value of optional int or zero.
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+// PhotoAttachment returns attachment of the photo.
+func PhotoAttachment(p Photos_Photo) Attachment {
+	return newAttachment(AttachmentPhoto, p.OwnerId, p.Id, p.AccessKey)
+}
+
+// DocAttachment returns attachment of the document.
+func DocAttachment(d Docs_Doc) Attachment {
+	return newAttachment(AttachmentDoc, d.OwnerId, d.Id, d.AccessKey)
+}
+
+// VideoAttachment returns attachment of the video.
+func VideoAttachment(v Video_VideoFull) Attachment {
+	return newAttachment(AttachmentVideo, derefInt(v.OwnerId), derefInt(v.Id), v.AccessKey)
+}
+
+// WallAttachment returns attachment of the wall post.
+func WallAttachment(w Wall_WallpostFull) Attachment {
+	return newAttachment(AttachmentWall, derefInt(w.OwnerId), derefInt(w.Id), w.AccessKey)
+}
+
+// MarketAttachment returns attachment of the market item.
+func MarketAttachment(m Market_MarketItem) Attachment {
+	return newAttachment(AttachmentMarket, m.OwnerId, m.Id, m.AccessKey)
+}
+
+// AudioAttachment returns attachment of the audio.
+func AudioAttachment(a Audio_Audio) Attachment {
+	return newAttachment(AttachmentAudio, a.OwnerId, a.Id, a.AccessKey)
+}
+
+// PollAttachment returns attachment of the poll.
+func PollAttachment(p Polls_Poll) Attachment {
+	return newAttachment(AttachmentPoll, p.OwnerId, p.Id, nil)
+}
+
+// PhotosAttachments returns attachments of saved photos,
+// e.g. from Photos_SaveMessagesPhoto_Response or Photos_SaveWallPhoto_Response.
+func PhotosAttachments(photos []Photos_Photo) Attachments {
+	as := make(Attachments, len(photos))
+
+	for i, p := range photos {
+		as[i] = PhotoAttachment(p)
+	}
+
+	return as
+}
+
+// SavedDocAttachment returns attachment of document, audio message or graffiti saved by VK.Docs_Save.
+func SavedDocAttachment(resp Docs_Save_Response) (Attachment, error) {
+	switch r := resp.Response; {
+	case r.Doc != nil:
+		return DocAttachment(*r.Doc), nil
+	case r.AudioMessage != nil:
+		return newAttachment(AttachmentDoc, r.AudioMessage.OwnerId, r.AudioMessage.Id, r.AudioMessage.AccessKey), nil
+	case r.Graffiti != nil:
+		return newAttachment(AttachmentDoc, r.Graffiti.OwnerId, r.Graffiti.Id, r.Graffiti.AccessKey), nil
+	default:
+		return Attachment{}, errors.New("docs.save returned no document")
+	}
+}
+
+// SavedVideoAttachment returns attachment of video created by VK.Video_Save.
+func SavedVideoAttachment(resp Video_Save_Response) Attachment {
+	r := resp.Response
+	return newAttachment(AttachmentVideo, derefInt(r.OwnerId), derefInt(r.VideoId), r.AccessKey)
+}
@@ -0,0 +1,31 @@
+package vk_sdk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAttachments(t *testing.T) {
+	accessKey := "abc_def"
+	videoOwnerID, videoID := -1, 2
+
+	as := Attachments{
+		PhotoAttachment(Photos_Photo{OwnerId: 1, Id: 2, AccessKey: &accessKey}),
+		VideoAttachment(Video_VideoFull{Video_Video: Video_Video{OwnerId: &videoOwnerID, Id: &videoID}}),
+		PollAttachment(Polls_Poll{OwnerId: 3, Id: 4}),
+		{Type: AttachmentAudioPlaylist, OwnerID: -5, ID: 6},
+	}
+
+	s := as.String()
+	assert.Equal(t, "photo1_2_abc_def,video-1_2,poll3_4,audio_playlist-5_6", s)
+
+	parsed, err := ParseAttachments(s)
+	require.NoError(t, err)
+	assert.Equal(t, as, parsed)
+
+	for _, invalid := range []string{"1_2", "photo", "photo1", "photo1_a"} {
+		_, err = ParseAttachment(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
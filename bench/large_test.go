@@ -0,0 +1,210 @@
+// go test -bench=Benchmark_Large -benchmem
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	severeCloud "github.com/SevereCloud/vksdk/v2/api"
+	"github.com/SevereCloud/vksdk/v2/api/params"
+	"github.com/elias506/vk-sdk"
+	goVKAPI "github.com/go-vk-api/vk"
+	"github.com/mailru/easyjson"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+)
+
+const largeUsersCount = 1000
+
+func largeUsersResponse() []byte {
+	resp := vk_sdk.Users_Get_Response{
+		Response: make([]vk_sdk.Users_UserFull, largeUsersCount),
+	}
+
+	for i := range resp.Response {
+		name := "Вася"
+		lastName := "Васильев"
+		nickname := "vasya" + strconv.Itoa(i)
+		about := "Немного о себе, чтобы ответ был похож на настоящий"
+		music := "Кино, Ария, Сплин"
+		sex := vk_sdk.Base_Sex_Male
+		online := vk_sdk.Base_BoolInt_Yes
+		hasMobile := vk_sdk.Base_BoolInt_No
+
+		user := &resp.Response[i]
+		user.Id = i + 1
+		user.FirstName = &name
+		user.LastName = &lastName
+		user.Nickname = &nickname
+		user.About = &about
+		user.Music = &music
+		user.Sex = &sex
+		user.Online = &online
+		user.HasMobile = &hasMobile
+		user.City = &vk_sdk.Base_City{Id: 1, Title: "Москва"}
+		user.Country = &vk_sdk.Base_Country{Id: 1, Title: "Россия"}
+	}
+
+	j, _ := json.Marshal(resp)
+
+	return j
+}
+
+func NewBenchClientLarge() *http.Client {
+	j := largeUsersResponse()
+
+	h := http.Header{
+		"Content-Type": []string{"application/json"},
+	}
+
+	t := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     h,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(j)),
+		}, nil
+	})
+
+	return &http.Client{Transport: t}
+}
+
+func Benchmark_Large_VK_SDK(b *testing.B) {
+	vk := vk_sdk.NewVK(NewBenchClientLarge(), token)
+
+	for n := 0; n < b.N; n++ {
+		ctx := context.Background()
+		req := vk_sdk.Users_Get_Request{
+			UserIds: &[]string{"elias506"},
+			Fields: &[]vk_sdk.Users_Fields{
+				vk_sdk.Users_Fields_Sex,
+				vk_sdk.Users_Fields_City,
+				vk_sdk.Users_Fields_Country,
+				vk_sdk.Users_Fields_Online,
+				vk_sdk.Users_Fields_HasMobile,
+				vk_sdk.Users_Fields_Nickname,
+				vk_sdk.Users_Fields_Music,
+				vk_sdk.Users_Fields_About,
+			},
+		}
+
+		resp, apiErr, err := vk.Users_Get(ctx, req)
+
+		if err != nil {
+			panic(err)
+		}
+
+		if apiErr != nil {
+			panic("")
+		}
+
+		if len(resp.Response) != largeUsersCount {
+			panic("")
+		}
+	}
+}
+
+func Benchmark_Large_SevereCloud(b *testing.B) {
+	vk2 := severeCloud.NewVK(token)
+	vk2.Client = NewBenchClientLarge()
+	vk2.Limit = 0
+
+	for n := 0; n < b.N; n++ {
+		builder := params.NewUsersGetBuilder()
+
+		builder.UserIDs([]string{"elias506"})
+		builder.Fields([]string{"sex", "city", "country", "online", "has_mobile", "nickname", "music", "about"})
+
+		resp, err := vk2.UsersGet(builder.Params)
+
+		if err != nil {
+			panic(err.Error())
+		}
+
+		if len(resp) != largeUsersCount {
+			panic("")
+		}
+	}
+}
+
+// goVKAPIUser is user of users.get response with requested fields.
+// go-vk-api/vk has no types of objects, so they are declared by its users.
+type goVKAPIUser struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Nickname  string `json:"nickname"`
+	About     string `json:"about"`
+	Music     string `json:"music"`
+	Sex       int    `json:"sex"`
+	Online    int    `json:"online"`
+	HasMobile int    `json:"has_mobile"`
+	City      struct {
+		ID    int    `json:"id"`
+		Title string `json:"title"`
+	} `json:"city"`
+	Country struct {
+		ID    int    `json:"id"`
+		Title string `json:"title"`
+	} `json:"country"`
+}
+
+func Benchmark_Large_goVKAPI(b *testing.B) {
+	vk, err := goVKAPI.NewClientWithOptions(
+		goVKAPI.WithToken(os.Getenv(token)),
+		goVKAPI.WithHTTPClient(NewBenchClientLarge()))
+
+	if err != nil {
+		panic(err.Error())
+	}
+
+	for n := 0; n < b.N; n++ {
+		var resp []goVKAPIUser
+
+		err = vk.CallMethod("users.get", goVKAPI.RequestParams{
+			"user_id": []string{"elias506"},
+			"fields":  []string{"sex", "city", "country", "online", "has_mobile", "nickname", "music", "about"},
+		}, &resp)
+
+		if err != nil {
+			panic(err.Error())
+		}
+
+		if len(resp) != largeUsersCount {
+			panic("")
+		}
+	}
+}
+
+// Benchmark_Large_Decode_JSON decodes response by encoding/json
+// that validates the whole input before calling generated unmarshaler.
+func Benchmark_Large_Decode_JSON(b *testing.B) {
+	j := largeUsersResponse()
+
+	b.SetBytes(int64(len(j)))
+
+	for n := 0; n < b.N; n++ {
+		var resp vk_sdk.Users_Get_Response
+
+		if err := json.Unmarshal(j, &resp); err != nil {
+			panic(err.Error())
+		}
+	}
+}
+
+// Benchmark_Large_Decode_EasyJSON decodes response by generated easyjson unmarshaler as VK does.
+func Benchmark_Large_Decode_EasyJSON(b *testing.B) {
+	j := largeUsersResponse()
+
+	b.SetBytes(int64(len(j)))
+
+	for n := 0; n < b.N; n++ {
+		var resp vk_sdk.Users_Get_Response
+
+		if err := easyjson.Unmarshal(j, &resp); err != nil {
+			panic(err.Error())
+		}
+	}
+}
@@ -127,7 +127,7 @@ func Benchmark_Medium_goVKAPI(b *testing.B) {
 	}
 
 	for n := 0; n < b.N; n++ {
-		var resp []vk_sdk.Users_UserFull
+		var resp []goVKAPIUser
 
 		err = vk.CallMethod("users.get", goVKAPI.RequestParams{
 			"user_id":   []string{"elias506"},
@@ -139,7 +139,7 @@ func Benchmark_Medium_goVKAPI(b *testing.B) {
 			panic(err.Error())
 		}
 
-		if resp[0].FirstName != nil && *resp[0].FirstName != "Вася" {
+		if resp[0].FirstName != "Вася" {
 			panic("")
 		}
 	}
@@ -0,0 +1,369 @@
+package vk_sdk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+)
+
+// DefaultBulkConcurrency is default maximum number of concurrent requests of bulk methods.
+const DefaultBulkConcurrency = 3
+
+// Maximum numbers of IDs in a single request of bulk methods.
+const (
+	maxUsersGetIDs        = 1000
+	maxGroupsGetByIdIDs   = 500
+	maxWallGetByIdIDs     = 100
+	maxPhotosGetByIdIDs   = 500
+	maxVideoGetIDs        = 200
+	maxMessagesGetByIdIDs = 100
+)
+
+// BulkOptions configures bulk methods that split long ID list into chunks.
+type BulkOptions struct {
+	// Concurrency is maximum number of concurrent requests, DefaultBulkConcurrency if not set.
+	Concurrency int
+	// Execute sends up to MaxExecuteCalls chunks within a single execute request.
+	Execute bool
+}
+
+// ChunkError is error of chunk of bulk method.
+// Items of failed chunks are skipped, items of other chunks are returned.
+type ChunkError struct {
+	// From and To are bounds of chunk IDs in the input list, To is exclusive.
+	From, To int
+	ApiErr   ApiError
+	Err      error
+}
+
+func (e ChunkError) Error() string {
+	if e.ApiErr != nil {
+		return fmt.Sprintf("chunk [%d:%d]: API error %d: %s", e.From, e.To, e.ApiErr.Code(), e.ApiErr.Msg())
+	}
+
+	return fmt.Sprintf("chunk [%d:%d]: %s", e.From, e.To, e.Err)
+}
+
+func (e ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// bulkCall is method call of one chunk.
+type bulkCall struct {
+	method  string
+	req     request
+	dst     interface{}
+	options []Option
+}
+
+type bulkChunk struct {
+	from, to int
+	call     bulkCall
+}
+
+// bulk splits n IDs into chunks of chunkSize, sends call of every chunk
+// and returns errors of failed chunks in input order.
+// Call is invoked for chunks in order before any request is sent,
+// so callers preallocate destinations with capacity of chunks number.
+func (vk *VK) bulk(ctx context.Context, n, chunkSize int, opts BulkOptions, call func(from, to int) bulkCall) []ChunkError {
+	chunks := make([]bulkChunk, 0, (n+chunkSize-1)/chunkSize)
+
+	for from := 0; from < n; from += chunkSize {
+		to := from + chunkSize
+		if to > n {
+			to = n
+		}
+
+		chunks = append(chunks, bulkChunk{
+			from: from,
+			to:   to,
+			call: call(from, to),
+		})
+	}
+
+	groupSize := 1
+	if opts.Execute {
+		groupSize = MaxExecuteCalls
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []ChunkError
+		sem  = make(chan struct{}, concurrency)
+	)
+
+	addErr := func(chunk bulkChunk, apiErr ApiError, err error) {
+		mu.Lock()
+		errs = append(errs, ChunkError{From: chunk.from, To: chunk.to, ApiErr: apiErr, Err: err})
+		mu.Unlock()
+	}
+
+	for i := 0; i < len(chunks); i += groupSize {
+		end := i + groupSize
+		if end > len(chunks) {
+			end = len(chunks)
+		}
+
+		group := chunks[i:end]
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for _, chunk := range group {
+				addErr(chunk, nil, ctx.Err())
+			}
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if opts.Execute {
+				vk.bulkExecute(ctx, group, addErr)
+				return
+			}
+
+			if apiErr, err := vk.bulkSend(ctx, group[0].call); apiErr != nil || err != nil {
+				addErr(group[0], apiErr, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].From < errs[j].From
+	})
+
+	return errs
+}
+
+// bulkSend sends call of one chunk as a method request.
+func (vk *VK) bulkSend(ctx context.Context, call bulkCall) (ApiError, error) {
+	values := make(url.Values, len(call.options)+2)
+
+	if err := call.req.fillIn(values); err != nil {
+		return nil, err
+	}
+
+	setOptions(values, call.options)
+
+	return vk.doReq(call.method, ctx, values, call.dst)
+}
+
+// bulkExecute sends calls of chunks within a single execute request.
+func (vk *VK) bulkExecute(ctx context.Context, chunks []bulkChunk, addErr func(chunk bulkChunk, apiErr ApiError, err error)) {
+	batch := NewExecuteBatch()
+	calls := make([]*ExecuteCall, len(chunks))
+
+	for i, chunk := range chunks {
+		call, err := batch.Add(chunk.call.method, chunk.call.req, chunk.call.dst, chunk.call.options...)
+
+		if err != nil {
+			addErr(chunk, nil, err)
+			continue
+		}
+
+		calls[i] = call
+	}
+
+	if batch.Len() == 0 {
+		return
+	}
+
+	apiErr, err := vk.ExecuteBatch(ctx, batch)
+
+	for i, call := range calls {
+		switch {
+		case call == nil:
+		case apiErr != nil || err != nil:
+			addErr(chunks[i], apiErr, err)
+		case call.ApiError() != nil:
+			addErr(chunks[i], call.ApiError(), nil)
+		}
+	}
+}
+
+// Users_GetBulk returns users of any number of req.UserIds in input order.
+// IDs are split into chunks of 1000 that are requested by VK.Users_Get.
+func (vk *VK) Users_GetBulk(ctx context.Context, req Users_Get_Request, opts BulkOptions, options ...Option) ([]Users_UserFull, []ChunkError) {
+	var ids []string
+	if req.UserIds != nil {
+		ids = *req.UserIds
+	}
+
+	resps := make([]Users_Get_Response, 0, (len(ids)+maxUsersGetIDs-1)/maxUsersGetIDs)
+
+	errs := vk.bulk(ctx, len(ids), maxUsersGetIDs, opts, func(from, to int) bulkCall {
+		chunk := ids[from:to]
+		r := req
+		r.UserIds = &chunk
+		resps = append(resps, Users_Get_Response{})
+
+		return bulkCall{method: "users.get", req: r, dst: &resps[len(resps)-1], options: options}
+	})
+
+	var items []Users_UserFull
+	for _, resp := range resps {
+		items = append(items, resp.Response...)
+	}
+
+	return items, errs
+}
+
+// Groups_GetByIdBulk returns communities of any number of req.GroupIds in input order.
+// IDs are split into chunks of 500 that are requested by VK.Groups_GetById.
+func (vk *VK) Groups_GetByIdBulk(ctx context.Context, req Groups_GetById_Request, opts BulkOptions, options ...Option) ([]Groups_GroupFull, []ChunkError) {
+	var ids []string
+	if req.GroupIds != nil {
+		ids = *req.GroupIds
+	}
+
+	resps := make([]Groups_GetByIdObjectLegacy_Response, 0, (len(ids)+maxGroupsGetByIdIDs-1)/maxGroupsGetByIdIDs)
+
+	errs := vk.bulk(ctx, len(ids), maxGroupsGetByIdIDs, opts, func(from, to int) bulkCall {
+		chunk := ids[from:to]
+		r := req
+		r.GroupIds = &chunk
+		resps = append(resps, Groups_GetByIdObjectLegacy_Response{})
+
+		return bulkCall{method: "groups.getById", req: r, dst: &resps[len(resps)-1], options: options}
+	})
+
+	var items []Groups_GroupFull
+	for _, resp := range resps {
+		items = append(items, resp.Response...)
+	}
+
+	return items, errs
+}
+
+// Wall_GetByIdBulk returns posts of any number of req.Posts in input order.
+// Posts are split into chunks of 100 that are requested by VK.Wall_GetById.
+// Extended is always sent as 0, because results have no profiles and groups,
+// use VK.Wall_GetByIdExtended to get them.
+func (vk *VK) Wall_GetByIdBulk(ctx context.Context, req Wall_GetById_Request, opts BulkOptions, options ...Option) ([]Wall_WallpostFull, []ChunkError) {
+	var ids []string
+	if req.Posts != nil {
+		ids = *req.Posts
+	}
+
+	resps := make([]Wall_GetByIdLegacy_Response, 0, (len(ids)+maxWallGetByIdIDs-1)/maxWallGetByIdIDs)
+	// non-extended response is decoded
+	options = append([]Option{{name: "extended", value: "0"}}, options...)
+
+	errs := vk.bulk(ctx, len(ids), maxWallGetByIdIDs, opts, func(from, to int) bulkCall {
+		chunk := ids[from:to]
+		r := req
+		r.Posts = &chunk
+		resps = append(resps, Wall_GetByIdLegacy_Response{})
+
+		return bulkCall{method: "wall.getById", req: r, dst: &resps[len(resps)-1], options: options}
+	})
+
+	var items []Wall_WallpostFull
+	for _, resp := range resps {
+		items = append(items, resp.Response...)
+	}
+
+	return items, errs
+}
+
+// Photos_GetByIdBulk returns photos of any number of req.Photos in input order.
+// Photos are split into chunks of 500 that are requested by VK.Photos_GetById.
+func (vk *VK) Photos_GetByIdBulk(ctx context.Context, req Photos_GetById_Request, opts BulkOptions, options ...Option) ([]Photos_Photo, []ChunkError) {
+	var ids []string
+	if req.Photos != nil {
+		ids = *req.Photos
+	}
+
+	resps := make([]Photos_GetById_Response, 0, (len(ids)+maxPhotosGetByIdIDs-1)/maxPhotosGetByIdIDs)
+
+	errs := vk.bulk(ctx, len(ids), maxPhotosGetByIdIDs, opts, func(from, to int) bulkCall {
+		chunk := ids[from:to]
+		r := req
+		r.Photos = &chunk
+		resps = append(resps, Photos_GetById_Response{})
+
+		return bulkCall{method: "photos.getById", req: r, dst: &resps[len(resps)-1], options: options}
+	})
+
+	var items []Photos_Photo
+	for _, resp := range resps {
+		items = append(items, resp.Response...)
+	}
+
+	return items, errs
+}
+
+// Video_GetBulk returns videos of any number of req.Videos in input order.
+// Videos are split into chunks of 200 that are requested by VK.Video_Get.
+func (vk *VK) Video_GetBulk(ctx context.Context, req Video_Get_Request, opts BulkOptions, options ...Option) ([]Video_VideoFull, []ChunkError) {
+	var ids []string
+	if req.Videos != nil {
+		ids = *req.Videos
+	}
+
+	resps := make([]Video_Get_Response, 0, (len(ids)+maxVideoGetIDs-1)/maxVideoGetIDs)
+
+	errs := vk.bulk(ctx, len(ids), maxVideoGetIDs, opts, func(from, to int) bulkCall {
+		chunk := ids[from:to]
+		count := len(chunk)
+		r := req
+		r.Videos, r.Count = &chunk, &count
+		resps = append(resps, Video_Get_Response{})
+
+		return bulkCall{method: "video.get", req: r, dst: &resps[len(resps)-1], options: options}
+	})
+
+	var items []Video_VideoFull
+	for _, resp := range resps {
+		items = append(items, resp.Response.Items...)
+	}
+
+	return items, errs
+}
+
+// Messages_GetByIdBulk returns messages of any number of req.MessageIds in input order.
+// IDs are split into chunks of 100 that are requested by VK.Messages_GetById.
+// Extended is always sent as 0, because results have no profiles and groups,
+// use VK.Messages_GetByIdExtended to get them.
+func (vk *VK) Messages_GetByIdBulk(ctx context.Context, req Messages_GetById_Request, opts BulkOptions, options ...Option) ([]Messages_Message, []ChunkError) {
+	var ids []int
+	if req.MessageIds != nil {
+		ids = *req.MessageIds
+	}
+
+	resps := make([]Messages_GetById_Response, 0, (len(ids)+maxMessagesGetByIdIDs-1)/maxMessagesGetByIdIDs)
+	// non-extended response is decoded
+	options = append([]Option{{name: "extended", value: "0"}}, options...)
+
+	errs := vk.bulk(ctx, len(ids), maxMessagesGetByIdIDs, opts, func(from, to int) bulkCall {
+		chunk := ids[from:to]
+		r := req
+		r.MessageIds = &chunk
+		resps = append(resps, Messages_GetById_Response{})
+
+		return bulkCall{method: "messages.getById", req: r, dst: &resps[len(resps)-1], options: options}
+	})
+
+	var items []Messages_Message
+	for _, resp := range resps {
+		items = append(items, resp.Response.Items...)
+	}
+
+	return items, errs
+}
@@ -0,0 +1,106 @@
+package vk_sdk
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestVK_Users_GetBulk(t *testing.T) {
+	ids := make([]string, 2*maxUsersGetIDs+1)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+
+	var mu sync.Mutex
+	requested := 0
+
+	vk := NewVK(nil, "")
+	vk.Use(func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (ApiError, error) {
+			assert.Equal(t, "users.get", inv.Method)
+
+			chunk := strings.Split(inv.Values.Get("user_ids"), ",")
+			require.LessOrEqual(t, len(chunk), maxUsersGetIDs)
+
+			mu.Lock()
+			requested += len(chunk)
+			mu.Unlock()
+
+			if chunk[0] == strconv.Itoa(maxUsersGetIDs+1) {
+				return &apiError{ErrorCode: int(Error_Server)}, nil
+			}
+
+			resp := inv.Dst.(*Users_Get_Response)
+			for _, id := range chunk {
+				n, _ := strconv.Atoi(id)
+				resp.Response = append(resp.Response, Users_UserFull{Users_User: Users_User{Users_UserMin: Users_UserMin{Id: n}}})
+			}
+
+			return nil, nil
+		}
+	})
+
+	users, errs := vk.Users_GetBulk(context.Background(), Users_Get_Request{UserIds: &ids}, BulkOptions{})
+
+	assert.Equal(t, len(ids), requested)
+	require.Len(t, errs, 1)
+	assert.Equal(t, maxUsersGetIDs, errs[0].From)
+	assert.Equal(t, 2*maxUsersGetIDs, errs[0].To)
+	assert.True(t, errs[0].ApiErr.Is(Error_Server))
+
+	require.Len(t, users, maxUsersGetIDs+1)
+	assert.Equal(t, 1, users[0].Id)
+	assert.Equal(t, maxUsersGetIDs, users[maxUsersGetIDs-1].Id)
+	assert.Equal(t, 2*maxUsersGetIDs+1, users[maxUsersGetIDs].Id)
+}
+
+func TestVK_Messages_GetByIdBulk_Execute(t *testing.T) {
+	ids := make([]int, 3*maxMessagesGetByIdIDs)
+	for i := range ids {
+		ids[i] = i
+	}
+
+	responses := []string{
+		fmt.Sprintf(`{"response":[{"count":1,"items":[{"id":1}]},false,{"count":1,"items":[{"id":3}]}],`+
+			`"execute_errors":[{"method":"messages.getById","error_code":%d,"error_msg":"Internal server error"}]}`, Error_Server),
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {
+		code := values.Get("code")
+		assert.Equal(t, 3, strings.Count(code, "API.messages.getById"))
+		assert.Contains(t, code, `"extended":"0"`)
+	})
+
+	messages, errs := NewVK(client, "").Messages_GetByIdBulk(context.Background(), Messages_GetById_Request{MessageIds: &ids}, BulkOptions{Execute: true})
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, maxMessagesGetByIdIDs, errs[0].From)
+	assert.True(t, errs[0].ApiErr.Is(Error_Server))
+
+	require.Len(t, messages, 2)
+	assert.Equal(t, 1, messages[0].Id)
+	assert.Equal(t, 3, messages[1].Id)
+}
+
+func TestVK_Users_GetBulk_Context(t *testing.T) {
+	ids := make([]string, 2*maxUsersGetIDs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	users, errs := NewVK(NewErrorTestClient(context.Canceled)).Users_GetBulk(ctx, Users_Get_Request{UserIds: &ids}, BulkOptions{Concurrency: 1})
+
+	assert.Empty(t, users)
+	require.Len(t, errs, 2)
+
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+}
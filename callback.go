@@ -0,0 +1,142 @@
+package vk_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxCallbackBodySize limits the size of Callback API request body.
+const maxCallbackBodySize = 10 << 20
+
+// DefaultCallbackMaxHandlers is default maximum number of events handled by CallbackHandler concurrently.
+const DefaultCallbackMaxHandlers = 100
+
+// CallbackHandler is http.Handler to receive community events by Callback API.
+//
+// It answers the "confirmation" event with the confirmation code, checks the secret key
+// and replies "ok" to other events immediately. Events are passed to handler in separate goroutines,
+// so VK does not repeat the request because of a long processing.
+// Number of concurrently handled events is limited by SetMaxHandlers,
+// the "ok" reply to a new event waits until one of them is handled.
+//
+// https://dev.vk.com/api/callback/getting-started
+type CallbackHandler struct {
+	mu           sync.RWMutex
+	confirmation string
+	secret       string
+	handler      GroupEventHandler
+	ctx          context.Context
+	// sem limits concurrently handled events, it is nil if they are not limited
+	sem chan struct{}
+}
+
+// NewCallbackHandler create and return new CallbackHandler.
+// Confirmation is a string that should be returned to confirm the server address,
+// it can be got by VK.Groups_GetCallbackConfirmationCode method.
+// If secret is empty, the secret key of incoming events is not checked.
+// Handler may be GroupEventMux.HandleEvent.
+func NewCallbackHandler(confirmation, secret string, handler GroupEventHandler) *CallbackHandler {
+	return &CallbackHandler{
+		confirmation: confirmation,
+		secret:       secret,
+		handler:      handler,
+		ctx:          context.Background(),
+		sem:          make(chan struct{}, DefaultCallbackMaxHandlers),
+	}
+}
+
+// SetConfirmation set confirmation code. It can be used as CallbackServerConfig.OnConfirmationCode.
+func (h *CallbackHandler) SetConfirmation(code string) {
+	h.mu.Lock()
+	h.confirmation = code
+	h.mu.Unlock()
+}
+
+// SetContext set context passed to the event handler. Default is context.Background.
+// Request context is not used because it is cancelled after the "ok" reply.
+func (h *CallbackHandler) SetContext(ctx context.Context) {
+	h.mu.Lock()
+	h.ctx = ctx
+	h.mu.Unlock()
+}
+
+// SetMaxHandlers set maximum number of events handled concurrently. Default is DefaultCallbackMaxHandlers.
+// If n <= 0, the number is not limited, so handler must return quickly on a burst of events.
+func (h *CallbackHandler) SetMaxHandlers(n int) {
+	var sem chan struct{}
+
+	if n > 0 {
+		sem = make(chan struct{}, n)
+	}
+
+	h.mu.Lock()
+	h.sem = sem
+	h.mu.Unlock()
+}
+
+// ServeHTTP implements http.Handler.
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCallbackBodySize))
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var e GroupEvent
+
+	if err = json.Unmarshal(body, &e); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if h.secret != "" && (e.Secret == nil || *e.Secret != h.secret) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if e.Type == Callback_Type_Confirmation {
+		h.mu.RLock()
+		confirmation := h.confirmation
+		h.mu.RUnlock()
+
+		_, _ = io.WriteString(w, confirmation)
+		return
+	}
+
+	if h.handler == nil {
+		_, _ = io.WriteString(w, "ok")
+		return
+	}
+
+	h.mu.RLock()
+	ctx, sem := h.ctx, h.sem
+	h.mu.RUnlock()
+
+	if sem != nil {
+		select {
+		case sem <- struct{}{}:
+		case <-r.Context().Done():
+			// VK repeats the event that is not answered
+			return
+		}
+	}
+
+	_, _ = io.WriteString(w, "ok")
+
+	go func() {
+		if sem != nil {
+			defer func() { <-sem }()
+		}
+
+		h.handler(ctx, e)
+	}()
+}
@@ -0,0 +1,185 @@
+package vk_sdk
+
+import (
+	"context"
+	"net/url"
+)
+
+// callbackEvents contains all event types that can be enabled by VK.Groups_SetCallbackSettings method.
+var callbackEvents = []Callback_Type{
+	Callback_Type_MessageNew, Callback_Type_MessageReply, Callback_Type_MessageAllow,
+	Callback_Type_MessageEdit, Callback_Type_MessageDeny, Callback_Type_MessageTypingState,
+	Callback_Type_PhotoNew, Callback_Type_AudioNew, Callback_Type_VideoNew,
+	Callback_Type_WallReplyNew, Callback_Type_WallReplyEdit, Callback_Type_WallReplyDelete,
+	Callback_Type_WallReplyRestore, Callback_Type_WallPostNew, Callback_Type_WallRepost,
+	Callback_Type_BoardPostNew, Callback_Type_BoardPostEdit, Callback_Type_BoardPostRestore,
+	Callback_Type_BoardPostDelete,
+	Callback_Type_PhotoCommentNew, Callback_Type_PhotoCommentEdit, Callback_Type_PhotoCommentDelete,
+	Callback_Type_PhotoCommentRestore,
+	Callback_Type_VideoCommentNew, Callback_Type_VideoCommentEdit, Callback_Type_VideoCommentDelete,
+	Callback_Type_VideoCommentRestore,
+	Callback_Type_MarketCommentNew, Callback_Type_MarketCommentEdit, Callback_Type_MarketCommentDelete,
+	Callback_Type_MarketCommentRestore,
+	Callback_Type_PollVoteNew,
+	Callback_Type_GroupJoin, Callback_Type_GroupLeave, Callback_Type_GroupChangeSettings,
+	Callback_Type_GroupChangePhoto, Callback_Type_GroupOfficersEdit,
+	Callback_Type_UserBlock, Callback_Type_UserUnblock, Callback_Type_LeadFormsNew,
+	// types that are absent in Callback_Type enum of the schema
+	"market_order_new", "market_order_edit", "like_add", "like_remove", "message_event",
+	"donut_subscription_create", "donut_subscription_prolonged", "donut_subscription_cancelled",
+	"donut_subscription_price_changed", "donut_subscription_expired",
+	"donut_money_withdraw", "donut_money_withdraw_error",
+}
+
+// CallbackServerConfig is configuration of Callback API server for VK.EnsureCallbackServer.
+type CallbackServerConfig struct {
+	// Community ID.
+	GroupID int
+	// Server URL. It is used to find existing server.
+	URL string
+	// Server title.
+	//  MaxLength: 14
+	Title string
+	// Secret key passed with every event. Empty key removes the key of existing server.
+	//  MaxLength: 50
+	SecretKey string
+	// API version used for the events. Default is Version.
+	ApiVersion string
+	// Event types to receive. Other events are disabled.
+	// Types missing in Callback_Type constants may be passed as well, e.g. Callback_Type("like_add").
+	Events []Callback_Type
+	// OnConfirmationCode is called with the confirmation code before the server is added or edited,
+	// because VK sends the confirmation event right away. It may be CallbackHandler.SetConfirmation.
+	OnConfirmationCode func(code string)
+}
+
+// CallbackServerInfo is result of VK.EnsureCallbackServer.
+type CallbackServerInfo struct {
+	// Server ID.
+	ServerID int
+	// String to be returned on confirmation event.
+	ConfirmationCode string
+	// Shows whether the server was created.
+	Created bool
+}
+
+// EnsureCallbackServer idempotently creates or updates Callback API server of community
+// and its event subscriptions, so the service can register itself on start.
+//
+// Existing server is found by URL using VK.Groups_GetCallbackServers method. It is edited if title
+// or secret key differs and added by VK.Groups_AddCallbackServer method otherwise.
+// Then the listed events are enabled and all other ones disabled by VK.Groups_SetCallbackSettings method.
+func (vk *VK) EnsureCallbackServer(ctx context.Context, cfg CallbackServerConfig) (info CallbackServerInfo, apiErr ApiError, err error) {
+	codeResp, apiErr, err := vk.Groups_GetCallbackConfirmationCode(ctx, Groups_GetCallbackConfirmationCode_Request{
+		GroupId: cfg.GroupID,
+	})
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	info.ConfirmationCode = codeResp.Response.Code
+
+	if cfg.OnConfirmationCode != nil {
+		cfg.OnConfirmationCode(info.ConfirmationCode)
+	}
+
+	serversResp, apiErr, err := vk.Groups_GetCallbackServers(ctx, Groups_GetCallbackServers_Request{
+		GroupId: cfg.GroupID,
+	})
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var existing *Groups_CallbackServer
+
+	for i, server := range serversResp.Response.Items {
+		if server.Url == cfg.URL {
+			existing = &serversResp.Response.Items[i]
+			break
+		}
+	}
+
+	var secretKey *string
+	if cfg.SecretKey != "" {
+		secretKey = &cfg.SecretKey
+	}
+
+	switch {
+	case existing == nil:
+		var addResp Groups_AddCallbackServer_Response
+
+		addResp, apiErr, err = vk.Groups_AddCallbackServer(ctx, Groups_AddCallbackServer_Request{
+			GroupId:   cfg.GroupID,
+			Url:       cfg.URL,
+			Title:     cfg.Title,
+			SecretKey: secretKey,
+		})
+
+		if apiErr != nil || err != nil {
+			return
+		}
+
+		info.ServerID = addResp.Response.ServerId
+		info.Created = true
+	case existing.Title != cfg.Title || existing.SecretKey != cfg.SecretKey:
+		info.ServerID = existing.Id
+
+		// empty key is sent too, so the server key matches the config on the next run
+		_, apiErr, err = vk.Groups_EditCallbackServer(ctx, Groups_EditCallbackServer_Request{
+			GroupId:   cfg.GroupID,
+			ServerId:  existing.Id,
+			Url:       cfg.URL,
+			Title:     cfg.Title,
+			SecretKey: &cfg.SecretKey,
+		})
+
+		if apiErr != nil || err != nil {
+			return
+		}
+	default:
+		info.ServerID = existing.Id
+	}
+
+	apiErr, err = vk.setCallbackEvents(ctx, cfg, info.ServerID)
+
+	return
+}
+
+// setCallbackEvents enables listed events and disables all other ones.
+func (vk *VK) setCallbackEvents(ctx context.Context, cfg CallbackServerConfig, serverID int) (ApiError, error) {
+	apiVersion := cfg.ApiVersion
+	if apiVersion == "" {
+		apiVersion = Version
+	}
+
+	req := Groups_SetCallbackSettings_Request{
+		GroupId:    cfg.GroupID,
+		ServerId:   &serverID,
+		ApiVersion: &apiVersion,
+	}
+
+	values := make(url.Values, len(callbackEvents)+5)
+
+	if err := req.fillIn(values); err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[Callback_Type]bool, len(cfg.Events))
+	for _, t := range cfg.Events {
+		enabled[t] = true
+	}
+
+	for _, t := range callbackEvents {
+		setBool(values, string(t), enabled[t])
+	}
+
+	for t := range enabled {
+		setBool(values, string(t), true)
+	}
+
+	var resp Base_Ok_Response
+
+	return vk.doReq("groups.setCallbackSettings", ctx, values, &resp)
+}
@@ -0,0 +1,221 @@
+package vk_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// callbackServersAPI is fake API of community Callback API servers.
+type callbackServersAPI struct {
+	mu       sync.Mutex
+	servers  []Groups_CallbackServer
+	calls    []string
+	settings url.Values
+}
+
+func (a *callbackServersAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	method := strings.TrimPrefix(r.URL.Path, "/"+apiPath+"/")
+	a.calls = append(a.calls, method)
+
+	var resp interface{} = 1
+
+	switch method {
+	case "groups.getCallbackConfirmationCode":
+		resp = map[string]string{"code": "confirm"}
+	case "groups.getCallbackServers":
+		resp = map[string]interface{}{"count": len(a.servers), "items": a.servers}
+	case "groups.addCallbackServer":
+		id := len(a.servers) + 1
+		a.servers = append(a.servers, Groups_CallbackServer{
+			Id:        id,
+			Url:       r.PostForm.Get("url"),
+			Title:     r.PostForm.Get("title"),
+			SecretKey: r.PostForm.Get("secret_key"),
+		})
+		resp = map[string]int{"server_id": id}
+	case "groups.editCallbackServer":
+		id, _ := strconv.Atoi(r.PostForm.Get("server_id"))
+		for i := range a.servers {
+			if a.servers[i].Id == id {
+				a.servers[i].Url = r.PostForm.Get("url")
+				a.servers[i].Title = r.PostForm.Get("title")
+				if _, ok := r.PostForm["secret_key"]; ok {
+					a.servers[i].SecretKey = r.PostForm.Get("secret_key")
+				}
+			}
+		}
+	case "groups.setCallbackSettings":
+		a.settings = r.PostForm
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"response": resp})
+}
+
+// takeCalls returns called methods and resets them.
+func (a *callbackServersAPI) takeCalls() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	calls := a.calls
+	a.calls = nil
+
+	return calls
+}
+
+// newCallbackServersTestVK returns VK that sends requests to api.
+func newCallbackServersTestVK(t *testing.T, api *callbackServersAPI) *VK {
+	client, _ := newServerTestClient(t, api)
+
+	return NewVK(client, "token")
+}
+
+func TestVK_EnsureCallbackServer(t *testing.T) {
+	cfg := CallbackServerConfig{
+		GroupID:   1,
+		URL:       "https://example.com/callback",
+		Title:     "bot",
+		SecretKey: "secret",
+		Events:    []Callback_Type{"message_new", "like_add"},
+	}
+
+	t.Run("create", func(t *testing.T) {
+		api := &callbackServersAPI{}
+		vk := newCallbackServersTestVK(t, api)
+
+		var code string
+		cfg := cfg
+		cfg.OnConfirmationCode = func(c string) {
+			code = c
+		}
+
+		info, apiErr, err := vk.EnsureCallbackServer(context.Background(), cfg)
+		require.NoError(t, err)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, CallbackServerInfo{ServerID: 1, ConfirmationCode: "confirm", Created: true}, info)
+		assert.Equal(t, "confirm", code)
+		assert.Equal(t, []string{
+			"groups.getCallbackConfirmationCode", "groups.getCallbackServers",
+			"groups.addCallbackServer", "groups.setCallbackSettings",
+		}, api.takeCalls())
+		assert.Equal(t, []Groups_CallbackServer{
+			{Id: 1, Url: cfg.URL, Title: "bot", SecretKey: "secret"},
+		}, api.servers)
+
+		assert.Equal(t, "1", api.settings.Get("server_id"))
+		assert.Equal(t, Version, api.settings.Get("api_version"))
+		assert.Equal(t, "true", api.settings.Get("message_new"))
+		assert.Equal(t, "true", api.settings.Get("like_add"))
+		assert.Equal(t, "false", api.settings.Get("message_reply"))
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		api := &callbackServersAPI{
+			servers: []Groups_CallbackServer{
+				{Id: 1, Url: "https://example.com/other", Title: "other"},
+				{Id: 2, Url: cfg.URL, Title: "old", SecretKey: "old"},
+			},
+		}
+		vk := newCallbackServersTestVK(t, api)
+
+		info, apiErr, err := vk.EnsureCallbackServer(context.Background(), cfg)
+		require.NoError(t, err)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, CallbackServerInfo{ServerID: 2, ConfirmationCode: "confirm"}, info)
+		assert.Equal(t, []string{
+			"groups.getCallbackConfirmationCode", "groups.getCallbackServers",
+			"groups.editCallbackServer", "groups.setCallbackSettings",
+		}, api.takeCalls())
+		assert.Equal(t, Groups_CallbackServer{Id: 2, Url: cfg.URL, Title: "bot", SecretKey: "secret"}, api.servers[1])
+		assert.Equal(t, "2", api.settings.Get("server_id"))
+	})
+
+	t.Run("no-op", func(t *testing.T) {
+		api := &callbackServersAPI{
+			servers: []Groups_CallbackServer{
+				{Id: 3, Url: cfg.URL, Title: "bot", SecretKey: "secret"},
+			},
+		}
+		vk := newCallbackServersTestVK(t, api)
+
+		info, apiErr, err := vk.EnsureCallbackServer(context.Background(), cfg)
+		require.NoError(t, err)
+		require.Nil(t, apiErr)
+
+		assert.Equal(t, CallbackServerInfo{ServerID: 3, ConfirmationCode: "confirm"}, info)
+		assert.Equal(t, []string{
+			"groups.getCallbackConfirmationCode", "groups.getCallbackServers", "groups.setCallbackSettings",
+		}, api.takeCalls())
+	})
+
+	t.Run("remove secret key", func(t *testing.T) {
+		api := &callbackServersAPI{
+			servers: []Groups_CallbackServer{
+				{Id: 1, Url: cfg.URL, Title: "bot", SecretKey: "secret"},
+			},
+		}
+		vk := newCallbackServersTestVK(t, api)
+
+		cfg := cfg
+		cfg.SecretKey = ""
+
+		_, apiErr, err := vk.EnsureCallbackServer(context.Background(), cfg)
+		require.NoError(t, err)
+		require.Nil(t, apiErr)
+
+		assert.Contains(t, api.takeCalls(), "groups.editCallbackServer")
+		assert.Equal(t, "", api.servers[0].SecretKey)
+
+		_, apiErr, err = vk.EnsureCallbackServer(context.Background(), cfg)
+		require.NoError(t, err)
+		require.Nil(t, apiErr)
+
+		assert.NotContains(t, api.takeCalls(), "groups.editCallbackServer")
+	})
+}
+
+func TestCallbackEvents(t *testing.T) {
+	// all event parameters of the method are set
+	req := Groups_SetCallbackSettings_Request{}
+
+	v := reflect.ValueOf(&req).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Type() == reflect.TypeOf((*bool)(nil)) {
+			f.Set(reflect.ValueOf(new(bool)))
+		}
+	}
+
+	values := make(url.Values)
+	require.NoError(t, req.fillIn(values))
+	values.Del("group_id")
+
+	events := make([]string, 0, len(callbackEvents))
+	for _, e := range callbackEvents {
+		events = append(events, string(e))
+	}
+
+	params := make([]string, 0, len(values))
+	for key := range values {
+		params = append(params, key)
+	}
+
+	assert.ElementsMatch(t, params, events)
+}
@@ -0,0 +1,119 @@
+package vk_sdk
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCallbackHandler(t *testing.T) {
+	mux := NewGroupEventMux()
+
+	received := make(chan Callback_MessageNew, 1)
+	mux.OnMessageNew(func(ctx context.Context, e Callback_MessageNew) {
+		received <- e
+	})
+
+	server := httptest.NewServer(NewCallbackHandler("code", "secret", mux.HandleEvent))
+	defer server.Close()
+
+	post := func(body string) (int, string) {
+		resp, err := http.Post(server.URL, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		return resp.StatusCode, string(respBody)
+	}
+
+	code, body := post(`{"type":"confirmation","group_id":1,"secret":"secret"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "code", body)
+
+	code, _ = post(`{"type":"message_new","group_id":1,"secret":"wrong"}`)
+	assert.Equal(t, http.StatusForbidden, code)
+
+	code, _ = post(`{`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, body = post(`{"type":"message_new","group_id":1,"event_id":"e","secret":"secret","object":{"message":{"text":"hi"}}}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", body)
+
+	select {
+	case e := <-received:
+		assert.Equal(t, 1, e.GroupId)
+		assert.Equal(t, "e", e.EventId)
+		require.NotNil(t, e.Object.Message)
+		assert.Equal(t, "hi", e.Object.Message.Text)
+	case <-time.After(time.Second):
+		t.Fatal("event was not handled")
+	}
+}
+
+func TestCallbackHandler_SetMaxHandlers(t *testing.T) {
+	release := make(chan struct{})
+	handled := make(chan string, 2)
+
+	h := NewCallbackHandler("code", "", func(ctx context.Context, e GroupEvent) {
+		handled <- e.EventID
+		<-release
+	})
+	h.SetMaxHandlers(1)
+
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	post := func(ctx context.Context, eventID string) error {
+		body := strings.NewReader(`{"type":"message_new","group_id":1,"event_id":"` + eventID + `"}`)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, body)
+		require.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", string(respBody))
+
+		return nil
+	}
+
+	require.NoError(t, post(context.Background(), "first"))
+	assert.Equal(t, "first", <-handled)
+
+	// the second event is not answered while the first one is handled
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, post(ctx, "second"))
+
+	select {
+	case id := <-handled:
+		t.Fatalf("event %s is handled over the limit", id)
+	default:
+	}
+
+	h.SetContext(context.Background())
+	close(release)
+
+	require.NoError(t, post(context.Background(), "third"))
+
+	// the second event may be handled if the server does not notice the cancelled request in time
+	for id := range handled {
+		if id == "third" {
+			break
+		}
+	}
+}
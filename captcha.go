@@ -0,0 +1,58 @@
+package vk_sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultCaptchaAttempts is the number of captchas solved for a single method call by default.
+const DefaultCaptchaAttempts = 3
+
+// CaptchaSolver solves captcha of Error_Captcha.
+//
+// https://dev.vk.com/api/captcha-error
+type CaptchaSolver interface {
+	// SolveCaptcha returns text from the captcha image by img URL.
+	// Sid is captcha ID that is sent back with the key.
+	SolveCaptcha(ctx context.Context, img, sid string) (key string, err error)
+}
+
+// CaptchaSolverFunc is function adapter for CaptchaSolver.
+type CaptchaSolverFunc func(ctx context.Context, img, sid string) (string, error)
+
+// SolveCaptcha implements CaptchaSolver.
+func (f CaptchaSolverFunc) SolveCaptcha(ctx context.Context, img, sid string) (string, error) {
+	return f(ctx, img, sid)
+}
+
+// SetCaptchaSolver set solver that is called when method returns Error_Captcha.
+// The method is sent again with CaptchaSID and CaptchaKey options
+// up to attempts times, DefaultCaptchaAttempts is used if attempts is not positive.
+// Pass nil solver to return Error_Captcha to the caller.
+func (vk *VK) SetCaptchaSolver(solver CaptchaSolver, attempts int) {
+	if attempts <= 0 {
+		attempts = DefaultCaptchaAttempts
+	}
+
+	vk.captchaSolver = solver
+	vk.captchaAttempts = attempts
+}
+
+// solveCaptcha solves captcha of apiErr and sets captcha options to the invocation.
+func (vk *VK) solveCaptcha(ctx context.Context, inv *Invocation, apiErr ApiError) error {
+	c := apiErr.Captcha()
+
+	if c == nil {
+		return fmt.Errorf("%s: captcha is required but not provided", inv.Method)
+	}
+
+	key, err := vk.captchaSolver.SolveCaptcha(ctx, c.Img(), c.SID())
+
+	if err != nil {
+		return fmt.Errorf("%s: solve captcha: %w", inv.Method, err)
+	}
+
+	setOptions(inv.Values, []Option{CaptchaSID(c.SID()), CaptchaKey(key)})
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestVK_SetCaptchaSolver(t *testing.T) {
+	const captchaErr = `{"error_code":14,"error_msg":"Captcha needed","captcha_sid":"123","captcha_img":"https://api.vk.com/captcha.php?sid=123"}`
+
+	tests := []struct {
+		name      string
+		responses []string
+		attempts  int
+		solved    int
+		isCaptcha bool
+	}{
+		{
+			name:      "Solved",
+			responses: []string{captchaErr, `{"response":[{"id":1}]}`},
+			attempts:  2,
+			solved:    1,
+		},
+		{
+			name:      "Attempts are over",
+			responses: []string{captchaErr, captchaErr, captchaErr},
+			attempts:  3,
+			solved:    2,
+			isCaptcha: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int
+
+			transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+				reqBody, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				values, err := url.ParseQuery(string(reqBody))
+				require.NoError(t, err)
+
+				if attempts > 0 {
+					assert.Equal(t, "123", values.Get("captcha_sid"))
+					assert.Equal(t, "key", values.Get("captcha_key"))
+				}
+
+				attempts++
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     make(http.Header),
+					Body:       ioutil.NopCloser(bytes.NewBufferString(test.responses[attempts-1])),
+				}, nil
+			})
+
+			var solved int
+
+			vk := NewVK(&http.Client{Transport: transport})
+			vk.SetCaptchaSolver(CaptchaSolverFunc(func(ctx context.Context, img, sid string) (string, error) {
+				solved++
+				assert.Equal(t, "https://api.vk.com/captcha.php?sid=123", img)
+				assert.Equal(t, "123", sid)
+				return "key", nil
+			}), 2)
+
+			_, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+			require.NoError(t, err)
+			assert.Equal(t, test.attempts, attempts)
+			assert.Equal(t, test.solved, solved)
+
+			if test.isCaptcha {
+				require.NotNil(t, apiErr)
+				assert.True(t, apiErr.Is(Error_Captcha))
+			} else {
+				assert.Nil(t, apiErr)
+			}
+		})
+	}
+}
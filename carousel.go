@@ -0,0 +1,259 @@
+package vk_sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Carousel limits.
+//
+// https://dev.vk.com/api/bots/development/messages#Carousels
+const (
+	MaxCarouselElements              = 10
+	MaxCarouselElementButtons        = 3
+	MaxCarouselElementTitleLen       = 80
+	MaxCarouselElementDescriptionLen = 80
+)
+
+const carouselTemplateType = "carousel"
+
+// CarouselTemplate is message template with horizontally scrolled elements.
+type CarouselTemplate struct {
+	Type     string            `json:"type"`
+	Elements []CarouselElement `json:"elements"`
+}
+
+// CarouselElement is element of the carousel.
+type CarouselElement struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// PhotoID is photo in format {owner_id}_{photo_id}
+	PhotoID string                    `json:"photo_id,omitempty"`
+	Buttons []Messages_KeyboardButton `json:"buttons,omitempty"`
+	Action  *CarouselElementAction    `json:"action,omitempty"`
+}
+
+// CarouselElementAction is action on click on the carousel element.
+type CarouselElementAction struct {
+	Type Messages_TemplateActionTypeNames `json:"type"`
+	Link string                           `json:"link,omitempty"`
+}
+
+// CarouselBuilder builds carousel template for Messages_Send_Request.Template and checks carousel limits.
+// Buttons are added to the last element. The first error is returned by Build, so the calls can be chained.
+//
+//    template, err := vk_sdk.NewCarousel().
+//        Element("First", "Description", "-1_2").
+//        OpenPhotoAction().
+//        Text("Buy", `{"item":1}`, vk_sdk.Messages_KeyboardButton_Color_Positive).
+//        Element("Second", "Description", "-1_3").
+//        OpenLinkAction("https://vk.com").
+//        Text("Buy", `{"item":2}`, vk_sdk.Messages_KeyboardButton_Color_Positive).
+//        Build()
+//
+//    req := vk_sdk.Messages_Send_Request{Template: &template}
+type CarouselBuilder struct {
+	elements []CarouselElement
+	// buttons builds buttons of the last element, one button per row
+	buttons *KeyboardBuilder
+	err     error
+}
+
+// NewCarousel create and return new CarouselBuilder.
+func NewCarousel() *CarouselBuilder {
+	return &CarouselBuilder{}
+}
+
+// Element adds carousel element. Element must have title or photo.
+// PhotoID is photo in format {owner_id}_{photo_id}.
+func (b *CarouselBuilder) Element(title, description, photoID string) *CarouselBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	switch {
+	case len(b.elements) >= MaxCarouselElements:
+		b.err = fmt.Errorf("carousel can not contain more than %d elements", MaxCarouselElements)
+	case title == "" && photoID == "":
+		b.err = errors.New("carousel element must have title or photo")
+	case utf8.RuneCountInString(title) > MaxCarouselElementTitleLen:
+		b.err = fmt.Errorf("carousel element title %q is longer than %d characters", title, MaxCarouselElementTitleLen)
+	case utf8.RuneCountInString(description) > MaxCarouselElementDescriptionLen:
+		b.err = fmt.Errorf("carousel element description %q is longer than %d characters", description, MaxCarouselElementDescriptionLen)
+	}
+
+	if b.err != nil {
+		return b
+	}
+
+	b.flushButtons()
+
+	b.elements = append(b.elements, CarouselElement{
+		Title:       title,
+		Description: description,
+		PhotoID:     photoID,
+	})
+	b.buttons = NewInlineKeyboard()
+
+	return b
+}
+
+// OpenLinkAction sets action of the last element that opens the link.
+func (b *CarouselBuilder) OpenLinkAction(link string) *CarouselBuilder {
+	if b.err == nil && link == "" {
+		b.err = errors.New("carousel element open_link action must have link")
+	}
+
+	return b.setAction(CarouselElementAction{
+		Type: Messages_TemplateActionTypeNames_OpenLink,
+		Link: link,
+	})
+}
+
+// OpenPhotoAction sets action of the last element that opens the element photo.
+func (b *CarouselBuilder) OpenPhotoAction() *CarouselBuilder {
+	if b.err == nil && len(b.elements) > 0 && b.elements[len(b.elements)-1].PhotoID == "" {
+		b.err = errors.New("carousel element open_photo action must have photo")
+	}
+
+	return b.setAction(CarouselElementAction{
+		Type: Messages_TemplateActionTypeNames_OpenPhoto,
+	})
+}
+
+// Text adds button to the last element that sends message with the label and payload.
+// Empty payload and color are not sent.
+func (b *CarouselBuilder) Text(label, payload string, color Messages_KeyboardButton_Color) *CarouselBuilder {
+	return b.addButton(func(kb *KeyboardBuilder) {
+		kb.Text(label, payload, color)
+	})
+}
+
+// Callback adds button to the last element that sends message_event to the bot without message.
+func (b *CarouselBuilder) Callback(label, payload string, color Messages_KeyboardButton_Color) *CarouselBuilder {
+	return b.addButton(func(kb *KeyboardBuilder) {
+		kb.Callback(label, payload, color)
+	})
+}
+
+// OpenLink adds button to the last element that opens the link.
+func (b *CarouselBuilder) OpenLink(label, link, payload string) *CarouselBuilder {
+	return b.addButton(func(kb *KeyboardBuilder) {
+		kb.OpenLink(label, link, payload)
+	})
+}
+
+// OpenApp adds button to the last element that opens VK Mini App. Hash may be empty.
+func (b *CarouselBuilder) OpenApp(label string, appID, ownerID int, hash, payload string) *CarouselBuilder {
+	return b.addButton(func(kb *KeyboardBuilder) {
+		kb.OpenApp(label, appID, ownerID, hash, payload)
+	})
+}
+
+// Build returns carousel template as JSON string or the first error of the builder.
+// All elements must have the same number of buttons and the same set of fields.
+func (b *CarouselBuilder) Build() (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+
+	b.flushButtons()
+
+	if b.err != nil {
+		return "", b.err
+	}
+
+	if len(b.elements) == 0 {
+		return "", errors.New("carousel must contain at least one element")
+	}
+
+	first := b.elements[0]
+
+	for i, e := range b.elements[1:] {
+		switch {
+		case len(e.Buttons) != len(first.Buttons):
+			return "", fmt.Errorf("carousel element %d has %d buttons, but the first element has %d", i+1, len(e.Buttons), len(first.Buttons))
+		case (e.Title == "") != (first.Title == ""):
+			return "", fmt.Errorf("carousel element %d: all elements must have title or none", i+1)
+		case (e.PhotoID == "") != (first.PhotoID == ""):
+			return "", fmt.Errorf("carousel element %d: all elements must have photo or none", i+1)
+		}
+	}
+
+	raw, err := json.Marshal(CarouselTemplate{
+		Type:     carouselTemplateType,
+		Elements: b.elements,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// setAction sets action of the last element.
+func (b *CarouselBuilder) setAction(action CarouselElementAction) *CarouselBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	if len(b.elements) == 0 {
+		b.err = errors.New("carousel action must follow element")
+		return b
+	}
+
+	b.elements[len(b.elements)-1].Action = &action
+
+	return b
+}
+
+// addButton adds button to the last element with keyboard builder
+// that checks the label and payload and marshals the action.
+func (b *CarouselBuilder) addButton(add func(kb *KeyboardBuilder)) *CarouselBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	switch {
+	case b.buttons == nil:
+		b.err = errors.New("carousel button must follow element")
+	case len(b.buttons.rows) >= MaxCarouselElementButtons:
+		b.err = fmt.Errorf("carousel element can not contain more than %d buttons", MaxCarouselElementButtons)
+	}
+
+	if b.err != nil {
+		return b
+	}
+
+	add(b.buttons.Row())
+
+	b.err = b.buttons.err
+
+	return b
+}
+
+// flushButtons sets built buttons to the last element.
+func (b *CarouselBuilder) flushButtons() {
+	if b.buttons == nil {
+		return
+	}
+
+	keyboard, err := b.buttons.Build()
+
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	e := &b.elements[len(b.elements)-1]
+	e.Buttons = nil
+
+	for _, row := range keyboard.Buttons {
+		e.Buttons = append(e.Buttons, row...)
+	}
+
+	b.buttons = nil
+}
@@ -0,0 +1,87 @@
+package vk_sdk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestCarouselBuilder(t *testing.T) {
+	template, err := NewCarousel().
+		Element("First", "Description", "-1_2").
+		OpenPhotoAction().
+		Text("Buy", `{"item":1}`, Messages_KeyboardButton_Color_Positive).
+		Element("Second", "", "-1_3").
+		OpenLinkAction("https://vk.com").
+		OpenLink("Open", "https://vk.com", "").
+		Build()
+	require.NoError(t, err)
+
+	expected := `{"type":"carousel","elements":[` +
+		`{"title":"First","description":"Description","photo_id":"-1_2","action":{"type":"open_photo"},` +
+		`"buttons":[{"action":{"label":"Buy","payload":"{\"item\":1}","type":"text"},"color":"positive"}]},` +
+		`{"title":"Second","photo_id":"-1_3","action":{"type":"open_link","link":"https://vk.com"},` +
+		`"buttons":[{"action":{"label":"Open","link":"https://vk.com","type":"open_link"}}]}` +
+		`]}`
+	assert.JSONEq(t, expected, template)
+
+	tooMany := NewCarousel()
+
+	for i := 0; i <= MaxCarouselElements; i++ {
+		tooMany.Element("title", "", "")
+	}
+
+	tests := []struct {
+		name    string
+		builder *CarouselBuilder
+	}{
+		{
+			name:    "Empty",
+			builder: NewCarousel(),
+		},
+		{
+			name:    "Elements",
+			builder: tooMany,
+		},
+		{
+			name:    "Title",
+			builder: NewCarousel().Element(strings.Repeat("a", MaxCarouselElementTitleLen+1), "", ""),
+		},
+		{
+			name:    "No title and photo",
+			builder: NewCarousel().Element("", "description", ""),
+		},
+		{
+			name:    "Element buttons",
+			builder: NewCarousel().Element("title", "", "").Text("1", "", "").Text("2", "", "").Text("3", "", "").Text("4", "", ""),
+		},
+		{
+			name:    "Inconsistent buttons",
+			builder: NewCarousel().Element("1", "", "").Text("1", "", "").Element("2", "", ""),
+		},
+		{
+			name:    "Inconsistent photo",
+			builder: NewCarousel().Element("1", "", "-1_2").Element("2", "", ""),
+		},
+		{
+			name:    "Label",
+			builder: NewCarousel().Element("title", "", "").Text(strings.Repeat("a", MaxKeyboardButtonLabelLen+1), "", ""),
+		},
+		{
+			name:    "Open photo without photo",
+			builder: NewCarousel().Element("title", "", "").OpenPhotoAction(),
+		},
+		{
+			name:    "Button without element",
+			builder: NewCarousel().Text("1", "", ""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.builder.Build()
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package vk_sdk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FieldError is error of request field that does not match limits of the method.
+// It is returned by generated Validate methods of requests.
+type FieldError struct {
+	// Field is API name of the parameter.
+	Field string
+	// Reason describes violated limit.
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid request field %q: %s", e.Field, e.Reason)
+}
+
+// requestValidator is interface that implement all generated method requests.
+type requestValidator interface {
+	Validate() error
+}
+
+// SetRequestValidation enables validation of requests by generated Validate methods before sending.
+// Invalid request is not sent and *FieldError is returned as error.
+func (vk *VK) SetRequestValidation(enabled bool) {
+	vk.validateRequests = enabled
+}
+
+// checkRequest validates request if validation is enabled.
+func (vk *VK) checkRequest(req requestValidator) error {
+	if !vk.validateRequests {
+		return nil
+	}
+
+	return req.Validate()
+}
+
+func checkRequired(field string, present bool) error {
+	if !present {
+		return &FieldError{Field: field, Reason: "is required"}
+	}
+
+	return nil
+}
+
+func checkMinimum(field string, v, min float64) error {
+	if v < min {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("%v is less than minimum %v", v, min)}
+	}
+
+	return nil
+}
+
+func checkMaximum(field string, v, max float64) error {
+	if v > max {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("%v is greater than maximum %v", v, max)}
+	}
+
+	return nil
+}
+
+func checkMinLength(field, v string, min int) error {
+	if n := utf8.RuneCountInString(v); n < min {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("length %d is less than minimum %d", n, min)}
+	}
+
+	return nil
+}
+
+func checkMaxLength(field, v string, max int) error {
+	if n := utf8.RuneCountInString(v); n > max {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("length %d is greater than maximum %d", n, max)}
+	}
+
+	return nil
+}
+
+func checkMinItems(field string, n, min int) error {
+	if n < min {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("%d items is less than minimum %d", n, min)}
+	}
+
+	return nil
+}
+
+func checkMaxItems(field string, n, max int) error {
+	if n > max {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("%d items is greater than maximum %d", n, max)}
+	}
+
+	return nil
+}
+
+func checkStringEnum(field, v string, values ...string) error {
+	for _, value := range values {
+		if v == value {
+			return nil
+		}
+	}
+
+	return &FieldError{Field: field, Reason: fmt.Sprintf("%q is not one of [%s]", v, strings.Join(values, ", "))}
+}
+
+func checkIntEnum(field string, v int, values ...int) error {
+	for _, value := range values {
+		if v == value {
+			return nil
+		}
+	}
+
+	ss := make([]string, len(values))
+
+	for i, value := range values {
+		ss[i] = strconv.Itoa(value)
+	}
+
+	return &FieldError{Field: field, Reason: fmt.Sprintf("%d is not one of [%s]", v, strings.Join(ss, ", "))}
+}
@@ -0,0 +1,65 @@
+package vk_sdk
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	count := -1
+	filter := Wall_GetFilter("unknown")
+	message := strings.Repeat("ы", 9001)
+	sex := Account_SaveProfileInfo_Sex(3)
+
+	tests := []struct {
+		name  string
+		req   requestValidator
+		field string
+	}{
+		{name: "valid", req: Wall_Get_Request{}},
+		{name: "minimum", req: Wall_Get_Request{Count: &count}, field: "count"},
+		{name: "string enum", req: Wall_Get_Request{Filter: &filter}, field: "filter"},
+		{name: "int enum", req: Account_SaveProfileInfo_Request{Sex: &sex}, field: "sex"},
+		{name: "max length", req: Messages_Send_Request{Message: &message}, field: "message"},
+		{name: "required", req: Account_ChangePassword_Request{}, field: "new_password"},
+		{name: "min length", req: Account_ChangePassword_Request{NewPassword: "12345"}, field: "new_password"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.req.Validate()
+
+			if test.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var fieldErr *FieldError
+			require.True(t, errors.As(err, &fieldErr))
+			assert.Equal(t, test.field, fieldErr.Field)
+		})
+	}
+}
+
+func TestVK_SetRequestValidation(t *testing.T) {
+	sendErr := errors.New("request is sent")
+	vk := NewVK(NewErrorTestClient(sendErr), "")
+	count := -1
+	req := Wall_Get_Request{Count: &count}
+
+	_, _, err := vk.Wall_Get(context.Background(), req)
+	assert.ErrorIs(t, err, sendErr)
+
+	vk.SetRequestValidation(true)
+
+	_, apiErr, err := vk.Wall_Get(context.Background(), req)
+	assert.Nil(t, apiErr)
+
+	var fieldErr *FieldError
+	require.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, "count", fieldErr.Field)
+}
@@ -0,0 +1,273 @@
+package vk_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SetCoalescing enables merging of concurrent generated method calls into execute requests.
+// Calls made within the window after the first pending call are sent as a single execute request
+// with up to MaxExecuteCalls sub-calls, and each caller gets its own result and ApiError.
+// It allows to stay under the requests per second limit without code changes.
+// Calls with access token set by middleware or option are merged only with calls of the same token.
+// Calls with options, auth methods, stored procedures and upload servers are not merged.
+// Pass zero window to disable coalescing.
+//
+// NOTE:
+// The execute request is cancelled when contexts of all its callers are done,
+// a caller with cancelled context gets its error without waiting for the result.
+func (vk *VK) SetCoalescing(window time.Duration) {
+	if window <= 0 {
+		vk.coalescer = nil
+		return
+	}
+
+	vk.coalescer = &coalescer{
+		vk:      vk,
+		window:  window,
+		batches: make(map[batchToken]*pendingBatch),
+	}
+}
+
+// coalescer collects pending method calls and sends them by ExecuteBatch.
+type coalescer struct {
+	vk     *VK
+	window time.Duration
+
+	mu      sync.Mutex
+	batches map[batchToken]*pendingBatch
+}
+
+// batchToken is access token of calls of the batch.
+// Calls without own token are sent with VK token.
+type batchToken struct {
+	token string
+	own   bool
+}
+
+// pendingBatch is calls with the same token waiting for the window to be over.
+type pendingBatch struct {
+	token batchToken
+	calls []*coalescedCall
+	timer *time.Timer
+}
+
+// coalescedCall is pending method call waiting for execute result.
+type coalescedCall struct {
+	ctx    context.Context
+	call   *ExecuteCall
+	values url.Values
+	done   chan struct{}
+	apiErr ApiError
+	err    error
+}
+
+// rawResponse is used to get raw method response.
+type rawResponse struct {
+	Response json.RawMessage `json:"response"`
+}
+
+// coalesceOptions are names of Option parameters. Calls with them are sent on their own,
+// because execute applies them to the whole request.
+var coalesceOptions = []string{"lang", "test_mode", "captcha_sid", "captcha_key"}
+
+// canCoalesce checks if method with values may be called within execute.
+func canCoalesce(methodName string, values url.Values) bool {
+	switch {
+	case methodName == "execute", strings.HasPrefix(methodName, "execute."):
+		return false
+	// auth methods are called without user token
+	case strings.HasPrefix(methodName, "auth."):
+		return false
+	// upload URLs are bound to IP address of the caller
+	case strings.HasSuffix(methodName, "UploadServer"), methodName == "video.save":
+		return false
+	case !executeMethodName.MatchString(methodName):
+		return false
+	}
+
+	for _, name := range coalesceOptions {
+		if _, ok := values[name]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// do adds method call to pending calls and waits for the result.
+func (c *coalescer) do(ctx context.Context, methodName string, values url.Values, dst interface{}) (ApiError, error) {
+	cc := &coalescedCall{
+		ctx: ctx,
+		// results are unmarshalled by caller, so dst is not touched after caller returns
+		call:   newExecuteCall(methodName, values, nil),
+		values: values,
+		done:   make(chan struct{}),
+	}
+
+	var token batchToken
+
+	if _, ok := values[tokenKey]; ok {
+		token = batchToken{token: values.Get(tokenKey), own: true}
+	}
+
+	c.mu.Lock()
+
+	b, ok := c.batches[token]
+
+	if !ok {
+		b = &pendingBatch{token: token}
+		b.timer = time.AfterFunc(c.window, func() {
+			c.flushPending(b)
+		})
+		c.batches[token] = b
+	}
+
+	b.calls = append(b.calls, cc)
+
+	if len(b.calls) == MaxExecuteCalls {
+		c.take(b)
+		c.mu.Unlock()
+
+		go c.flush(b)
+	} else {
+		c.mu.Unlock()
+	}
+
+	select {
+	case <-cc.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if cc.apiErr != nil || cc.err != nil {
+		return cc.apiErr, cc.err
+	}
+
+	if cc.call.apiErr != nil {
+		return cc.call.apiErr, nil
+	}
+
+	return nil, cc.call.unmarshalResult(dst, c.vk.unmarshaler(cc.call.method))
+}
+
+// take removes batch from pending ones. Must be called with locked mutex.
+func (c *coalescer) take(b *pendingBatch) {
+	delete(c.batches, b.token)
+	b.timer.Stop()
+}
+
+// flushPending sends pending batch when the window is over, if it is not sent yet.
+func (c *coalescer) flushPending(b *pendingBatch) {
+	c.mu.Lock()
+
+	if c.batches[b.token] != b {
+		c.mu.Unlock()
+		return
+	}
+
+	c.take(b)
+
+	c.mu.Unlock()
+
+	c.flush(b)
+}
+
+// flush sends calls of batch by single request and notifies callers.
+func (c *coalescer) flush(pending *pendingBatch) {
+	batch := pending.calls
+
+	defer func() {
+		for _, cc := range batch {
+			close(cc.done)
+		}
+	}()
+
+	ctx, cancel := batchContext(batch)
+	defer cancel()
+
+	if len(batch) == 1 {
+		cc := batch[0]
+		var resp rawResponse
+		cc.apiErr, cc.err = c.vk.send(cc.call.method, ctx, cc.values, &resp)
+		cc.call.result = resp.Response
+		return
+	}
+
+	b := &ExecuteBatch{
+		calls: make([]*ExecuteCall, 0, len(batch)),
+	}
+
+	for _, cc := range batch {
+		b.calls = append(b.calls, cc.call)
+	}
+
+	// execute is sent past middlewares, because they have already seen every call of the batch
+	apiErr, err := c.sendBatch(ctx, b, pending.token)
+
+	if apiErr != nil || err != nil {
+		for _, cc := range batch {
+			cc.apiErr, cc.err = apiErr, err
+		}
+	}
+}
+
+// batchContext returns context of the request of batch calls that is done when contexts of all callers are done.
+func batchContext(batch []*coalescedCall) (context.Context, context.CancelFunc) {
+	if len(batch) == 1 {
+		return context.WithCancel(batch[0].ctx)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	waiting := int32(len(batch))
+
+	for _, cc := range batch {
+		done := cc.ctx.Done()
+
+		// the call is waited until the end
+		if done == nil {
+			continue
+		}
+
+		go func() {
+			select {
+			case <-done:
+				if atomic.AddInt32(&waiting, -1) == 0 {
+					cancel()
+				}
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	return ctx, cancel
+}
+
+// sendBatch sends calls of ExecuteBatch within execute request with token of the calls.
+func (c *coalescer) sendBatch(ctx context.Context, b *ExecuteBatch, token batchToken) (ApiError, error) {
+	code, err := b.Code()
+
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(url.Values, 3)
+	setString(values, "code", code)
+
+	if token.own {
+		setString(values, tokenKey, token.token)
+	}
+
+	var resp Execute_Response
+
+	if apiErr, err := c.vk.send("execute", ctx, values, &resp); apiErr != nil || err != nil {
+		return apiErr, err
+	}
+
+	return nil, b.setResults(resp, c.vk)
+}
@@ -0,0 +1,197 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestVK_SetCoalescing(t *testing.T) {
+	var requests int
+
+	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		requests++
+
+		assert.Equal(t, "/"+apiPath+"/execute", req.URL.Path)
+
+		reqBody, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		values, err := url.ParseQuery(string(reqBody))
+		require.NoError(t, err)
+
+		code := values.Get("code")
+		assert.Equal(t, 3, strings.Count(code, "API.users.get"))
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"response":[[{"id":1}],[{"id":1}],[{"id":1}]]}`)),
+		}, nil
+	})
+
+	vk := NewVK(&http.Client{Transport: transport})
+	vk.SetCoalescing(50 * time.Millisecond)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+			assert.NoError(t, err)
+			assert.Nil(t, apiErr)
+			if assert.Len(t, resp.Response, 1) {
+				assert.Equal(t, 1, resp.Response[0].Id)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 1, requests)
+}
+
+type tokenCtxKey struct{}
+
+func TestVK_SetCoalescing_Tokens(t *testing.T) {
+	var mu sync.Mutex
+	requests := make(map[string][]string)
+
+	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		reqBody, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		values, err := url.ParseQuery(string(reqBody))
+		require.NoError(t, err)
+
+		token := values.Get(tokenKey)
+		method := strings.TrimPrefix(req.URL.Path, "/"+apiPath+"/")
+
+		mu.Lock()
+		requests[token] = append(requests[token], method)
+		mu.Unlock()
+
+		body := `{"response":[{"id":1}]}`
+		if method == "execute" {
+			body = `{"response":[[{"id":1}],[{"id":1}]]}`
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		}, nil
+	})
+
+	vk := NewVK(&http.Client{Transport: transport}, "client")
+	vk.SetCoalescing(50 * time.Millisecond)
+	vk.Use(func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (ApiError, error) {
+			if token, ok := ctx.Value(tokenCtxKey{}).(string); ok {
+				inv.Values.Set(tokenKey, token)
+			}
+
+			return next(ctx, inv)
+		}
+	})
+
+	var wg sync.WaitGroup
+
+	for _, token := range []string{"first", "first", "second", ""} {
+		ctx := context.Background()
+		if token != "" {
+			ctx = context.WithValue(ctx, tokenCtxKey{}, token)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, apiErr, err := vk.Users_Get(ctx, Users_Get_Request{})
+			assert.NoError(t, err)
+			assert.Nil(t, apiErr)
+			if assert.Len(t, resp.Response, 1) {
+				assert.Equal(t, 1, resp.Response[0].Id)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, map[string][]string{
+		"first":  {"execute"},
+		"second": {"users.get"},
+		"client": {"users.get"},
+	}, requests)
+}
+
+func TestCanCoalesce(t *testing.T) {
+	tests := []struct {
+		method string
+		values url.Values
+		want   bool
+	}{
+		{method: "users.get", want: true},
+		{method: "users.get", values: url.Values{tokenKey: {"token"}}, want: true},
+		{method: "users.get", values: url.Values{"lang": {"3"}}},
+		{method: "users.get", values: url.Values{"test_mode": {"1"}}},
+		{method: "execute"},
+		{method: "execute.myProcedure"},
+		{method: "auth.restore"},
+		{method: "photos.getWallUploadServer"},
+		{method: "video.save"},
+		{method: "users.get(1);return 1;//"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, canCoalesce(tt.method, tt.values), tt.method, tt.values)
+	}
+}
+
+func TestVK_SetCoalescing_Cancel(t *testing.T) {
+	cancelled := make(chan struct{})
+
+	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		close(cancelled)
+
+		return nil, req.Context().Err()
+	})
+
+	vk := NewVK(&http.Client{Transport: transport})
+	vk.SetCoalescing(10 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, _, err := vk.Users_Get(ctx, Users_Get_Request{})
+			assert.ErrorIs(t, err, context.Canceled)
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("execute request is not cancelled")
+	}
+}
@@ -0,0 +1,209 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// maxPooledBufferSize is maximum capacity of response buffer that is returned to the pool,
+// so rare huge responses do not hold memory.
+const maxPooledBufferSize = 4 << 20
+
+// responseBuffers pools buffers of response bodies.
+var responseBuffers = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// parseResponse reads response body to pooled buffer and decodes it to dst or ApiError.
+func (vk *VK) parseResponse(methodName string, resp *http.Response, dst interface{}) (apiErr ApiError, err error) {
+	defer func() {
+		if closeErr := resp.Body.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	buf := responseBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			responseBuffers.Put(buf)
+		}
+	}()
+
+	if resp.ContentLength > 0 {
+		buf.Grow(int(resp.ContentLength))
+	}
+
+	if _, err = buf.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+
+	return decodeResponse(buf.Bytes(), dst, vk.unmarshaler(methodName))
+}
+
+// decodeResponse decodes API response dispatching on the first key of top-level object.
+// The first key is read by lexer, then the lexer decodes value of "error" key as ApiError
+// or, being moved back to the start of the object, the object with error fields at the top level as ApiError
+// and other object to dst by its generated unmarshaler, so only the first key is read twice.
+// If unmarshal is not nil, e.g. in lenient mode or with drift report, it decodes the whole data once more,
+// as well as encoding/json does for dst that has no generated unmarshaler.
+// Decoded values do not refer to data, so it may be reused.
+func decodeResponse(data []byte, dst interface{}, unmarshal unmarshalFunc) (ApiError, error) {
+	in := jlexer.Lexer{Data: data}
+	// lexer state at the start of the object
+	start := in
+
+	in.Delim('{')
+
+	if !in.Ok() || in.IsDelim('}') {
+		return nil, in.Error()
+	}
+
+	key := in.UnsafeFieldName(false)
+	in.WantColon()
+
+	switch {
+	case key == "error":
+		var apiErr apiError
+
+		apiErr.UnmarshalEasyJSON(&in)
+
+		if err := in.Error(); err != nil {
+			return nil, err
+		}
+
+		return &apiErr, nil
+	case strings.HasPrefix(key, "error_"):
+		var apiErr apiError
+
+		apiErr.UnmarshalEasyJSON(&start)
+
+		if err := start.Error(); err != nil {
+			return nil, err
+		}
+
+		return &apiErr, nil
+	}
+
+	if unmarshal != nil {
+		return nil, unmarshal(data, dst)
+	}
+
+	u, ok := dst.(easyjson.Unmarshaler)
+	if !ok {
+		return nil, unmarshalResponse(data, dst)
+	}
+
+	u.UnmarshalEasyJSON(&start)
+	start.Consumed()
+
+	return nil, start.Error()
+}
+
+// unmarshalResponse unmarshal data to dst by generated easyjson unmarshaler if dst implements it.
+func unmarshalResponse(data []byte, dst interface{}) error {
+	if u, ok := dst.(easyjson.Unmarshaler); ok {
+		return easyjson.Unmarshal(data, u)
+	}
+
+	return json.Unmarshal(data, &dst)
+}
+
+// UnmarshalEasyJSON decodes error object of API response.
+func (e *apiError) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if in.IsNull() {
+		in.Skip()
+		return
+	}
+
+	in.Delim('{')
+
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+
+		switch key {
+		case "error_code":
+			e.ErrorCode = in.Int()
+		case "error_subcode":
+			subcode := in.Int()
+			e.ErrorSubcode = &subcode
+		case "error_msg":
+			e.ErrorMsg = in.String()
+		case "error_text":
+			e.ErrorText = in.String()
+		case "request_params":
+			e.ReqParams = decodeRequestParams(in)
+		case "redirect_uri":
+			e.RedirURI = decodeStringPtr(in)
+		case "confirmation_text":
+			e.ConfirmText = decodeStringPtr(in)
+		case "captcha_sid":
+			e.CaptchaSID = decodeStringPtr(in)
+		case "captcha_img":
+			e.CaptchaImg = decodeStringPtr(in)
+		default:
+			in.SkipRecursive()
+		}
+
+		in.WantComma()
+	}
+
+	in.Delim('}')
+}
+
+func decodeRequestParams(in *jlexer.Lexer) []RequestParam {
+	params := make([]RequestParam, 0)
+
+	in.Delim('[')
+
+	for !in.IsDelim(']') {
+		var p RequestParam
+
+		in.Delim('{')
+
+		for !in.IsDelim('}') {
+			key := in.UnsafeFieldName(false)
+			in.WantColon()
+
+			switch key {
+			case "key":
+				p.Key = in.String()
+			case "value":
+				p.Value = in.String()
+			default:
+				in.SkipRecursive()
+			}
+
+			in.WantComma()
+		}
+
+		in.Delim('}')
+
+		params = append(params, p)
+		in.WantComma()
+	}
+
+	in.Delim(']')
+
+	return params
+}
+
+func decodeStringPtr(in *jlexer.Lexer) *string {
+	s := in.String()
+	return &s
+}
@@ -0,0 +1,129 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+func TestDecodeResponse(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		data := []byte(`{"error":{"error_code":5,"error_msg":"User authorization failed","request_params":[{"key":"v","value":"5.131"}]}}`)
+
+		var resp Users_Get_Response
+		apiErr, err := decodeResponse(data, &resp, unmarshalResponse)
+
+		require.NoError(t, err)
+		require.NotNil(t, apiErr)
+		assert.True(t, apiErr.Is(Error_Auth))
+		assert.Equal(t, "User authorization failed", apiErr.Msg())
+		assert.Equal(t, []RequestParam{{Key: "v", Value: "5.131"}}, apiErr.RequestParams())
+		assert.Empty(t, resp)
+	})
+
+	t.Run("top-level error", func(t *testing.T) {
+		var resp Users_Get_Response
+		apiErr, err := decodeResponse([]byte(`{"error_code":6,"error_msg":"Too many requests per second"}`), &resp, unmarshalResponse)
+
+		require.NoError(t, err)
+		require.NotNil(t, apiErr)
+		assert.True(t, apiErr.Is(Error_TooMany))
+	})
+
+	t.Run("response", func(t *testing.T) {
+		var resp Users_Get_Response
+		apiErr, err := decodeResponse([]byte(`{"response":[{"id":1,"first_name":"A"}]}`), &resp, unmarshalResponse)
+
+		require.NoError(t, err)
+		assert.Nil(t, apiErr)
+		require.Len(t, resp.Response, 1)
+		assert.Equal(t, 1, resp.Response[0].Id)
+	})
+
+	t.Run("execute errors", func(t *testing.T) {
+		var resp Execute_Response
+		apiErr, err := decodeResponse([]byte(`{"response":[false],"execute_errors":[{"method":"users.get","error_code":15}]}`), &resp, unmarshalResponse)
+
+		require.NoError(t, err)
+		assert.Nil(t, apiErr)
+		require.Len(t, resp.ExecuteErrors, 1)
+		assert.Equal(t, "users.get", resp.ExecuteErrors[0].Method)
+	})
+
+	t.Run("generated unmarshaler", func(t *testing.T) {
+		var resp Execute_Response
+		apiErr, err := decodeResponse([]byte(`{"response":[1,false],"execute_errors":[{"method":"users.get","error_code":15}]}`), &resp, nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, apiErr)
+		assert.JSONEq(t, `[1,false]`, string(resp.Response))
+		require.Len(t, resp.ExecuteErrors, 1)
+		assert.Equal(t, 15, resp.ExecuteErrors[0].ErrorCode)
+
+		var users Users_Get_Response
+		_, err = decodeResponse([]byte(`{"response":[{"id":1}]} {}`), &users, nil)
+		assert.Error(t, err)
+
+		var v map[string]interface{}
+		apiErr, err = decodeResponse([]byte(`{"response":1}`), &v, nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, apiErr)
+		assert.Equal(t, map[string]interface{}{"response": float64(1)}, v)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		var resp Users_Get_Response
+
+		_, err := decodeResponse([]byte(`{"response":[{"id":1}`), &resp, unmarshalResponse)
+		assert.Error(t, err)
+
+		_, err = decodeResponse([]byte(`{"error":"unknown"}`), &resp, unmarshalResponse)
+		assert.Error(t, err)
+	})
+
+	t.Run("reused data", func(t *testing.T) {
+		item := `{"type":"post","source_id":1,"date":2,"post_id":3}`
+		data := []byte(`{"response":{"items":[` + item + `],"groups":[],"profiles":[]}}`)
+
+		var resp Newsfeed_Generic_Response
+		apiErr, err := decodeResponse(data, &resp, unmarshalResponse)
+
+		require.NoError(t, err)
+		assert.Nil(t, apiErr)
+
+		copy(data, bytes.Repeat([]byte(" "), len(data)))
+
+		require.Len(t, resp.Response.Items, 1)
+		assert.JSONEq(t, item, string(resp.Response.Items[0].Raw()))
+	})
+}
+
+func TestVK_parseResponse_ReusesBuffers(t *testing.T) {
+	responses := []string{
+		`{"response":[{"id":1,"first_name":"First"}]}`,
+		`{"error":{"error_code":10,"error_msg":"Internal server error"}}`,
+		`{"response":[{"id":2,"first_name":"Second"}]}`,
+	}
+
+	vk := NewVK(newSequenceTestClient(t, responses, func(int, url.Values) {}), "")
+
+	first, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+
+	_, apiErr, err = vk.Users_Get(context.Background(), Users_Get_Request{})
+	require.NoError(t, err)
+	require.NotNil(t, apiErr)
+	assert.True(t, apiErr.Is(Error_Server))
+
+	second, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+
+	assert.Equal(t, "First", *first.Response[0].FirstName)
+	assert.Equal(t, "Second", *second.Response[0].FirstName)
+}
@@ -0,0 +1,199 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// UnknownField is key of response object that is absent in its generated type,
+// so the value is dropped by decoding. It usually means that vk-api-schema is outdated.
+type UnknownField struct {
+	// Type is generated type of the object, e.g. "vk_sdk.Users_UserFull".
+	// Anonymous types are named by the outer type and JSON path, e.g. "vk_sdk.Users_Search_Response.response".
+	Type string `json:"type"`
+	// Field is the unknown key.
+	Field string `json:"field"`
+	// Method and Path are API method and JSON path of the first met field, e.g. "response[].items[].online_info".
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Count is number of objects with the field.
+	Count int `json:"count"`
+}
+
+// DriftReport collects unknown fields of method responses by types.
+// It is safe for concurrent use.
+//
+//    report := vk_sdk.NewDriftReport()
+//    vk.SetDriftReport(report)
+//    ...
+//    for _, f := range report.UnknownFields() {
+//        log.Printf("%s.%s: %d times, e.g. %s %s", f.Type, f.Field, f.Count, f.Method, f.Path)
+//    }
+type DriftReport struct {
+	mu     sync.Mutex
+	fields map[unknownFieldKey]*UnknownField
+}
+
+type unknownFieldKey struct {
+	typ, field string
+}
+
+// NewDriftReport create and return new empty DriftReport.
+func NewDriftReport() *DriftReport {
+	return &DriftReport{
+		fields: make(map[unknownFieldKey]*UnknownField),
+	}
+}
+
+// SetDriftReport enables recording of unknown fields of method responses to report.
+// Every response is walked once more after decoding, so it is opt-in.
+// Pass nil to disable recording.
+func (vk *VK) SetDriftReport(report *DriftReport) {
+	vk.driftReport = report
+}
+
+// UnknownFields returns recorded fields sorted by type and field.
+func (r *DriftReport) UnknownFields() []UnknownField {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fields := make([]UnknownField, 0, len(r.fields))
+	for _, f := range r.fields {
+		fields = append(fields, *f)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].Type != fields[j].Type {
+			return fields[i].Type < fields[j].Type
+		}
+
+		return fields[i].Field < fields[j].Field
+	})
+
+	return fields
+}
+
+// Counts returns total number of unknown fields met in objects of every type.
+func (r *DriftReport) Counts() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[string]int)
+	for key, f := range r.fields {
+		counts[key.typ] += f.Count
+	}
+
+	return counts
+}
+
+// Reset removes all recorded fields.
+func (r *DriftReport) Reset() {
+	r.mu.Lock()
+	r.fields = make(map[unknownFieldKey]*UnknownField)
+	r.mu.Unlock()
+}
+
+// String returns the report as lines "type.field count method path" sorted by type and field.
+func (r *DriftReport) String() string {
+	var b strings.Builder
+
+	for _, f := range r.UnknownFields() {
+		fmt.Fprintf(&b, "%s.%s %d %s %s\n", f.Type, f.Field, f.Count, f.Method, f.Path)
+	}
+
+	return b.String()
+}
+
+// inspect records unknown fields of decoded response data of method to dst type.
+func (r *DriftReport) inspect(methodName string, data []byte, dst interface{}) {
+	if dst == nil {
+		return
+	}
+
+	var v interface{}
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	if d.Decode(&v) != nil {
+		return
+	}
+
+	t := reflect.TypeOf(dst)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.walk(methodName, t, t.String(), v, "")
+}
+
+// walk records unknown fields of value v of type t with name typeName. Must be called with locked mutex.
+func (r *DriftReport) walk(methodName string, t reflect.Type, typeName string, v interface{}, path string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Name() != "" {
+		typeName = t.String()
+	}
+
+	// values of custom unmarshalers like oneOf objects are not known, but generated easyjson ones are
+	if pt := reflect.PtrTo(t); pt.Implements(jsonUnmarshalerType) && !pt.Implements(easyjsonUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		fields := jsonFields(t)
+
+		for key, fv := range obj {
+			ft, ok := fields[key]
+			if !ok {
+				r.add(methodName, typeName, key, joinPath(path, key))
+				continue
+			}
+
+			r.walk(methodName, ft, typeName+"."+key, fv, joinPath(path, key))
+		}
+	case reflect.Map:
+		if obj, ok := v.(map[string]interface{}); ok {
+			for _, fv := range obj {
+				r.walk(methodName, t.Elem(), typeName+"[]", fv, path+"[]")
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if arr, ok := v.([]interface{}); ok {
+			for _, item := range arr {
+				r.walk(methodName, t.Elem(), typeName+"[]", item, path+"[]")
+			}
+		}
+	}
+}
+
+// add records unknown field. Must be called with locked mutex.
+func (r *DriftReport) add(methodName, typeName, field, path string) {
+	key := unknownFieldKey{typ: typeName, field: field}
+
+	if f, ok := r.fields[key]; ok {
+		f.Count++
+		return
+	}
+
+	r.fields[key] = &UnknownField{
+		Type:   typeName,
+		Field:  field,
+		Method: methodName,
+		Path:   path,
+		Count:  1,
+	}
+}
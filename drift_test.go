@@ -0,0 +1,54 @@
+package vk_sdk
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+func TestVK_SetDriftReport(t *testing.T) {
+	responses := []string{
+		`{"response":[{"id":1,"unknown_info":{"visible":true},"city":{"id":2,"title":"A","region":"B"}},{"id":3,"unknown_info":{}}]}`,
+		`{"response":{"count":1,"items":[{"id":4,"unknown_info":{}}],"next_from":"x"}}`,
+		`{"response":[{"id":5,"unknown_info":{}}]}`,
+	}
+
+	vk := NewVK(newSequenceTestClient(t, responses, func(int, url.Values) {}))
+
+	report := NewDriftReport()
+	vk.SetDriftReport(report)
+
+	_, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+
+	resp, apiErr, err := vk.Users_Search(context.Background(), Users_Search_Request{})
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	assert.Equal(t, 4, resp.Response.Items[0].Id)
+
+	assert.Equal(t, []UnknownField{
+		{Type: "vk_sdk.Base_City", Field: "region", Method: "users.get", Path: "response[].city.region", Count: 1},
+		{Type: "vk_sdk.Users_Search_Response.response", Field: "next_from", Method: "users.search", Path: "response.next_from", Count: 1},
+		{Type: "vk_sdk.Users_UserFull", Field: "unknown_info", Method: "users.get", Path: "response[].unknown_info", Count: 3},
+	}, report.UnknownFields())
+
+	assert.Equal(t, map[string]int{
+		"vk_sdk.Base_City":                      1,
+		"vk_sdk.Users_Search_Response.response": 1,
+		"vk_sdk.Users_UserFull":                 3,
+	}, report.Counts())
+
+	assert.Equal(t, "vk_sdk.Base_City.region 1 users.get response[].city.region\n"+
+		"vk_sdk.Users_Search_Response.response.next_from 1 users.search response.next_from\n"+
+		"vk_sdk.Users_UserFull.unknown_info 3 users.get response[].unknown_info\n", report.String())
+
+	report.Reset()
+	vk.SetDriftReport(nil)
+
+	_, _, err = vk.Users_Get(context.Background(), Users_Get_Request{})
+	require.NoError(t, err)
+	assert.Empty(t, report.UnknownFields())
+}
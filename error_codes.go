@@ -660,3 +660,67 @@ const (
 	//  IsGlobal: false
 	Error_FaveAliexpressTag ErrorCode = 3800
 )
+
+// IsGlobal reports whether the error may be returned by any method.
+func (c ErrorCode) IsGlobal() bool {
+	switch c {
+	case Error_Unknown,
+		Error_Disabled,
+		Error_Method,
+		Error_Signature,
+		Error_Auth,
+		Error_TooMany,
+		Error_Permission,
+		Error_Request,
+		Error_Flood,
+		Error_Server,
+		Error_EnabledInTest,
+		Error_Captcha,
+		Error_Access,
+		Error_AuthHttps,
+		Error_AuthValidation,
+		Error_UserDeleted,
+		Error_MethodPermission,
+		Error_MethodAds,
+		Error_MethodDisabled,
+		Error_NeedConfirmation,
+		Error_NeedTokenConfirmation,
+		Error_GroupAuth,
+		Error_AppAuth,
+		Error_RateLimit,
+		Error_PrivateProfile,
+		Error_NotImplementedYet,
+		Error_ClientVersionDeprecated,
+		Error_UserBanned,
+		Error_UnknownApplication,
+		Error_UnknownUser,
+		Error_UnknownGroup,
+		Error_AdditionalSignupRequired,
+		Error_IpIsNotAllowed,
+		Error_Param,
+		Error_ParamApiId,
+		Error_ParamUserId,
+		Error_ParamTimestamp,
+		Error_AccessAlbum,
+		Error_AccessAudio,
+		Error_AccessGroup,
+		Error_AlbumFull,
+		Error_VotesPermission,
+		Error_AdsPermission,
+		Error_AdsSpecific,
+		Error_AuthAnonymousTokenHasExpired,
+		Error_AuthAnonymousTokenIsInvalid,
+		Error_Recaptcha,
+		Error_PhoneValidationNeed,
+		Error_PasswordValidationNeed,
+		Error_OtpValidationNeed,
+		Error_EmailConfirmationNeed,
+		Error_AssertVotes,
+		Error_TokenExtensionRequired,
+		Error_UserDeactivated,
+		Error_UserServiceDeactivated:
+		return true
+	}
+
+	return false
+}
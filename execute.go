@@ -0,0 +1,270 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MaxExecuteCalls is the maximum number of API methods calls within a single execute request.
+const MaxExecuteCalls = 25
+
+// executeMethodName matches names of API methods that are written to VKScript code.
+var executeMethodName = regexp.MustCompile(`^[a-z]+\.[a-zA-Z]+$`)
+
+// ExecuteError is an error of API method called within execute.
+type ExecuteError struct {
+	apiError
+	Method string `json:"method"`
+}
+
+// MethodName returns name of the failed method.
+func (e ExecuteError) MethodName() string {
+	return e.Method
+}
+
+type Execute_Response struct {
+	// Value returned by the code.
+	Response json.RawMessage `json:"response"`
+	// Errors of API methods called by the code.
+	ExecuteErrors []ExecuteError `json:"execute_errors,omitempty"`
+}
+
+// Execute A universal method for calling a sequence of other methods while saving and filtering interim results.
+// Code is algorithm in VKScript - a format similar to JavaScript or ActionScript (assumed compatibility with ECMAScript).
+// The algorithm should end with return %expression% operator. The operators must be separated by a semicolon.
+// May execute with listed access token types:
+//    [ user, group, service ]
+// When executing method, may return one of global or with listed codes API errors:
+//    [ Error_Compile, Error_Runtime ]
+//
+// https://dev.vk.com/method/execute
+func (vk *VK) Execute(ctx context.Context, code string, options ...Option) (resp Execute_Response, apiErr ApiError, err error) {
+	values := make(url.Values, 3+len(options))
+	setString(values, "code", code)
+	setOptions(values, options)
+	apiErr, err = vk.doReq("execute", ctx, values, &resp)
+	return
+}
+
+// request is interface that implement all generated method requests.
+type request interface {
+	fillIn(values url.Values) error
+}
+
+// ExecuteCall is API method call added to ExecuteBatch.
+type ExecuteCall struct {
+	method string
+	params map[string]string
+	dst    interface{}
+	result json.RawMessage
+	apiErr ApiError
+	err    error
+}
+
+// newExecuteCall create and return new ExecuteCall with parameters from values.
+func newExecuteCall(methodName string, values url.Values, dst interface{}) *ExecuteCall {
+	params := make(map[string]string, len(values))
+	for k := range values {
+		// execute is sent with its own token and version
+		if k == tokenKey || k == versionKey {
+			continue
+		}
+
+		params[k] = values.Get(k)
+	}
+
+	return &ExecuteCall{
+		method: methodName,
+		params: params,
+		dst:    dst,
+	}
+}
+
+// ApiError returns error of the call after ExecuteBatch is done, if present.
+func (c *ExecuteCall) ApiError() ApiError {
+	return c.apiErr
+}
+
+// Err returns error of the call result after ExecuteBatch is done, if present.
+// It is set if the call returned false without matching execute error and false can not be unmarshalled to dst.
+func (c *ExecuteCall) Err() error {
+	return c.err
+}
+
+// ExecuteBatch is builder of execute request with up to MaxExecuteCalls calls
+// of generated methods. Use VK.ExecuteBatch to send it.
+//
+//    var users vk_sdk.Users_Get_Response
+//    var friends vk_sdk.Friends_Get_Response
+//
+//    batch := vk_sdk.NewExecuteBatch()
+//    usersCall, _ := batch.Add("users.get", vk_sdk.Users_Get_Request{...}, &users)
+//    friendsCall, _ := batch.Add("friends.get", vk_sdk.Friends_Get_Request{...}, &friends)
+//
+//    apiErr, err := vk.ExecuteBatch(ctx, batch)
+type ExecuteBatch struct {
+	calls []*ExecuteCall
+}
+
+// NewExecuteBatch create and return new ExecuteBatch.
+func NewExecuteBatch() *ExecuteBatch {
+	return &ExecuteBatch{
+		calls: make([]*ExecuteCall, 0, MaxExecuteCalls),
+	}
+}
+
+// Len returns number of added calls.
+func (b *ExecuteBatch) Len() int {
+	return len(b.calls)
+}
+
+// Add adds call of method with request from generated *_Request structs.
+// Req may be nil for methods without parameters.
+// Dst is pointer to matching generated *_Response struct, it may be nil to skip the result.
+// Method name must be like "users.get", because it is written to VKScript code as is.
+func (b *ExecuteBatch) Add(methodName string, req request, dst interface{}, options ...Option) (*ExecuteCall, error) {
+	if len(b.calls) >= MaxExecuteCalls {
+		return nil, fmt.Errorf("execute batch can not contain more than %d calls", MaxExecuteCalls)
+	}
+
+	if !executeMethodName.MatchString(methodName) {
+		return nil, fmt.Errorf("invalid method name %q", methodName)
+	}
+
+	values := make(url.Values, len(options))
+
+	if req != nil {
+		if err := req.fillIn(values); err != nil {
+			return nil, err
+		}
+	}
+
+	setOptions(values, options)
+
+	call := newExecuteCall(methodName, values, dst)
+
+	b.calls = append(b.calls, call)
+
+	return call, nil
+}
+
+// Code returns VKScript code that calls all added methods and returns array of their results.
+func (b *ExecuteBatch) Code() (string, error) {
+	var code strings.Builder
+
+	code.WriteString("return [")
+
+	for i, call := range b.calls {
+		params, err := json.Marshal(call.params)
+
+		if err != nil {
+			return "", err
+		}
+
+		if i > 0 {
+			code.WriteByte(',')
+		}
+
+		code.WriteString("API.")
+		code.WriteString(call.method)
+		code.WriteByte('(')
+		code.Write(params)
+		code.WriteByte(')')
+	}
+
+	code.WriteString("];")
+
+	return code.String(), nil
+}
+
+// ExecuteBatch sends all calls of ExecuteBatch within a single execute request.
+// Results of calls are unmarshalled to their destinations,
+// errors of failed calls are available by ExecuteCall.ApiError and ExecuteCall.Err.
+// Returned ApiError is error of execute method itself.
+func (vk *VK) ExecuteBatch(ctx context.Context, b *ExecuteBatch, options ...Option) (ApiError, error) {
+	if len(b.calls) == 0 {
+		return nil, errors.New("execute batch is empty")
+	}
+
+	code, err := b.Code()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, apiErr, err := vk.Execute(ctx, code, options...)
+
+	if apiErr != nil || err != nil {
+		return apiErr, err
+	}
+
+	return nil, b.setResults(resp, vk)
+}
+
+// setResults splits execute response and errors into calls results
+// and unmarshal them in decode mode of vk.
+func (b *ExecuteBatch) setResults(resp Execute_Response, vk *VK) error {
+	var results []json.RawMessage
+
+	if err := json.Unmarshal(resp.Response, &results); err != nil {
+		return err
+	}
+
+	if len(results) != len(b.calls) {
+		return fmt.Errorf("execute returned %d results for %d calls", len(results), len(b.calls))
+	}
+
+	errIdx := 0
+
+	for i, call := range b.calls {
+		result := bytes.TrimSpace(results[i])
+
+		if bytes.Equal(result, []byte("false")) && errIdx < len(resp.ExecuteErrors) &&
+			resp.ExecuteErrors[errIdx].Method == call.method {
+			executeErr := resp.ExecuteErrors[errIdx]
+			call.apiErr = &executeErr
+			errIdx++
+			continue
+		}
+
+		call.result = result
+
+		err := call.unmarshalResult(call.dst, vk.unmarshaler(call.method))
+
+		// false is returned by failed call, even if its error is not listed
+		if err != nil && bytes.Equal(result, []byte("false")) {
+			call.err = fmt.Errorf("execute call %d (%s) returned false: %w", i, call.method, err)
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("execute call %d (%s): %w", i, call.method, err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalResult unmarshal call result to dst as method response.
+func (c *ExecuteCall) unmarshalResult(dst interface{}, unmarshal unmarshalFunc) error {
+	if dst == nil || c.result == nil {
+		return nil
+	}
+
+	if unmarshal == nil {
+		unmarshal = unmarshalResponse
+	}
+
+	wrapped := make([]byte, 0, len(c.result)+len(`{"response":}`))
+	wrapped = append(wrapped, `{"response":`...)
+	wrapped = append(wrapped, c.result...)
+	wrapped = append(wrapped, '}')
+
+	return unmarshal(wrapped, dst)
+}
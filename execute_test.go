@@ -0,0 +1,97 @@
+package vk_sdk
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+func TestVK_ExecuteBatch(t *testing.T) {
+	batch := NewExecuteBatch()
+
+	var users Users_Get_Response
+	var friends Friends_GetOnline_Response
+
+	userIDs := []string{"1", "2"}
+	usersCall, err := batch.Add("users.get", Users_Get_Request{UserIds: &userIDs}, &users)
+	require.NoError(t, err)
+
+	friendsCall, err := batch.Add("friends.getOnline", Friends_GetOnline_Request{}, &friends, Lang(English))
+	require.NoError(t, err)
+
+	code, err := batch.Code()
+	require.NoError(t, err)
+	assert.Equal(t, `return [API.users.get({"user_ids":"1,2"}),API.friends.getOnline({"lang":"3"})];`, code)
+
+	values := make(url.Values)
+	setString(values, "code", code)
+
+	responseJSON := []byte(`{"response":[[{"id":1,"first_name":"A"}],false],"execute_errors":[{"method":"friends.getOnline","error_code":15,"error_msg":"Access denied"}]}`)
+
+	token := randString()
+	vk := NewVK(NewTestClient(t, token, "execute", values, responseJSON), token)
+
+	apiErr, err := vk.ExecuteBatch(context.Background(), batch)
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+
+	assert.Nil(t, usersCall.ApiError())
+	require.Len(t, users.Response, 1)
+	assert.Equal(t, 1, users.Response[0].Id)
+
+	require.NotNil(t, friendsCall.ApiError())
+	assert.True(t, friendsCall.ApiError().Is(Error_Access))
+	assert.Equal(t, "friends.getOnline", friendsCall.ApiError().(*ExecuteError).MethodName())
+}
+
+func TestExecuteBatch_Add(t *testing.T) {
+	batch := NewExecuteBatch()
+
+	_, err := batch.Add("users.get(1);return 1;//", nil, nil)
+	assert.Error(t, err)
+
+	_, err = batch.Add("users", nil, nil)
+	assert.Error(t, err)
+
+	_, err = batch.Add("friends.getOnline", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batch.Len())
+}
+
+func TestVK_ExecuteBatch_UnpairedFalse(t *testing.T) {
+	batch := NewExecuteBatch()
+
+	var users Users_Get_Response
+	var friends Friends_GetOnline_Response
+
+	friendsCall, err := batch.Add("friends.getOnline", nil, &friends)
+	require.NoError(t, err)
+
+	usersCall, err := batch.Add("users.get", nil, &users)
+	require.NoError(t, err)
+
+	code, err := batch.Code()
+	require.NoError(t, err)
+
+	values := make(url.Values)
+	setString(values, "code", code)
+
+	// error of the first call is not listed
+	responseJSON := []byte(`{"response":[false,[{"id":1}]]}`)
+
+	token := randString()
+	vk := NewVK(NewTestClient(t, token, "execute", values, responseJSON), token)
+
+	apiErr, err := vk.ExecuteBatch(context.Background(), batch)
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+
+	assert.Nil(t, friendsCall.ApiError())
+	assert.Error(t, friendsCall.Err())
+
+	assert.NoError(t, usersCall.Err())
+	require.Len(t, users.Response, 1)
+	assert.Equal(t, 1, users.Response[0].Id)
+}
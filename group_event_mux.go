@@ -0,0 +1,354 @@
+package vk_sdk
+
+import (
+	"context"
+	"sync"
+)
+
+type groupEventCtxKey struct{}
+
+// GroupEventFromContext returns GroupEvent passed to handler registered in GroupEventMux.
+func GroupEventFromContext(ctx context.Context) (GroupEvent, bool) {
+	e, ok := ctx.Value(groupEventCtxKey{}).(GroupEvent)
+	return e, ok
+}
+
+// GroupEventMux is community events multiplexer.
+// It decodes every event by GroupEvent.Decode and calls handler registered for the event type.
+// Event without registered handler is passed to default handler if it is set.
+//
+// GroupEventMux.HandleEvent can be used with GroupLongPoll and CallbackHandler:
+//
+//	mux := vk_sdk.NewGroupEventMux()
+//	mux.OnMessageNew(func(ctx context.Context, e vk_sdk.Callback_MessageNew) {
+//	    ...
+//	})
+//	lp.Run(ctx, mux.HandleEvent)
+type GroupEventMux struct {
+	mu             sync.RWMutex
+	handlers       map[Callback_Type]func(ctx context.Context, obj interface{})
+	defaultHandler GroupEventHandler
+	errHandler     func(ctx context.Context, e GroupEvent, err error)
+}
+
+// NewGroupEventMux create and return new GroupEventMux.
+func NewGroupEventMux() *GroupEventMux {
+	return &GroupEventMux{
+		handlers: make(map[Callback_Type]func(ctx context.Context, obj interface{})),
+	}
+}
+
+// HandleEvent decodes event and calls registered handler. It implements GroupEventHandler.
+func (m *GroupEventMux) HandleEvent(ctx context.Context, e GroupEvent) {
+	m.mu.RLock()
+	handler, ok := m.handlers[e.Type]
+	defaultHandler := m.defaultHandler
+	errHandler := m.errHandler
+	m.mu.RUnlock()
+
+	ctx = context.WithValue(ctx, groupEventCtxKey{}, e)
+
+	if !ok {
+		if defaultHandler != nil {
+			defaultHandler(ctx, e)
+		}
+		return
+	}
+
+	obj, err := e.Decode()
+
+	if err != nil {
+		if errHandler != nil {
+			errHandler(ctx, e, err)
+		}
+		return
+	}
+
+	handler(ctx, obj)
+}
+
+// OnDefault set handler for events without registered typed handler.
+func (m *GroupEventMux) OnDefault(handler GroupEventHandler) {
+	m.mu.Lock()
+	m.defaultHandler = handler
+	m.mu.Unlock()
+}
+
+// OnError set handler for events which could not be decoded.
+func (m *GroupEventMux) OnError(handler func(ctx context.Context, e GroupEvent, err error)) {
+	m.mu.Lock()
+	m.errHandler = handler
+	m.mu.Unlock()
+}
+
+func (m *GroupEventMux) on(t Callback_Type, handler func(ctx context.Context, obj interface{})) {
+	m.mu.Lock()
+	m.handlers[t] = handler
+	m.mu.Unlock()
+}
+
+// OnMessageNew set handler for Callback_Type_MessageNew events.
+func (m *GroupEventMux) OnMessageNew(handler func(ctx context.Context, e Callback_MessageNew)) {
+	m.on(Callback_Type_MessageNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MessageNew))
+	})
+}
+
+// OnMessageReply set handler for Callback_Type_MessageReply events.
+func (m *GroupEventMux) OnMessageReply(handler func(ctx context.Context, e Callback_MessageReply)) {
+	m.on(Callback_Type_MessageReply, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MessageReply))
+	})
+}
+
+// OnMessageEdit set handler for Callback_Type_MessageEdit events.
+func (m *GroupEventMux) OnMessageEdit(handler func(ctx context.Context, e Callback_MessageEdit)) {
+	m.on(Callback_Type_MessageEdit, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MessageEdit))
+	})
+}
+
+// OnMessageAllow set handler for Callback_Type_MessageAllow events.
+func (m *GroupEventMux) OnMessageAllow(handler func(ctx context.Context, e Callback_MessageAllow)) {
+	m.on(Callback_Type_MessageAllow, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MessageAllow))
+	})
+}
+
+// OnMessageDeny set handler for Callback_Type_MessageDeny events.
+func (m *GroupEventMux) OnMessageDeny(handler func(ctx context.Context, e Callback_MessageDeny)) {
+	m.on(Callback_Type_MessageDeny, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MessageDeny))
+	})
+}
+
+// OnPhotoNew set handler for Callback_Type_PhotoNew events.
+func (m *GroupEventMux) OnPhotoNew(handler func(ctx context.Context, e Photos_Photo)) {
+	m.on(Callback_Type_PhotoNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Photos_Photo))
+	})
+}
+
+// OnPhotoCommentNew set handler for Callback_Type_PhotoCommentNew events.
+func (m *GroupEventMux) OnPhotoCommentNew(handler func(ctx context.Context, e Callback_PhotoComment)) {
+	m.on(Callback_Type_PhotoCommentNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_PhotoComment))
+	})
+}
+
+// OnPhotoCommentEdit set handler for Callback_Type_PhotoCommentEdit events.
+func (m *GroupEventMux) OnPhotoCommentEdit(handler func(ctx context.Context, e Callback_PhotoComment)) {
+	m.on(Callback_Type_PhotoCommentEdit, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_PhotoComment))
+	})
+}
+
+// OnPhotoCommentRestore set handler for Callback_Type_PhotoCommentRestore events.
+func (m *GroupEventMux) OnPhotoCommentRestore(handler func(ctx context.Context, e Callback_PhotoComment)) {
+	m.on(Callback_Type_PhotoCommentRestore, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_PhotoComment))
+	})
+}
+
+// OnPhotoCommentDelete set handler for Callback_Type_PhotoCommentDelete events.
+func (m *GroupEventMux) OnPhotoCommentDelete(handler func(ctx context.Context, e Callback_PhotoCommentDelete)) {
+	m.on(Callback_Type_PhotoCommentDelete, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_PhotoCommentDelete))
+	})
+}
+
+// OnAudioNew set handler for Callback_Type_AudioNew events.
+func (m *GroupEventMux) OnAudioNew(handler func(ctx context.Context, e Audio_Audio)) {
+	m.on(Callback_Type_AudioNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Audio_Audio))
+	})
+}
+
+// OnVideoNew set handler for Callback_Type_VideoNew events.
+func (m *GroupEventMux) OnVideoNew(handler func(ctx context.Context, e Video_VideoFull)) {
+	m.on(Callback_Type_VideoNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Video_VideoFull))
+	})
+}
+
+// OnVideoCommentNew set handler for Callback_Type_VideoCommentNew events.
+func (m *GroupEventMux) OnVideoCommentNew(handler func(ctx context.Context, e Callback_VideoComment)) {
+	m.on(Callback_Type_VideoCommentNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_VideoComment))
+	})
+}
+
+// OnVideoCommentEdit set handler for Callback_Type_VideoCommentEdit events.
+func (m *GroupEventMux) OnVideoCommentEdit(handler func(ctx context.Context, e Callback_VideoComment)) {
+	m.on(Callback_Type_VideoCommentEdit, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_VideoComment))
+	})
+}
+
+// OnVideoCommentRestore set handler for Callback_Type_VideoCommentRestore events.
+func (m *GroupEventMux) OnVideoCommentRestore(handler func(ctx context.Context, e Callback_VideoComment)) {
+	m.on(Callback_Type_VideoCommentRestore, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_VideoComment))
+	})
+}
+
+// OnVideoCommentDelete set handler for Callback_Type_VideoCommentDelete events.
+func (m *GroupEventMux) OnVideoCommentDelete(handler func(ctx context.Context, e Callback_VideoCommentDelete)) {
+	m.on(Callback_Type_VideoCommentDelete, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_VideoCommentDelete))
+	})
+}
+
+// OnWallPostNew set handler for Callback_Type_WallPostNew events.
+func (m *GroupEventMux) OnWallPostNew(handler func(ctx context.Context, e Wall_WallpostFull)) {
+	m.on(Callback_Type_WallPostNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Wall_WallpostFull))
+	})
+}
+
+// OnWallRepost set handler for Callback_Type_WallRepost events.
+func (m *GroupEventMux) OnWallRepost(handler func(ctx context.Context, e Wall_WallpostFull)) {
+	m.on(Callback_Type_WallRepost, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Wall_WallpostFull))
+	})
+}
+
+// OnWallReplyNew set handler for Callback_Type_WallReplyNew events.
+func (m *GroupEventMux) OnWallReplyNew(handler func(ctx context.Context, e Wall_WallComment)) {
+	m.on(Callback_Type_WallReplyNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Wall_WallComment))
+	})
+}
+
+// OnWallReplyEdit set handler for Callback_Type_WallReplyEdit events.
+func (m *GroupEventMux) OnWallReplyEdit(handler func(ctx context.Context, e Wall_WallComment)) {
+	m.on(Callback_Type_WallReplyEdit, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Wall_WallComment))
+	})
+}
+
+// OnWallReplyRestore set handler for Callback_Type_WallReplyRestore events.
+func (m *GroupEventMux) OnWallReplyRestore(handler func(ctx context.Context, e Wall_WallComment)) {
+	m.on(Callback_Type_WallReplyRestore, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Wall_WallComment))
+	})
+}
+
+// OnWallReplyDelete set handler for Callback_Type_WallReplyDelete events.
+func (m *GroupEventMux) OnWallReplyDelete(handler func(ctx context.Context, e Callback_WallCommentDelete)) {
+	m.on(Callback_Type_WallReplyDelete, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_WallCommentDelete))
+	})
+}
+
+// OnBoardPostNew set handler for Callback_Type_BoardPostNew events.
+func (m *GroupEventMux) OnBoardPostNew(handler func(ctx context.Context, e Board_TopicComment)) {
+	m.on(Callback_Type_BoardPostNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Board_TopicComment))
+	})
+}
+
+// OnBoardPostEdit set handler for Callback_Type_BoardPostEdit events.
+func (m *GroupEventMux) OnBoardPostEdit(handler func(ctx context.Context, e Board_TopicComment)) {
+	m.on(Callback_Type_BoardPostEdit, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Board_TopicComment))
+	})
+}
+
+// OnBoardPostRestore set handler for Callback_Type_BoardPostRestore events.
+func (m *GroupEventMux) OnBoardPostRestore(handler func(ctx context.Context, e Board_TopicComment)) {
+	m.on(Callback_Type_BoardPostRestore, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Board_TopicComment))
+	})
+}
+
+// OnBoardPostDelete set handler for Callback_Type_BoardPostDelete events.
+func (m *GroupEventMux) OnBoardPostDelete(handler func(ctx context.Context, e Callback_BoardPostDelete)) {
+	m.on(Callback_Type_BoardPostDelete, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_BoardPostDelete))
+	})
+}
+
+// OnMarketCommentNew set handler for Callback_Type_MarketCommentNew events.
+func (m *GroupEventMux) OnMarketCommentNew(handler func(ctx context.Context, e Callback_MarketComment)) {
+	m.on(Callback_Type_MarketCommentNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MarketComment))
+	})
+}
+
+// OnMarketCommentEdit set handler for Callback_Type_MarketCommentEdit events.
+func (m *GroupEventMux) OnMarketCommentEdit(handler func(ctx context.Context, e Callback_MarketComment)) {
+	m.on(Callback_Type_MarketCommentEdit, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MarketComment))
+	})
+}
+
+// OnMarketCommentRestore set handler for Callback_Type_MarketCommentRestore events.
+func (m *GroupEventMux) OnMarketCommentRestore(handler func(ctx context.Context, e Callback_MarketComment)) {
+	m.on(Callback_Type_MarketCommentRestore, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MarketComment))
+	})
+}
+
+// OnMarketCommentDelete set handler for Callback_Type_MarketCommentDelete events.
+func (m *GroupEventMux) OnMarketCommentDelete(handler func(ctx context.Context, e Callback_MarketCommentDelete)) {
+	m.on(Callback_Type_MarketCommentDelete, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_MarketCommentDelete))
+	})
+}
+
+// OnGroupLeave set handler for Callback_Type_GroupLeave events.
+func (m *GroupEventMux) OnGroupLeave(handler func(ctx context.Context, e Callback_GroupLeave)) {
+	m.on(Callback_Type_GroupLeave, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_GroupLeave))
+	})
+}
+
+// OnGroupJoin set handler for Callback_Type_GroupJoin events.
+func (m *GroupEventMux) OnGroupJoin(handler func(ctx context.Context, e Callback_GroupJoin)) {
+	m.on(Callback_Type_GroupJoin, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_GroupJoin))
+	})
+}
+
+// OnUserBlock set handler for Callback_Type_UserBlock events.
+func (m *GroupEventMux) OnUserBlock(handler func(ctx context.Context, e Callback_UserBlock)) {
+	m.on(Callback_Type_UserBlock, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_UserBlock))
+	})
+}
+
+// OnUserUnblock set handler for Callback_Type_UserUnblock events.
+func (m *GroupEventMux) OnUserUnblock(handler func(ctx context.Context, e Callback_UserUnblock)) {
+	m.on(Callback_Type_UserUnblock, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_UserUnblock))
+	})
+}
+
+// OnPollVoteNew set handler for Callback_Type_PollVoteNew events.
+func (m *GroupEventMux) OnPollVoteNew(handler func(ctx context.Context, e Callback_PollVoteNew)) {
+	m.on(Callback_Type_PollVoteNew, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_PollVoteNew))
+	})
+}
+
+// OnGroupOfficersEdit set handler for Callback_Type_GroupOfficersEdit events.
+func (m *GroupEventMux) OnGroupOfficersEdit(handler func(ctx context.Context, e Callback_GroupOfficersEdit)) {
+	m.on(Callback_Type_GroupOfficersEdit, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_GroupOfficersEdit))
+	})
+}
+
+// OnGroupChangeSettings set handler for Callback_Type_GroupChangeSettings events.
+func (m *GroupEventMux) OnGroupChangeSettings(handler func(ctx context.Context, e Callback_GroupChangeSettings)) {
+	m.on(Callback_Type_GroupChangeSettings, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_GroupChangeSettings))
+	})
+}
+
+// OnGroupChangePhoto set handler for Callback_Type_GroupChangePhoto events.
+func (m *GroupEventMux) OnGroupChangePhoto(handler func(ctx context.Context, e Callback_GroupChangePhoto)) {
+	m.on(Callback_Type_GroupChangePhoto, func(ctx context.Context, obj interface{}) {
+		handler(ctx, *obj.(*Callback_GroupChangePhoto))
+	})
+}
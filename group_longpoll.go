@@ -0,0 +1,244 @@
+package vk_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// GroupEvent is an event of community received from Bots Long Poll API or Callback API.
+//
+// https://dev.vk.com/api/community-events/json-schema
+type GroupEvent struct {
+	// Event type.
+	Type Callback_Type `json:"type"`
+	// Unique event id. If it passed twice or more - you should ignore it.
+	EventID string `json:"event_id"`
+	// Community ID the event occurred in.
+	GroupID int `json:"group_id"`
+	// API version used for the event.
+	Version string `json:"v"`
+	// Raw event object.
+	Object json.RawMessage `json:"object"`
+	// Secret key specified in Callback API server settings. Only for Callback API.
+	Secret *string `json:"secret,omitempty"`
+
+	raw []byte
+}
+
+// groupEvent is used to unmarshal GroupEvent fields without recursion.
+type groupEvent GroupEvent
+
+func (e *GroupEvent) UnmarshalJSON(body []byte) error {
+	if err := json.Unmarshal(body, (*groupEvent)(e)); err != nil {
+		return err
+	}
+
+	e.raw = body
+
+	return nil
+}
+
+// Raw returns raw event JSON.
+func (e GroupEvent) Raw() []byte {
+	return e.raw
+}
+
+// Decode returns pointer to the generated struct matching the event Type.
+// Events with Callback_Base (like Callback_MessageNew) are decoded entirely,
+// others (like Callback_GroupJoin) are decoded from the event object.
+// For unknown types it returns raw event object as json.RawMessage.
+func (e GroupEvent) Decode() (interface{}, error) {
+	var (
+		dst        interface{}
+		withObject = true
+	)
+
+	switch e.Type {
+	case Callback_Type_Confirmation:
+		dst, withObject = new(Callback_Confirmation), false
+	case Callback_Type_MessageNew:
+		dst, withObject = new(Callback_MessageNew), false
+	case Callback_Type_MessageReply:
+		dst, withObject = new(Callback_MessageReply), false
+	case Callback_Type_MessageEdit:
+		dst, withObject = new(Callback_MessageEdit), false
+	case Callback_Type_MessageAllow:
+		dst, withObject = new(Callback_MessageAllow), false
+	case Callback_Type_MessageDeny:
+		dst = new(Callback_MessageDeny)
+	case Callback_Type_PhotoNew:
+		dst = new(Photos_Photo)
+	case Callback_Type_PhotoCommentNew, Callback_Type_PhotoCommentEdit, Callback_Type_PhotoCommentRestore:
+		dst = new(Callback_PhotoComment)
+	case Callback_Type_PhotoCommentDelete:
+		dst = new(Callback_PhotoCommentDelete)
+	case Callback_Type_AudioNew:
+		dst = new(Audio_Audio)
+	case Callback_Type_VideoNew:
+		dst = new(Video_VideoFull)
+	case Callback_Type_VideoCommentNew, Callback_Type_VideoCommentEdit, Callback_Type_VideoCommentRestore:
+		dst = new(Callback_VideoComment)
+	case Callback_Type_VideoCommentDelete:
+		dst = new(Callback_VideoCommentDelete)
+	case Callback_Type_WallPostNew, Callback_Type_WallRepost:
+		dst = new(Wall_WallpostFull)
+	case Callback_Type_WallReplyNew, Callback_Type_WallReplyEdit, Callback_Type_WallReplyRestore:
+		dst = new(Wall_WallComment)
+	case Callback_Type_WallReplyDelete:
+		dst = new(Callback_WallCommentDelete)
+	case Callback_Type_BoardPostNew, Callback_Type_BoardPostEdit, Callback_Type_BoardPostRestore:
+		dst = new(Board_TopicComment)
+	case Callback_Type_BoardPostDelete:
+		dst = new(Callback_BoardPostDelete)
+	case Callback_Type_MarketCommentNew, Callback_Type_MarketCommentEdit, Callback_Type_MarketCommentRestore:
+		dst = new(Callback_MarketComment)
+	case Callback_Type_MarketCommentDelete:
+		dst = new(Callback_MarketCommentDelete)
+	case Callback_Type_GroupLeave:
+		dst = new(Callback_GroupLeave)
+	case Callback_Type_GroupJoin:
+		dst = new(Callback_GroupJoin)
+	case Callback_Type_UserBlock:
+		dst = new(Callback_UserBlock)
+	case Callback_Type_UserUnblock:
+		dst = new(Callback_UserUnblock)
+	case Callback_Type_PollVoteNew:
+		dst = new(Callback_PollVoteNew)
+	case Callback_Type_GroupOfficersEdit:
+		dst = new(Callback_GroupOfficersEdit)
+	case Callback_Type_GroupChangeSettings:
+		dst = new(Callback_GroupChangeSettings)
+	case Callback_Type_GroupChangePhoto:
+		dst = new(Callback_GroupChangePhoto)
+	default:
+		return e.Object, nil
+	}
+
+	body := e.raw
+	if withObject {
+		body = e.Object
+	}
+
+	if err := json.Unmarshal(body, dst); err != nil {
+		return nil, err
+	}
+
+	return dst, nil
+}
+
+// GroupEventHandler handles events received from Bots Long Poll API.
+type GroupEventHandler func(ctx context.Context, e GroupEvent)
+
+// GroupLongPoll is client for Bots Long Poll API.
+// It allows you to work with community events in real time.
+//
+// NOTE:
+// Long Poll requests are done by VK http.Client, so its timeout
+// must be greater than the waiting period.
+//
+// https://dev.vk.com/api/bots-long-poll/getting-started
+type GroupLongPoll struct {
+	vk      *VK
+	groupID int
+	wait    int
+	server  Groups_LongPollServer
+}
+
+// NewGroupLongPoll create and return new GroupLongPoll for community with provided ID.
+// VK must contain community access key or user access key with the groups permission.
+func NewGroupLongPoll(vk *VK, groupID int) *GroupLongPoll {
+	return &GroupLongPoll{
+		vk:      vk,
+		groupID: groupID,
+		wait:    DefaultLongPollWait,
+	}
+}
+
+// SetWait set waiting period in seconds. Maximum is 90.
+func (lp *GroupLongPoll) SetWait(wait int) {
+	lp.wait = wait
+}
+
+// updateServer gets new key and server address. Previous ts is kept if withTs is false.
+func (lp *GroupLongPoll) updateServer(ctx context.Context, withTs bool) (ApiError, error) {
+	resp, apiErr, err := lp.vk.Groups_GetLongPollServer(ctx, Groups_GetLongPollServer_Request{
+		GroupId: lp.groupID,
+	})
+
+	if apiErr != nil || err != nil {
+		return apiErr, err
+	}
+
+	ts := lp.server.Ts
+
+	lp.server = resp.Response
+
+	if !withTs {
+		lp.server.Ts = ts
+	}
+
+	return nil, nil
+}
+
+// Run polls Long Poll server and calls handler for every received event
+// until the context is cancelled or unrecoverable error occurs.
+// The "failed" codes are handled by getting new ts or key.
+// Handler is called synchronously in order of events.
+func (lp *GroupLongPoll) Run(ctx context.Context, handler GroupEventHandler) (ApiError, error) {
+	if apiErr, err := lp.updateServer(ctx, true); apiErr != nil || err != nil {
+		return apiErr, err
+	}
+
+	for {
+		values := make(url.Values, 4)
+
+		setString(values, "act", "a_check")
+		setString(values, "key", lp.server.Key)
+		setString(values, "ts", lp.server.Ts)
+		setLongPollWait(values, lp.wait)
+
+		lpResp, err := doLongPollReq(ctx, lp.vk.client, lp.server.Server, values)
+
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, err
+		}
+
+		switch lpResp.Failed {
+		case 0:
+		case LongPollFailedHistory:
+			lp.server.Ts = lpResp.Ts.String()
+			continue
+		case LongPollFailedKey, LongPollFailedInfo:
+			if apiErr, err := lp.updateServer(ctx, lpResp.Failed == LongPollFailedInfo); apiErr != nil || err != nil {
+				return apiErr, err
+			}
+			continue
+		default:
+			return nil, LongPollError{
+				Failed:     lpResp.Failed,
+				MinVersion: lpResp.MinVersion,
+				MaxVersion: lpResp.MaxVersion,
+			}
+		}
+
+		lp.server.Ts = lpResp.Ts.String()
+
+		for _, update := range lpResp.Updates {
+			var e GroupEvent
+
+			if err = json.Unmarshal(update, &e); err != nil {
+				return nil, err
+			}
+
+			handler(ctx, e)
+		}
+
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestGroupLongPoll_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lpResponses := []string{
+		`{"failed":1,"ts":"11"}`,
+		`{"failed":2}`,
+		`{"ts":"12","updates":[{"type":"group_join","object":{"user_id":1,"join_type":"join"},"group_id":2,"event_id":"e","v":"5.131"}]}`,
+	}
+
+	var serverCalls, lpCalls int
+	var tsValues []string
+
+	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		var body string
+
+		if req.URL.Host == apiHost {
+			serverCalls++
+			body = `{"response":{"key":"key","server":"https://lp.vk.com/wh2","ts":"10"}}`
+		} else {
+			assert.Equal(t, "a_check", req.URL.Query().Get("act"))
+			tsValues = append(tsValues, req.URL.Query().Get("ts"))
+			body = lpResponses[lpCalls]
+			lpCalls++
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		}, nil
+	})
+
+	lp := NewGroupLongPoll(NewVK(&http.Client{Transport: transport}), 2)
+
+	var events []interface{}
+
+	apiErr, err := lp.Run(ctx, func(ctx context.Context, e GroupEvent) {
+		obj, err := e.Decode()
+		require.NoError(t, err)
+		events = append(events, obj)
+		cancel()
+	})
+
+	assert.Nil(t, apiErr)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, serverCalls)
+	assert.Equal(t, []string{"10", "11", "11"}, tsValues)
+	require.Len(t, events, 1)
+	assert.Equal(t, &Callback_GroupJoin{UserId: 1, JoinType: Callback_GroupJoinType_Join}, events[0])
+}
@@ -2,7 +2,117 @@ package generator
 
 type skipReason string
 
-var easyJSONBlackList = map[string]skipReason{
-	"notifications_notification_parent": "Ambiguous reference 'Date'",
-	"newsfeed_item_wallpost":            "Ambiguous reference 'Date'",
+var easyJSONBlackList = map[string]skipReason{}
+
+// ambiguousProperties contains properties that allOf objects promote from several
+// embedded objects, by object names. Properties are declared in the object itself,
+// so both encoding/json and easyjson use them instead of the ambiguous promoted ones.
+// Properties of different types in embedded objects are declared as raw JSON.
+var ambiguousProperties = map[string]map[string]Property{
+	"newsfeed_item_wallpost": {
+		"date": requiredProperty(newIntProperty("Date when item has been added in Unixtime")),
+	},
+	"notifications_notification_parent": {
+		"access_key":  newStringProperty("Access key"),
+		"can_comment": newRefProperty("base_bool_int", "Information whether current user can comment the parent"),
+		"comments":    newRawProperty("Comments info or number depending on the parent type"),
+		"date":        newIntProperty("Date when the parent has been created in Unixtime"),
+		"height":      newIntProperty("Height of the parent media"),
+		"id":          newIntProperty("Parent ID"),
+		"is_favorite": newBoolProperty("Information whether the parent is in favorites"),
+		"likes":       newRefProperty("base_likes_info", "Likes info"),
+		"owner_id":    newIntProperty("Parent owner ID"),
+		"post_id":     newIntProperty("Post ID"),
+		"reposts":     newRefProperty("base_reposts_info", "Reposts info"),
+		"text":        newStringProperty("Parent text"),
+		"title":       newStringProperty("Parent title"),
+		"user_id":     newIntProperty("ID of the user who created the parent"),
+		"width":       newIntProperty("Width of the parent media"),
+	},
+}
+
+// oneOfVariantKinds contains values of "type" field of OneOf variants by OneOf and variant names,
+// if variants share the field type and schema does not describe values of every variant.
+var oneOfVariantKinds = map[string]map[string][]string{
+	"newsfeed_newsfeed_item": {
+		"newsfeed_item_wallpost":  {"post"},
+		"newsfeed_item_photo":     {"photo", "wall_photo"},
+		"newsfeed_item_photo_tag": {"photo_tag"},
+		"newsfeed_item_friend":    {"friend"},
+		"newsfeed_item_audio":     {"audio"},
+		"newsfeed_item_video":     {"video"},
+		"newsfeed_item_topic":     {"topic"},
+		"newsfeed_item_digest":    {"digest"},
+	},
+}
+
+// missingResponseProperties contains properties of response that API returns,
+// but schema does not describe, by response names.
+var missingResponseProperties = map[string]map[string]Property{
+	"newsfeed_generic_response": {
+		"next_from": newStringProperty("Next from value"),
+	},
+}
+
+func newStringProperty(description string) Property {
+	return newTypeProperty("string", description)
+}
+
+func newIntProperty(description string) Property {
+	return newTypeProperty("integer", description)
+}
+
+func newBoolProperty(description string) Property {
+	return newTypeProperty("boolean", description)
+}
+
+func newTypeProperty(typ, description string) Property {
+	var t interface{} = typ
+	return Property{
+		Type:        &t,
+		Description: &description,
+	}
+}
+
+func newRefProperty(ref, description string) Property {
+	return Property{
+		Ref:         &ref,
+		Description: &description,
+	}
+}
+
+// newRawProperty returns property that is generated as json.RawMessage.
+func newRawProperty(description string) Property {
+	return newRefProperty(rawMessageType, description)
+}
+
+func requiredProperty(prop Property) Property {
+	required := true
+	prop.Required = &required
+	return prop
+}
+
+func addMissingResponseProperties(name string, prop *Property) {
+	missing, ok := missingResponseProperties[name]
+	if !ok || prop.Properties == nil {
+		return
+	}
+
+	resp, ok := (*prop.Properties)["response"]
+	if !ok || resp.Properties == nil {
+		return
+	}
+
+	for propName, p := range missing {
+		(*resp.Properties)[propName] = p
+	}
+}
+
+func addAmbiguousProperties(name string, prop *Property) {
+	ambiguous, ok := ambiguousProperties[name]
+	if !ok || prop.AllOf == nil {
+		return
+	}
+
+	*prop.AllOf = append(*prop.AllOf, Property{Properties: &ambiguous})
 }
@@ -205,5 +205,29 @@ func (es Errors) Gen() (gen string) {
 
 	gen += ")\n\n"
 
+	gen += es.genIsGlobal()
+
+	return
+}
+
+// genIsGlobal generates ErrorCode method to check IsGlobal error metadata in runtime.
+func (es Errors) genIsGlobal() (gen string) {
+	globals := make([]string, 0, len(es))
+
+	for _, e := range es {
+		if e.IsGlobal {
+			globals = append(globals, getErrorName(e.Name))
+		}
+	}
+
+	gen += "// IsGlobal reports whether the error may be returned by any method.\n"
+	gen += fmt.Sprintf("func (c %s) IsGlobal() bool {\n", errTypeName)
+	gen += "\tswitch c {\n"
+	gen += fmt.Sprintf("\tcase %s:\n", strings.Join(globals, ",\n\t\t"))
+	gen += "\t\treturn true\n"
+	gen += "\t}\n\n"
+	gen += "\treturn false\n"
+	gen += "}\n"
+
 	return
 }
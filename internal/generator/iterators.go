@@ -0,0 +1,250 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// pagedResponse is response with items of one page and total count or cursor of the next page.
+type pagedResponse struct {
+	ItemType         string
+	ItemsRequired    bool
+	HasCount         bool
+	CountRequired    bool
+	HasNextFrom      bool
+	NextFromRequired bool
+}
+
+// pagedResponses contains parsed paged responses by their names.
+var pagedResponses = make(map[string]pagedResponse)
+
+func registerPagedResponse(genner NameGennerWithTest) {
+	o, ok := genner.(Object)
+	if !ok {
+		return
+	}
+
+	for _, field := range o.Fields {
+		if resp, ok := field.(Object); ok && resp.Name == "response" && resp.ArrayNestingLevel == 0 {
+			if p, ok := findPagedFields(resp.Fields); ok {
+				pagedResponses[o.Name] = p
+			}
+		}
+	}
+}
+
+func findPagedFields(fields []NameNestedGenner) (p pagedResponse, ok bool) {
+	var hasItems bool
+
+	for _, field := range fields {
+		t, isSimple := field.(SimpleType)
+		if !isSimple {
+			continue
+		}
+
+		switch {
+		case t.Name == "count" && t.Type == "int" && t.ArrayNestingLevel == 0:
+			p.HasCount = true
+			p.CountRequired = t.IsRequired
+		case t.Name == "next_from" && t.Type == "string" && t.ArrayNestingLevel == 0:
+			p.HasNextFrom = true
+			p.NextFromRequired = t.IsRequired
+		case t.Name == "items" && t.ArrayNestingLevel == 1:
+			hasItems = true
+			p.ItemType = getFullObjectName(t.Type)
+			p.ItemsRequired = t.IsRequired
+		}
+	}
+
+	return p, hasItems && (p.HasCount || p.HasNextFrom)
+}
+
+func parseIterator(m Method) (Genner, bool) {
+	if it, ok := parseCursorIterator(m); ok {
+		return it, true
+	}
+
+	if it, ok := parseOffsetIterator(m); ok {
+		return it, true
+	}
+
+	return nil, false
+}
+
+// findOptionalParam returns optional parameter of simple type by name.
+func findOptionalParam(m Method, name, t string) (p SimpleType, ok bool) {
+	for _, pGenner := range m.Params {
+		p, ok = pGenner.(SimpleType)
+		if ok && p.Name == name && p.Type == t && p.ArrayNestingLevel == 0 && !p.IsRequired {
+			return p, true
+		}
+	}
+
+	return p, false
+}
+
+func genIteratorType(iterName, pagerName, itemType string) (gen string) {
+	gen += fmt.Sprintf("type %s struct {\n\t%s\n\titems []%s\n\titem  %s\n}\n\n", iterName, pagerName, itemType, itemType)
+
+	gen += "// Next advances the iterator to the next item and fetches the next page if needed.\n"
+	gen += "// It returns false when items are exhausted, context is done or request is failed, see Err and ApiErr.\n"
+	gen += fmt.Sprintf("func (it *%s) Next() bool {\n", iterName)
+	gen += "\tfor len(it.items) == 0 {\n\t\tif !it.nextPage() {\n\t\t\treturn false\n\t\t}\n\t}\n"
+	gen += "\tit.item, it.items = it.items[0], it.items[1:]\n\treturn true\n}\n\n"
+
+	gen += "// Item returns the current item.\n"
+	gen += fmt.Sprintf("func (it *%s) Item() %s {\n\treturn it.item\n}\n\n", iterName, itemType)
+
+	return
+}
+
+func (p pagedResponse) genItems() string {
+	if p.ItemsRequired {
+		return "\t\tit.items = resp.Response.Items\n"
+	}
+
+	return "\t\tif resp.Response.Items != nil {\n\t\t\tit.items = *resp.Response.Items\n\t\t}\n"
+}
+
+// OffsetIterator is iterator over items of method with offset and count parameters.
+type OffsetIterator struct {
+	MethodName   string
+	RequestName  string
+	ResponseName string
+	pagedResponse
+	// MaxCount is documented maximum of count parameter, 0 if unknown
+	MaxCount int
+}
+
+var maxCountDescription = regexp.MustCompile(`(?i)maximum(?: value)?[ ,']*(\d+)`)
+
+func parseOffsetIterator(m Method) (it OffsetIterator, ok bool) {
+	if m.ResponseRef == nil || len(m.Params) == 0 {
+		return
+	}
+
+	it.pagedResponse, ok = pagedResponses[*m.ResponseRef]
+	if !ok || !it.HasCount {
+		return it, false
+	}
+
+	if _, ok = findOptionalParam(m, "offset", "int"); !ok {
+		return
+	}
+
+	count, ok := findOptionalParam(m, "count", "int")
+	if !ok {
+		return
+	}
+
+	it.MaxCount = getMaxCount(count)
+
+	it.MethodName = m.FullName
+	it.RequestName = m.RequestName
+	it.ResponseName = getFullObjectName(*m.ResponseRef)
+
+	return it, true
+}
+
+func getMaxCount(t SimpleType) int {
+	if maximum, ok := t.Limits.Maximum.(float64); ok {
+		return int(maximum)
+	}
+
+	if match := maxCountDescription.FindStringSubmatch(t.Description); match != nil {
+		maxCount, _ := strconv.Atoi(match[1])
+		return maxCount
+	}
+
+	return 0
+}
+
+func (it OffsetIterator) Gen() (gen string) {
+	iterName := it.MethodName + "Iterator"
+
+	gen += fmt.Sprintf("// %s iterates over items of VK.%s page by page.\n", iterName, it.MethodName)
+	gen += genIteratorType(iterName, "offsetPager", it.ItemType)
+
+	gen += fmt.Sprintf("// %sIter returns iterator over all items of VK.%s starting from req.Offset.\n", it.MethodName, it.MethodName)
+	if it.MaxCount > 0 {
+		gen += fmt.Sprintf("// Req.Count is used as page size and is limited by %d.\n", it.MaxCount)
+	} else {
+		gen += "// Req.Count is used as page size.\n"
+	}
+	gen += fmt.Sprintf("func (vk *VK) %sIter(ctx context.Context, req %s, options ...Option) *%s {\n", it.MethodName, it.RequestName, iterName)
+	gen += fmt.Sprintf("\tit := new(%s)\n", iterName)
+	gen += fmt.Sprintf("\tit.offsetPager = newOffsetPager(ctx, req.Offset, req.Count, %d, func(offset int, count *int) (n, total int, apiErr ApiError, err error) {\n", it.MaxCount)
+	gen += "\t\treq.Offset, req.Count = &offset, count\n"
+	gen += fmt.Sprintf("\t\tvar resp %s\n", it.ResponseName)
+	gen += fmt.Sprintf("\t\tresp, apiErr, err = vk.%s(ctx, req, options...)\n", it.MethodName)
+
+	gen += it.genItems()
+
+	if it.CountRequired {
+		gen += "\t\treturn len(it.items), resp.Response.Count, apiErr, err\n"
+	} else {
+		gen += "\t\ttotal = -1\n\t\tif resp.Response.Count != nil {\n\t\t\ttotal = *resp.Response.Count\n\t\t}\n"
+		gen += "\t\treturn len(it.items), total, apiErr, err\n"
+	}
+
+	gen += "\t})\n\treturn it\n}\n\n"
+
+	return
+}
+
+// CursorIterator is iterator over items of method with start_from parameter and next_from in response.
+type CursorIterator struct {
+	MethodName   string
+	RequestName  string
+	ResponseName string
+	pagedResponse
+}
+
+func parseCursorIterator(m Method) (it CursorIterator, ok bool) {
+	if m.ResponseRef == nil || len(m.Params) == 0 {
+		return
+	}
+
+	it.pagedResponse, ok = pagedResponses[*m.ResponseRef]
+	if !ok || !it.HasNextFrom {
+		return it, false
+	}
+
+	if _, ok = findOptionalParam(m, "start_from", "string"); !ok {
+		return
+	}
+
+	it.MethodName = m.FullName
+	it.RequestName = m.RequestName
+	it.ResponseName = getFullObjectName(*m.ResponseRef)
+
+	return it, true
+}
+
+func (it CursorIterator) Gen() (gen string) {
+	iterName := it.MethodName + "Iterator"
+
+	gen += fmt.Sprintf("// %s iterates over items of VK.%s page by page.\n", iterName, it.MethodName)
+	gen += genIteratorType(iterName, "cursorPager", it.ItemType)
+
+	gen += fmt.Sprintf("// %sIter returns iterator over all items of VK.%s starting from req.StartFrom.\n", it.MethodName, it.MethodName)
+	gen += "// Next_from of every page is used as start_from of the next page.\n"
+	gen += fmt.Sprintf("func (vk *VK) %sIter(ctx context.Context, req %s, options ...Option) *%s {\n", it.MethodName, it.RequestName, iterName)
+	gen += fmt.Sprintf("\tit := new(%s)\n", iterName)
+	gen += "\tit.cursorPager = newCursorPager(ctx, req.StartFrom, func(startFrom *string) (nextFrom *string, apiErr ApiError, err error) {\n"
+	gen += "\t\treq.StartFrom = startFrom\n"
+	gen += fmt.Sprintf("\t\tvar resp %s\n", it.ResponseName)
+	gen += fmt.Sprintf("\t\tresp, apiErr, err = vk.%s(ctx, req, options...)\n", it.MethodName)
+	gen += it.genItems()
+
+	if it.NextFromRequired {
+		gen += "\t\treturn &resp.Response.NextFrom, apiErr, err\n"
+	} else {
+		gen += "\t\treturn resp.Response.NextFrom, apiErr, err\n"
+	}
+
+	gen += "\t})\n\treturn it\n}\n\n"
+
+	return
+}
@@ -25,7 +25,7 @@ type MethodsFile struct {
 	Methods []MethodJSON `json:"methods"`
 }
 
-func GenerateMethods(w, wTest io.Writer, methodsRaw []byte) {
+func GenerateMethods(w, wTest, wIter io.Writer, methodsRaw []byte) {
 	var file MethodsFile
 
 	if err := json.Unmarshal(methodsRaw, &file); err != nil {
@@ -42,9 +42,17 @@ func GenerateMethods(w, wTest io.Writer, methodsRaw []byte) {
 	writeStartFile(wTest, "vk_sdk", "",
 		"context", "encoding/json", "errors", "github.com/stretchr/testify/assert", "github.com/stretchr/testify/require", "net/url", "testing")
 
+	writeStartFile(wIter, "vk_sdk", "", "context")
+
 	for _, g := range genners {
 		fmt.Fprint(w, g.Gen())
 		fmt.Fprint(wTest, g.TestGen())
+
+		if m, ok := g.(Method); ok {
+			if it, ok := parseIterator(m); ok {
+				fmt.Fprint(wIter, it.Gen())
+			}
+		}
 	}
 }
 
@@ -60,6 +68,7 @@ type Param struct {
 	IsRequired        bool
 	ArrayNestingLevel int
 	Limits            Limits
+	EnumValues        []string
 }
 
 func (t SimpleType) Param() (p Param) {
@@ -68,6 +77,7 @@ func (t SimpleType) Param() (p Param) {
 	p.IsRequired = t.IsRequired
 	p.Type = t.Type
 	p.Limits = t.Limits
+	p.EnumValues = enumLiterals[t.Type]
 
 	return
 }
@@ -79,6 +89,7 @@ func (e Enum) Param() (p Param) {
 	p.ArrayNestingLevel = e.ArrayNestingLevel
 	p.IsRequired = e.IsRequired
 	p.Limits = e.Limits
+	p.EnumValues = e.literals()
 
 	return
 }
@@ -562,6 +573,10 @@ const (
 )
 
 func (m Method) genBody() (body string) {
+	if len(m.Params) > 0 {
+		body += "\tif err = vk.checkRequest(req); err != nil {\n\t\treturn\n\t}\n"
+	}
+
 	body += fmt.Sprintf("\t%s := make(url.Values, %d+len(options))\n", urlValuesName, len(m.Params)+len(m.SetFields)+2)
 
 	if len(m.Params) > 0 {
@@ -604,6 +619,8 @@ func (m Method) genRequest() (gen string) {
 
 	gen += "\treturn\n}\n\n"
 
+	gen += m.genValidate()
+
 	return
 }
 
@@ -624,6 +641,13 @@ func (m Method) testGenRequest() (testGen string) {
 }
 
 func (p *Param) findReferenceType() {
+	// UnixTime is sent as number of seconds
+	if p.Type == unixTimeType {
+		p.Type = "int"
+		p.HasCustomType = true
+		return
+	}
+
 	if !isGoType(p.Type) {
 		p.HasCustomType = true
 	}
@@ -22,9 +22,14 @@ func GenerateObjects(w, testW io.Writer, objectsRaw []byte) {
 	nameGenners := make([]NameGennerWithTest, 0, len(file.Definitions))
 
 	for name, prop := range file.Definitions {
+		addMissingResponseProperties(name, &prop)
+		addAmbiguousProperties(name, &prop)
+
 		obj := parseObjectNameGenner(name, prop, 0)
 		if obj != nil {
 			nameGenners = append(nameGenners, obj)
+			registerTypeField(obj)
+			registerPagedResponse(obj)
 		}
 	}
 
@@ -86,6 +91,10 @@ func parseObjectNameGenner(name string, prop Property, arrayNestingLvl int) Name
 			ArrayNestingLevel: arrayNestingLvl,
 			Type:              e.ValuesType,
 		}
+		if arrayNestingLvl == 0 {
+			enumValues[e.Name] = e.stringValues()
+			enumLiterals[e.Name] = e.literals()
+		}
 		return e
 	}
 
@@ -519,6 +528,246 @@ func (of OneOf) Gen() (gen string) {
 	// write json map getter
 	gen += of.genRawGetter()
 
+	// write variant accessors and constructors
+	gen += of.genAccessors()
+
+	return
+}
+
+// typeField is "type" field of object that is used as OneOf discriminator.
+type typeField struct {
+	GoType string
+	Values []string
+	// Shared is true if Values are all values of GoType rather than values of the object.
+	Shared bool
+}
+
+// objectTypeFields contains "type" fields of parsed objects by their names.
+var objectTypeFields = make(map[string]typeField)
+
+// allOfParents contains referenced parts of parsed AllOf objects by their names.
+var allOfParents = make(map[string][]string)
+
+// enumValues contains values of parsed string enums by their names.
+var enumValues = make(map[string][]string)
+
+func registerTypeField(genner NameGennerWithTest) {
+	switch o := genner.(type) {
+	case Object:
+		if f, ok := findTypeField(getFullObjectName(o.Name), o.Fields); ok {
+			objectTypeFields[o.Name] = f
+		}
+	case AllOf:
+		for _, part := range o.Fields {
+			switch p := part.(type) {
+			case SimpleType:
+				allOfParents[o.Name] = append(allOfParents[o.Name], p.Type)
+			case Object:
+				if f, ok := findTypeField(getFullObjectName(o.Name), p.Fields); ok {
+					objectTypeFields[o.Name] = f
+				}
+			}
+		}
+	}
+}
+
+func findTypeField(objGenName string, fields []NameNestedGenner) (f typeField, ok bool) {
+	for _, field := range fields {
+		if field.GetName() != "type" {
+			continue
+		}
+
+		switch t := field.(type) {
+		case Enum:
+			if t.ArrayNestingLevel != 0 || t.ValuesType != "string" {
+				return
+			}
+			f.GoType = objGenName + "_" + getFullName(t.Name)
+			f.Values = t.stringValues()
+		case SimpleType:
+			if t.ArrayNestingLevel != 0 {
+				return
+			}
+			f.GoType = getFullObjectName(t.Type)
+			f.Values = enumValues[t.Type]
+			f.Shared = true
+
+			if t.Limits.Default != nil {
+				f.Values = []string{fmt.Sprint(t.Limits.Default)}
+				f.Shared = false
+			}
+		default:
+			return
+		}
+
+		return f, true
+	}
+
+	return
+}
+
+func lookupTypeField(name string) (typeField, bool) {
+	if f, ok := objectTypeFields[name]; ok {
+		return f, true
+	}
+
+	for _, parent := range allOfParents[name] {
+		if f, ok := lookupTypeField(parent); ok {
+			return f, true
+		}
+	}
+
+	return typeField{}, false
+}
+
+// discriminator returns Go type of "type" field shared by all variants
+// and values of the field that distinguish every variant.
+// Values of variant are nil if other variants have the same values.
+func (of OneOf) discriminator() (kindType string, values [][]string, ok bool) {
+	fields := make([]typeField, 0, len(of.Fields))
+
+	for _, field := range of.Fields {
+		t, isSimple := field.(SimpleType)
+
+		if !isSimple || t.ArrayNestingLevel != 0 || isGoType(t.Type) {
+			return "", nil, false
+		}
+
+		f, found := lookupTypeField(t.Type)
+
+		if !found {
+			return "", nil, false
+		}
+
+		if kinds, ok := oneOfVariantKinds[of.Name][t.Type]; ok {
+			f.Values = kinds
+			f.Shared = false
+		}
+
+		fields = append(fields, f)
+	}
+
+	kindType = fields[0].GoType
+
+	for _, f := range fields[1:] {
+		if f.GoType != kindType {
+			kindType = "string"
+			break
+		}
+	}
+
+	// all values of the same field type do not distinguish variants
+	if kindType != "string" {
+		for i := range fields {
+			if fields[i].Shared {
+				fields[i].Values = nil
+			}
+		}
+	}
+
+	// count variants by values to find shared ones
+	variants := make(map[string]int)
+
+	for _, f := range fields {
+		for _, v := range f.Values {
+			variants[v]++
+		}
+	}
+
+	values = make([][]string, len(fields))
+
+	for i, f := range fields {
+		distinct := len(f.Values) > 0
+
+		for _, v := range f.Values {
+			if variants[v] > 1 {
+				distinct = false
+			}
+		}
+
+		if distinct {
+			values[i] = f.Values
+		}
+	}
+
+	return kindType, values, true
+}
+
+// variantName returns short name of the variant used in accessors and constructors.
+func (of OneOf) variantName(t SimpleType) string {
+	genType := getFullObjectName(t.Type)
+
+	var name string
+
+	if isGoType(t.Type) {
+		name = upFirstAny(genType)
+	} else {
+		section := strings.SplitN(of.genName(), "_", 2)[0] + "_"
+		name = strings.TrimPrefix(genType, section)
+	}
+
+	return name + strings.Repeat("Array", t.ArrayNestingLevel)
+}
+
+func (of OneOf) genName() string {
+	if of.isNested {
+		return of.Name
+	}
+
+	return getFullObjectName(of.Name)
+}
+
+func (of OneOf) genAccessors() (gen string) {
+	genName := of.genName()
+
+	kindType, values, hasKind := of.discriminator()
+
+	if hasKind {
+		gen += "// Kind returns value of the \"type\" field of the variant.\n"
+		gen += fmt.Sprintf("func (o %s) Kind() %s {\n", genName, kindType)
+		gen += "\tvar v struct {\n"
+		gen += fmt.Sprintf("\t\tType %s `json:\"type\"`\n", kindType)
+		gen += "\t}\n"
+		gen += "\t_ = json.Unmarshal(o.raw, &v)\n"
+		gen += "\treturn v.Type\n"
+		gen += "}\n\n"
+	}
+
+	for i, field := range of.Fields {
+		t := field.(SimpleType)
+		variantType := getArrayBrackets(t.ArrayNestingLevel) + getFullObjectName(t.Type)
+		variantName := of.variantName(t)
+
+		// variant that is not distinguished by the "type" field has only constructor,
+		// because its accessor would accept other variants too
+		if !hasKind || len(values[i]) > 0 {
+			gen += fmt.Sprintf("// As%s returns %s variant, if present.\n", variantName, variantType)
+			gen += fmt.Sprintf("func (o %s) As%s() (v %s, ok bool) {\n", genName, variantName, variantType)
+
+			if hasKind {
+				quoted := make([]string, len(values[i]))
+				for j, value := range values[i] {
+					quoted[j] = fmt.Sprintf("%q", value)
+				}
+
+				gen += "\tswitch o.Kind() {\n"
+				gen += fmt.Sprintf("\tcase %s:\n", strings.Join(quoted, ", "))
+				gen += "\tdefault:\n"
+				gen += "\t\treturn v, false\n"
+				gen += "\t}\n"
+			}
+
+			gen += "\treturn v, json.Unmarshal(o.raw, &v) == nil\n"
+			gen += "}\n\n"
+		}
+
+		gen += fmt.Sprintf("// New%sFrom%s returns %s with %s variant.\n", genName, variantName, genName, variantType)
+		gen += fmt.Sprintf("func New%sFrom%s(v %s) (o %s, err error) {\n", genName, variantName, variantType, genName)
+		gen += "\to.raw, err = json.Marshal(&v)\n"
+		gen += "\treturn\n"
+		gen += "}\n\n"
+	}
+
 	return
 }
 
@@ -550,7 +799,7 @@ func (of OneOf) genUnmarshaler() (gen string) {
 
 	gen += fmt.Sprintf("func (o *%s) UnmarshalJSON(body []byte) (err error) {\n", genName)
 
-	gen += "\to.raw = body\n"
+	gen += "\to.raw = append([]byte(nil), body...)\n"
 	//gen += "\treturn json.Unmarshal(body, &o.raw)\n"
 	gen += "\treturn nil\n"
 
@@ -806,6 +1055,10 @@ func parseSimpleType(name string, prop Property, arrayNestingLvl int) (t SimpleT
 		} else {
 			t.Type = getSimpleType((*prop.Type).(string))
 		}
+
+		if t.Type == "int" && prop.isUnixTime() {
+			t.Type = unixTimeType
+		}
 		return
 	}
 
@@ -1076,6 +1329,21 @@ func (e Enum) GetName() string {
 	return e.Name
 }
 
+// stringValues returns enum values of string enum.
+func (e Enum) stringValues() []string {
+	if e.ValuesType != "string" {
+		return nil
+	}
+
+	values := make([]string, 0, len(e.EnumValues))
+
+	for _, v := range e.EnumValues {
+		values = append(values, v.(string))
+	}
+
+	return values
+}
+
 func parseEnum(name string, prop Property, arrayNestingLvl int) (e Enum) {
 	e.Name = name
 	e.ArrayNestingLevel = arrayNestingLvl
@@ -1339,6 +1607,10 @@ func getRandSetter(t string) (s string) {
 		s += "randFloat()"
 	case "bool":
 		s += "randBool()"
+	case rawMessageType:
+		s += "randRaw()"
+	case unixTimeType:
+		s += "randUnixTime()"
 	}
 
 	return
@@ -1,6 +1,9 @@
 package generator
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+)
 
 type Property struct {
 	Type *interface{} `json:"type"`
@@ -25,6 +28,19 @@ type Property struct {
 	OneOf             *[]Property                   `json:"oneOf"`
 }
 
+// unixTimeDescription matches descriptions of properties with time in Unixtime,
+// e.g. "Date when the message has been sent in Unixtime" or "Date (Unix time) when the product was purchased".
+var unixTimeDescription = regexp.MustCompile(`(?i)\bunix[\s-]?time`)
+
+// isUnixTime reports whether property is time in Unixtime by its format or description.
+func (p Property) isUnixTime() bool {
+	if p.Format != nil && *p.Format == "unixtime" {
+		return true
+	}
+
+	return p.Description != nil && unixTimeDescription.MatchString(*p.Description)
+}
+
 type Limits struct {
 	Minimum   interface{} `json:"minimum"`
 	Maximum   interface{} `json:"maximum"`
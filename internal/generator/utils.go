@@ -109,9 +109,15 @@ func getTabs(nestingLvl int) (t string) {
 	return
 }
 
+// rawMessageType is type of properties that are kept as raw JSON.
+const rawMessageType = "json.RawMessage"
+
+// unixTimeType is type of integer properties with time in Unixtime.
+const unixTimeType = "UnixTime"
+
 func isGoType(s string) bool {
 	switch s {
-	case "string", "int", "bool", "float64", "interface{}":
+	case "string", "int", "bool", "float64", "interface{}", rawMessageType, unixTimeType:
 		return true
 	default:
 		return false
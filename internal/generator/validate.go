@@ -0,0 +1,137 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// enumLiterals contains Go literals of values of parsed enums by their names.
+var enumLiterals = make(map[string][]string)
+
+// literals returns enum values as Go literals.
+func (e Enum) literals() []string {
+	literals := make([]string, 0, len(e.EnumValues))
+
+	for _, v := range e.EnumValues {
+		if e.ValuesType == "string" {
+			literals = append(literals, fmt.Sprintf("%q", v))
+		} else {
+			literals = append(literals, fmt.Sprintf("%v", v))
+		}
+	}
+
+	return literals
+}
+
+func (m Method) genValidate() (gen string) {
+	gen += "// Validate checks that request fields match limits of the method.\n"
+	gen += fmt.Sprintf("func (r %s) Validate() (err error) {\n", m.RequestName)
+
+	for _, pGenner := range m.Params {
+		gen += pGenner.Param().genValidate()
+	}
+
+	gen += "\treturn\n}\n\n"
+
+	return
+}
+
+func (p Param) genValidate() string {
+	if p.Limits.Format != nil && *p.Limits.Format == "json" {
+		return ""
+	}
+
+	p.findReferenceType()
+
+	name := getFullName(p.Name)
+	value := "r." + name
+	nestingLvl := 1
+
+	if !p.IsRequired {
+		value = "*r." + name
+		nestingLvl = 2
+	}
+
+	tabs := getTabs(nestingLvl)
+
+	var checks string
+
+	if p.ArrayNestingLevel == 0 {
+		if p.IsRequired && p.Type == "string" {
+			checks += genCheck(nestingLvl, "checkRequired(%q, %s != \"\")", p.Name, value)
+		}
+
+		checks += p.genValueChecks(nestingLvl, value)
+	} else {
+		if p.IsRequired {
+			checks += genCheck(nestingLvl, "checkRequired(%q, len(%s) > 0)", p.Name, value)
+		}
+
+		if p.Limits.MinItems != nil {
+			checks += genCheck(nestingLvl, "checkMinItems(%q, len(%s), %d)", p.Name, value, *p.Limits.MinItems)
+		}
+
+		if p.Limits.MaxItems != nil {
+			checks += genCheck(nestingLvl, "checkMaxItems(%q, len(%s), %d)", p.Name, value, *p.Limits.MaxItems)
+		}
+
+		if p.ArrayNestingLevel == 1 {
+			if itemChecks := p.genValueChecks(nestingLvl+1, "v"); itemChecks != "" {
+				checks += fmt.Sprintf("%sfor _, v := range %s {\n%s%s}\n", tabs, value, itemChecks, tabs)
+			}
+		}
+	}
+
+	if checks == "" || p.IsRequired {
+		return checks
+	}
+
+	return fmt.Sprintf("\tif r.%s != nil {\n%s\t}\n", name, checks)
+}
+
+// genValueChecks returns checks of single value of the parameter.
+func (p Param) genValueChecks(nestingLvl int, value string) (checks string) {
+	switch p.Type {
+	case "int", "float64":
+		number := value
+		if p.Type != "float64" || p.HasCustomType {
+			number = fmt.Sprintf("float64(%s)", value)
+		}
+
+		if p.Limits.Minimum != nil {
+			checks += genCheck(nestingLvl, "checkMinimum(%q, %s, %v)", p.Name, number, p.Limits.Minimum)
+		}
+
+		if p.Limits.Maximum != nil {
+			checks += genCheck(nestingLvl, "checkMaximum(%q, %s, %v)", p.Name, number, p.Limits.Maximum)
+		}
+
+		if p.Type == "int" && len(p.EnumValues) > 0 {
+			checks += genCheck(nestingLvl, "checkIntEnum(%q, int(%s), %s)", p.Name, value, strings.Join(p.EnumValues, ", "))
+		}
+	case "string":
+		if p.HasCustomType {
+			value = fmt.Sprintf("string(%s)", value)
+		}
+
+		if p.Limits.MinLength != nil {
+			checks += genCheck(nestingLvl, "checkMinLength(%q, %s, %d)", p.Name, value, *p.Limits.MinLength)
+		}
+
+		if p.Limits.MaxLength != nil {
+			checks += genCheck(nestingLvl, "checkMaxLength(%q, %s, %d)", p.Name, value, *p.Limits.MaxLength)
+		}
+
+		if len(p.EnumValues) > 0 {
+			checks += genCheck(nestingLvl, "checkStringEnum(%q, %s, %s)", p.Name, value, strings.Join(p.EnumValues, ", "))
+		}
+	}
+
+	return
+}
+
+func genCheck(nestingLvl int, format string, args ...interface{}) string {
+	tabs := getTabs(nestingLvl)
+
+	return fmt.Sprintf("%sif err = %s; err != nil {\n%s\treturn\n%s}\n", tabs, fmt.Sprintf(format, args...), tabs, tabs)
+}
@@ -60,7 +60,28 @@ func main() {
 	genErrors("error_codes.go")
 	genObjects("objects.go", "objects_test.go")
 	genResponses("responses.go", "responses_test.go")
-	genMethods("methods.go", "methods_test.go")
+	genMethods("methods.go", "methods_test.go", "iterators.go")
+	genEasyJSON("objects.go")
+	genEasyJSON("responses.go")
+}
+
+// genEasyJSON generates easyjson marshalers of all types in file
+// with easyjson version from go.mod.
+func genEasyJSON(file string) {
+	cmd := exec.Command("go", "run", "github.com/mailru/easyjson/easyjson", "-all", file)
+	cmd.Stderr = os.Stderr
+
+	// json.RawMessage is alias of jsontext.Value with jsonv2 experiment,
+	// the package is missing in Go version of go.mod, so the experiment is disabled
+	if exec.Command("go", "list", "encoding/json/jsontext").Run() == nil {
+		cmd.Env = append(os.Environ(), "GOEXPERIMENT=nojsonv2")
+	}
+
+	err := cmd.Run()
+
+	if err != nil {
+		panic(err.Error())
+	}
 }
 
 func genErrors(file string) {
@@ -126,9 +147,10 @@ func genResponses(file, testFile string) {
 	generator.GenerateObjects(o, oTest, getRawFromAddr(responsesFile))
 }
 
-func genMethods(file, testFile string) {
+func genMethods(file, testFile, iterFile string) {
 	defer goFmt(file)
 	defer goFmt(testFile)
+	defer goFmt(iterFile)
 
 	m, err := os.Create(file)
 	if err != nil {
@@ -142,5 +164,11 @@ func genMethods(file, testFile string) {
 	}
 	defer m.Close()
 
-	generator.GenerateMethods(m, mTest, getRawFromAddr(methodsFile))
+	mIter, err := os.Create(iterFile)
+	if err != nil {
+		panic(err.Error())
+	}
+	defer mIter.Close()
+
+	generator.GenerateMethods(m, mTest, mIter, getRawFromAddr(methodsFile))
 }
@@ -0,0 +1,245 @@
+package vk_sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Keyboard limits.
+//
+// https://dev.vk.com/api/bots/development/keyboard
+const (
+	MaxKeyboardRows             = 10
+	MaxKeyboardButtons          = 40
+	MaxInlineKeyboardRows       = 6
+	MaxInlineKeyboardButtons    = 10
+	MaxKeyboardButtonsInRow     = 5
+	MaxKeyboardButtonLabelLen   = 40
+	MaxKeyboardButtonPayloadLen = 255
+)
+
+// KeyboardBuilder builds Messages_Keyboard with typed buttons and checks keyboard limits.
+// The first error is returned by Build, so the calls can be chained.
+//
+//    keyboard, err := vk_sdk.NewKeyboard(false).
+//        Text("Yes", `{"answer":1}`, vk_sdk.Messages_KeyboardButton_Color_Positive).
+//        Text("No", `{"answer":0}`, vk_sdk.Messages_KeyboardButton_Color_Negative).
+//        Row().
+//        Location("").
+//        Build()
+//
+//    req := vk_sdk.Messages_Send_Request{Keyboard: &keyboard}
+type KeyboardBuilder struct {
+	inline  bool
+	oneTime bool
+	rows    [][]Messages_KeyboardButton
+	buttons int
+	// fullRow is set when the last row has button that takes the whole row
+	fullRow bool
+	err     error
+}
+
+// NewKeyboard create and return new KeyboardBuilder of keyboard under the message input.
+// One time keyboard is hidden after the first button click.
+func NewKeyboard(oneTime bool) *KeyboardBuilder {
+	return &KeyboardBuilder{
+		oneTime: oneTime,
+	}
+}
+
+// NewInlineKeyboard create and return new KeyboardBuilder of keyboard inside the message.
+func NewInlineKeyboard() *KeyboardBuilder {
+	return &KeyboardBuilder{
+		inline: true,
+	}
+}
+
+// Row starts new row of buttons.
+func (b *KeyboardBuilder) Row() *KeyboardBuilder {
+	if len(b.rows) == 0 || len(b.rows[len(b.rows)-1]) > 0 {
+		b.rows = append(b.rows, nil)
+		b.fullRow = false
+	}
+
+	return b
+}
+
+// Text adds button that sends message with the label and payload.
+// Empty payload and color are not sent.
+func (b *KeyboardBuilder) Text(label, payload string, color Messages_KeyboardButton_Color) *KeyboardBuilder {
+	b.checkLabel(label)
+
+	return b.add(Messages_KeyboardButtonActionText{
+		Label:   label,
+		Payload: b.payload(payload),
+		Type:    Messages_KeyboardButtonActionText_Type_Text,
+	}, color, false)
+}
+
+// Callback adds button that sends message_event to the bot without message.
+func (b *KeyboardBuilder) Callback(label, payload string, color Messages_KeyboardButton_Color) *KeyboardBuilder {
+	b.checkLabel(label)
+
+	return b.add(Messages_KeyboardButtonActionCallback{
+		Label:   label,
+		Payload: b.payload(payload),
+		Type:    Messages_KeyboardButtonActionCallback_Type_Callback,
+	}, color, false)
+}
+
+// OpenLink adds button that opens the link.
+func (b *KeyboardBuilder) OpenLink(label, link, payload string) *KeyboardBuilder {
+	b.checkLabel(label)
+
+	return b.add(Messages_KeyboardButtonActionOpenLink{
+		Label:   label,
+		Link:    link,
+		Payload: b.payload(payload),
+		Type:    Messages_KeyboardButtonActionOpenLink_Type_OpenLink,
+	}, "", false)
+}
+
+// Location adds button that sends user location. It takes the whole row.
+func (b *KeyboardBuilder) Location(payload string) *KeyboardBuilder {
+	return b.add(Messages_KeyboardButtonActionLocation{
+		Payload: b.payload(payload),
+		Type:    Messages_KeyboardButtonActionLocation_Type_Location,
+	}, "", true)
+}
+
+// VKPay adds button that opens VK Pay window with parameters from hash. It takes the whole row.
+func (b *KeyboardBuilder) VKPay(hash, payload string) *KeyboardBuilder {
+	return b.add(Messages_KeyboardButtonActionVkpay{
+		Hash:    hash,
+		Payload: b.payload(payload),
+		Type:    Messages_KeyboardButtonActionVkpay_Type_Vkpay,
+	}, "", true)
+}
+
+// OpenApp adds button that opens VK Mini App. Hash may be empty. It takes the whole row.
+func (b *KeyboardBuilder) OpenApp(label string, appID, ownerID int, hash, payload string) *KeyboardBuilder {
+	b.checkLabel(label)
+
+	action := Messages_KeyboardButtonActionOpenApp{
+		AppId:   appID,
+		Label:   label,
+		OwnerId: ownerID,
+		Payload: b.payload(payload),
+		Type:    Messages_KeyboardButtonActionOpenApp_Type_OpenApp,
+	}
+
+	if hash != "" {
+		action.Hash = &hash
+	}
+
+	return b.add(action, "", true)
+}
+
+// Build returns keyboard or the first error of the builder.
+// Keyboard without buttons hides the current keyboard.
+func (b *KeyboardBuilder) Build() (Messages_Keyboard, error) {
+	if b.err != nil {
+		return Messages_Keyboard{}, b.err
+	}
+
+	buttons := make([][]Messages_KeyboardButton, 0, len(b.rows))
+
+	for _, row := range b.rows {
+		if len(row) > 0 {
+			buttons = append(buttons, row)
+		}
+	}
+
+	keyboard := Messages_Keyboard{
+		Buttons: buttons,
+		OneTime: b.oneTime,
+	}
+
+	if b.inline {
+		keyboard.Inline = &b.inline
+	}
+
+	return keyboard, nil
+}
+
+// add adds button with action to the current row.
+func (b *KeyboardBuilder) add(action interface{}, color Messages_KeyboardButton_Color, fullRow bool) *KeyboardBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	if len(b.rows) == 0 {
+		b.Row()
+	}
+
+	row := b.rows[len(b.rows)-1]
+
+	maxRows, maxButtons := MaxKeyboardRows, MaxKeyboardButtons
+
+	if b.inline {
+		maxRows, maxButtons = MaxInlineKeyboardRows, MaxInlineKeyboardButtons
+	}
+
+	switch {
+	case len(b.rows) > maxRows:
+		b.err = fmt.Errorf("keyboard can not contain more than %d rows", maxRows)
+	case b.buttons >= maxButtons:
+		b.err = fmt.Errorf("keyboard can not contain more than %d buttons", maxButtons)
+	case len(row) >= MaxKeyboardButtonsInRow:
+		b.err = fmt.Errorf("keyboard row can not contain more than %d buttons", MaxKeyboardButtonsInRow)
+	case len(row) > 0 && (fullRow || b.fullRow):
+		b.err = errors.New("location, vkpay and open_app buttons must be the only buttons in a row")
+	}
+
+	if b.err != nil {
+		return b
+	}
+
+	raw, err := json.Marshal(action)
+
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	button := Messages_KeyboardButton{
+		Action: Messages_KeyboardButtonPropertyAction{raw: raw},
+	}
+
+	if color != "" {
+		button.Color = &color
+	}
+
+	b.rows[len(b.rows)-1] = append(row, button)
+	b.buttons++
+	b.fullRow = fullRow
+
+	return b
+}
+
+// checkLabel checks button label length.
+func (b *KeyboardBuilder) checkLabel(label string) {
+	if b.err == nil && utf8.RuneCountInString(label) > MaxKeyboardButtonLabelLen {
+		b.err = fmt.Errorf("keyboard button label %q is longer than %d characters", label, MaxKeyboardButtonLabelLen)
+	}
+}
+
+// payload checks button payload and returns it as optional field.
+func (b *KeyboardBuilder) payload(payload string) *string {
+	if payload == "" {
+		return nil
+	}
+
+	if b.err == nil {
+		switch {
+		case len(payload) > MaxKeyboardButtonPayloadLen:
+			b.err = fmt.Errorf("keyboard button payload is longer than %d bytes", MaxKeyboardButtonPayloadLen)
+		case !json.Valid([]byte(payload)):
+			b.err = fmt.Errorf("keyboard button payload %q is not valid JSON", payload)
+		}
+	}
+
+	return &payload
+}
@@ -0,0 +1,67 @@
+package vk_sdk
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestKeyboardBuilder(t *testing.T) {
+	keyboard, err := NewKeyboard(true).
+		Text("Yes", `{"answer":1}`, Messages_KeyboardButton_Color_Positive).
+		Callback("No", "", "").
+		Row().
+		Location("").
+		Build()
+	require.NoError(t, err)
+
+	raw, err := json.Marshal(keyboard)
+	require.NoError(t, err)
+
+	expected := `{"buttons":[` +
+		`[{"action":{"label":"Yes","payload":"{\"answer\":1}","type":"text"},"color":"positive"},{"action":{"label":"No","type":"callback"}}],` +
+		`[{"action":{"type":"location"}}]` +
+		`],"one_time":true}`
+	assert.JSONEq(t, expected, string(raw))
+
+	tests := []struct {
+		name    string
+		builder *KeyboardBuilder
+	}{
+		{
+			name:    "Label",
+			builder: NewKeyboard(false).Text(strings.Repeat("a", MaxKeyboardButtonLabelLen+1), "", ""),
+		},
+		{
+			name:    "Payload length",
+			builder: NewKeyboard(false).Text("a", `"`+strings.Repeat("a", MaxKeyboardButtonPayloadLen)+`"`, ""),
+		},
+		{
+			name:    "Payload JSON",
+			builder: NewKeyboard(false).Text("a", "payload", ""),
+		},
+		{
+			name:    "Full row",
+			builder: NewKeyboard(false).Text("a", "", "").VKPay("hash", ""),
+		},
+		{
+			name:    "Row buttons",
+			builder: NewKeyboard(false).Text("1", "", "").Text("2", "", "").Text("3", "", "").Text("4", "", "").Text("5", "", "").Text("6", "", ""),
+		},
+		{
+			name: "Inline rows",
+			builder: NewInlineKeyboard().
+				Text("1", "", "").Row().Text("2", "", "").Row().Text("3", "", "").Row().
+				Text("4", "", "").Row().Text("5", "", "").Row().Text("6", "", "").Row().Text("7", "", ""),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.builder.Build()
+			assert.Error(t, err)
+		})
+	}
+}
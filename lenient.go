@@ -0,0 +1,379 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/mailru/easyjson"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DecodeMode is mode of decoding responses with values that do not match the schema.
+type DecodeMode int
+
+const (
+	// DecodeStrict fails the call with JSON error on any value of unexpected type. It is default mode.
+	DecodeStrict DecodeMode = iota
+	// DecodeLenient coerces common mismatches of value types and reports them as DriftWarning.
+	DecodeLenient
+)
+
+// Coercion is kind of conversion of response value to the type of the schema.
+type Coercion string
+
+const (
+	// CoercionNumericString converts numeric string to number, e.g. "123" to 123.
+	CoercionNumericString Coercion = "numeric string to number"
+	// CoercionNumberString converts number to string, e.g. 123 to "123".
+	CoercionNumberString Coercion = "number to string"
+	// CoercionBoolNumber converts bool to 0 or 1, e.g. true to Base_BoolInt_Yes.
+	CoercionBoolNumber Coercion = "bool to number"
+	// CoercionNumberBool converts 0 or 1 to bool.
+	CoercionNumberBool Coercion = "number to bool"
+	// CoercionEmptyArrayObject converts empty array to empty object.
+	CoercionEmptyArrayObject Coercion = "empty array to object"
+	// CoercionEmptyObjectArray converts empty object to empty array.
+	CoercionEmptyObjectArray Coercion = "empty object to array"
+	// CoercionFalseNull converts false to null where object or array is expected.
+	CoercionFalseNull Coercion = "false to null"
+)
+
+// DriftWarning is response value that did not match the schema and was coerced in lenient mode.
+type DriftWarning struct {
+	// Method is API method name, e.g. "users.get".
+	Method string
+	// Path is JSON path of the value, e.g. "response.items[0].date".
+	Path string
+	// Type is Go type of the value by the schema, e.g. "vk_sdk.Base_BoolInt".
+	Type string
+	// Value is received JSON value, long values are truncated.
+	Value    string
+	Coercion Coercion
+}
+
+func (w DriftWarning) String() string {
+	return fmt.Sprintf("%s: %s: %s %s as %s", w.Method, w.Path, w.Coercion, w.Value, w.Type)
+}
+
+// DriftHandler receives warnings of lenient decoding. It may be called concurrently.
+type DriftHandler func(w DriftWarning)
+
+// SetDecodeMode sets mode of decoding method responses.
+// In DecodeLenient mode response that fails to decode is decoded again with coerced values
+// and handler, if not nil, is called for every coercion.
+func (vk *VK) SetDecodeMode(mode DecodeMode, handler DriftHandler) {
+	vk.decodeMode = mode
+	vk.driftHandler = handler
+}
+
+// unmarshalFunc unmarshal method response to dst.
+type unmarshalFunc func(data []byte, dst interface{}) error
+
+// unmarshaler returns unmarshalFunc of method responses by decode mode
+// that records unknown fields of decoded responses if drift report is set.
+// It returns nil in DecodeStrict mode without drift report,
+// so responses are decoded by generated unmarshalers directly.
+func (vk *VK) unmarshaler(methodName string) unmarshalFunc {
+	report := vk.driftReport
+
+	if vk.decodeMode != DecodeLenient && report == nil {
+		return nil
+	}
+
+	unmarshal := unmarshalResponse
+
+	if vk.decodeMode == DecodeLenient {
+		handler := vk.driftHandler
+
+		unmarshal = func(data []byte, dst interface{}) error {
+			return unmarshalLenient(methodName, data, dst, handler)
+		}
+	}
+
+	if report == nil {
+		return unmarshal
+	}
+
+	return func(data []byte, dst interface{}) error {
+		if err := unmarshal(data, dst); err != nil {
+			return err
+		}
+
+		report.inspect(methodName, data, dst)
+
+		return nil
+	}
+}
+
+// maxDriftValueLen is maximum length of DriftWarning.Value.
+const maxDriftValueLen = 64
+
+// unmarshalLenient unmarshal data to dst and, if it fails, coerces values
+// that do not match types of dst and unmarshal data again.
+// The original error is returned if coerced data does not match dst too.
+func unmarshalLenient(methodName string, data []byte, dst interface{}, handler DriftHandler) error {
+	err := unmarshalResponse(data, dst)
+
+	if err == nil || dst == nil {
+		return err
+	}
+
+	var v interface{}
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	// syntax errors are not coerced
+	if d.Decode(&v) != nil {
+		return err
+	}
+
+	c := coercer{method: methodName}
+	v = c.coerce(reflect.TypeOf(dst), v, "")
+
+	if len(c.warnings) == 0 {
+		return err
+	}
+
+	coerced, marshalErr := json.Marshal(v)
+
+	if marshalErr != nil {
+		return err
+	}
+
+	if rv := reflect.ValueOf(dst); rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv.Elem().Set(reflect.Zero(rv.Elem().Type()))
+	}
+
+	if unmarshalResponse(coerced, dst) != nil {
+		return err
+	}
+
+	if handler != nil {
+		for _, w := range c.warnings {
+			handler(w)
+		}
+	}
+
+	return nil
+}
+
+// coercer converts values of decoded JSON to types of the schema.
+type coercer struct {
+	method   string
+	warnings []DriftWarning
+}
+
+var (
+	jsonUnmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	easyjsonUnmarshalerType = reflect.TypeOf((*easyjson.Unmarshaler)(nil)).Elem()
+)
+
+// coerce returns v converted to type t. Value at path is reported if it is converted.
+func (c *coercer) coerce(t reflect.Type, v interface{}, path string) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// values of custom unmarshalers like oneOf objects are not known, but generated easyjson ones are
+	if pt := reflect.PtrTo(t); pt.Implements(jsonUnmarshalerType) && !pt.Implements(easyjsonUnmarshalerType) {
+		return v
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return c.coerceObject(t, v, path)
+		}
+
+		fields := jsonFields(t)
+
+		for _, key := range sortedKeys(obj) {
+			if ft, ok := fields[key]; ok {
+				obj[key] = c.coerce(ft, obj[key], joinPath(path, key))
+			}
+		}
+
+		return obj
+	case reflect.Map:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return c.coerceObject(t, v, path)
+		}
+
+		for _, key := range sortedKeys(obj) {
+			obj[key] = c.coerce(t.Elem(), obj[key], joinPath(path, key))
+		}
+
+		return obj
+	case reflect.Slice, reflect.Array:
+		arr, ok := v.([]interface{})
+		if !ok {
+			return c.coerceArray(t, v, path)
+		}
+
+		for i := range arr {
+			arr[i] = c.coerce(t.Elem(), arr[i], path+"["+strconv.Itoa(i)+"]")
+		}
+
+		return arr
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch x := v.(type) {
+		case string:
+			if _, err := strconv.ParseInt(strings.TrimSpace(x), 10, 64); err == nil {
+				return c.warn(t, v, path, CoercionNumericString, json.Number(strings.TrimSpace(x)))
+			}
+		case bool:
+			return c.warn(t, v, path, CoercionBoolNumber, boolNumber(x))
+		}
+	case reflect.Float32, reflect.Float64:
+		switch x := v.(type) {
+		case string:
+			if _, err := strconv.ParseFloat(strings.TrimSpace(x), 64); err == nil {
+				return c.warn(t, v, path, CoercionNumericString, json.Number(strings.TrimSpace(x)))
+			}
+		case bool:
+			return c.warn(t, v, path, CoercionBoolNumber, boolNumber(x))
+		}
+	case reflect.Bool:
+		if x, ok := v.(json.Number); ok && (x == "0" || x == "1") {
+			return c.warn(t, v, path, CoercionNumberBool, x == "1")
+		}
+	case reflect.String:
+		if x, ok := v.(json.Number); ok {
+			return c.warn(t, v, path, CoercionNumberString, string(x))
+		}
+	}
+
+	return v
+}
+
+// coerceObject coerces value of object type that is not object.
+func (c *coercer) coerceObject(t reflect.Type, v interface{}, path string) interface{} {
+	switch x := v.(type) {
+	case []interface{}:
+		if len(x) == 0 {
+			return c.warn(t, v, path, CoercionEmptyArrayObject, map[string]interface{}{})
+		}
+	case bool:
+		if !x {
+			return c.warn(t, v, path, CoercionFalseNull, nil)
+		}
+	}
+
+	return v
+}
+
+// coerceArray coerces value of array type that is not array.
+func (c *coercer) coerceArray(t reflect.Type, v interface{}, path string) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		if len(x) == 0 {
+			return c.warn(t, v, path, CoercionEmptyObjectArray, []interface{}{})
+		}
+	case bool:
+		if !x {
+			return c.warn(t, v, path, CoercionFalseNull, nil)
+		}
+	}
+
+	return v
+}
+
+// warn records coercion of value v and returns coerced value.
+func (c *coercer) warn(t reflect.Type, v interface{}, path string, coercion Coercion, coerced interface{}) interface{} {
+	raw, _ := json.Marshal(v)
+
+	value := string(raw)
+	if len(value) > maxDriftValueLen {
+		value = value[:maxDriftValueLen] + "..."
+	}
+
+	c.warnings = append(c.warnings, DriftWarning{
+		Method:   c.method,
+		Path:     path,
+		Type:     t.String(),
+		Value:    value,
+		Coercion: coercion,
+	})
+
+	return coerced
+}
+
+func boolNumber(b bool) json.Number {
+	if b {
+		return "1"
+	}
+
+	return "0"
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// structFields caches JSON fields of struct types.
+var structFields sync.Map
+
+// jsonFields returns types of struct fields including promoted ones by their JSON names.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	if fields, ok := structFields.Load(t); ok {
+		return fields.(map[string]reflect.Type)
+	}
+
+	fields := make(map[string]reflect.Type)
+	depths := make(map[string]int)
+
+	for _, f := range reflect.VisibleFields(t) {
+		if f.Anonymous || !f.IsExported() {
+			continue
+		}
+
+		name := f.Name
+
+		if tag := f.Tag.Get("json"); tag != "" {
+			name = strings.Split(tag, ",")[0]
+		}
+
+		if name == "-" {
+			continue
+		}
+
+		// the shallowest field wins as in encoding/json
+		if depth, ok := depths[name]; ok && depth <= len(f.Index) {
+			continue
+		}
+
+		fields[name] = f.Type
+		depths[name] = len(f.Index)
+	}
+
+	structFields.Store(t, fields)
+
+	return fields
+}
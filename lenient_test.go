@@ -0,0 +1,100 @@
+package vk_sdk
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+const driftedUsersResponse = `{"response":[{"id":"1","first_name":123,"online":true,"city":false,"counters":[],"career":{}}]}`
+
+func TestVK_SetDecodeMode(t *testing.T) {
+	t.Run("strict", func(t *testing.T) {
+		vk := NewVK(newSequenceTestClient(t, []string{driftedUsersResponse}, func(int, url.Values) {}))
+
+		_, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+		assert.Nil(t, apiErr)
+		assert.Error(t, err)
+	})
+
+	t.Run("lenient", func(t *testing.T) {
+		vk := NewVK(newSequenceTestClient(t, []string{driftedUsersResponse}, func(int, url.Values) {}))
+
+		var warnings []DriftWarning
+		vk.SetDecodeMode(DecodeLenient, func(w DriftWarning) {
+			warnings = append(warnings, w)
+		})
+
+		resp, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+		require.NoError(t, err)
+		require.Nil(t, apiErr)
+
+		require.Len(t, resp.Response, 1)
+		user := resp.Response[0]
+		assert.Equal(t, 1, user.Id)
+		assert.Equal(t, "123", *user.FirstName)
+		assert.Equal(t, Base_BoolInt_Yes, *user.Online)
+		assert.Nil(t, user.City)
+		assert.NotNil(t, user.Counters)
+		assert.Equal(t, []Users_Career{}, *user.Career)
+
+		assert.Equal(t, []DriftWarning{
+			{Method: "users.get", Path: "response[0].career", Type: "[]vk_sdk.Users_Career", Value: "{}", Coercion: CoercionEmptyObjectArray},
+			{Method: "users.get", Path: "response[0].city", Type: "vk_sdk.Base_City", Value: "false", Coercion: CoercionFalseNull},
+			{Method: "users.get", Path: "response[0].counters", Type: "vk_sdk.Users_UserCounters", Value: "[]", Coercion: CoercionEmptyArrayObject},
+			{Method: "users.get", Path: "response[0].first_name", Type: "string", Value: "123", Coercion: CoercionNumberString},
+			{Method: "users.get", Path: "response[0].id", Type: "int", Value: `"1"`, Coercion: CoercionNumericString},
+			{Method: "users.get", Path: "response[0].online", Type: "vk_sdk.Base_BoolInt", Value: "true", Coercion: CoercionBoolNumber},
+		}, warnings)
+	})
+
+	t.Run("not coercible", func(t *testing.T) {
+		responses := []string{`{"response":[{"id":"first","online":true}]}`}
+		vk := NewVK(newSequenceTestClient(t, responses, func(int, url.Values) {}))
+
+		called := false
+		vk.SetDecodeMode(DecodeLenient, func(DriftWarning) {
+			called = true
+		})
+
+		_, _, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+		assert.Error(t, err)
+		assert.False(t, called)
+	})
+}
+
+func TestVK_SetDecodeMode_Execute(t *testing.T) {
+	responses := []string{`{"response":[[{"id":1,"online":false}],{"count":"2","items":[1,2]}]}`}
+	vk := NewVK(newSequenceTestClient(t, responses, func(int, url.Values) {}))
+
+	var mu sync.Mutex
+	var warnings []DriftWarning
+	vk.SetDecodeMode(DecodeLenient, func(w DriftWarning) {
+		mu.Lock()
+		warnings = append(warnings, w)
+		mu.Unlock()
+	})
+
+	var users Users_Get_Response
+	var friends Friends_Get_Response
+
+	batch := NewExecuteBatch()
+	_, err := batch.Add("users.get", Users_Get_Request{}, &users)
+	require.NoError(t, err)
+	_, err = batch.Add("friends.get", Friends_Get_Request{}, &friends)
+	require.NoError(t, err)
+
+	apiErr, err := vk.ExecuteBatch(context.Background(), batch)
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+
+	assert.Equal(t, Base_BoolInt_No, *users.Response[0].Online)
+	assert.Equal(t, 2, friends.Response.Count)
+	assert.Equal(t, []DriftWarning{
+		{Method: "users.get", Path: "response[0].online", Type: "vk_sdk.Base_BoolInt", Value: "false", Coercion: CoercionBoolNumber},
+		{Method: "friends.get", Path: "response.count", Type: "int", Value: `"2"`, Coercion: CoercionNumericString},
+	}, warnings)
+}
@@ -0,0 +1,115 @@
+package vk_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// LongPollFailed is the code of the "failed" field returned by Long Poll server.
+//
+// https://dev.vk.com/api/bots-long-poll/getting-started
+type LongPollFailed int
+
+const (
+	// LongPollFailedHistory the event history went out of date or was partially lost,
+	// the application can receive events further using the new ts value from the response.
+	LongPollFailedHistory LongPollFailed = 1
+	// LongPollFailedKey the key's active period expired, it's necessary to receive a key again
+	// using the getLongPollServer method.
+	LongPollFailedKey LongPollFailed = 2
+	// LongPollFailedInfo the information was lost, it's necessary to request a new key and ts
+	// using the getLongPollServer method.
+	LongPollFailedInfo LongPollFailed = 3
+	// LongPollFailedVersion an invalid version number was passed in the version parameter.
+	// Only for User Long Poll.
+	LongPollFailedVersion LongPollFailed = 4
+)
+
+// DefaultLongPollWait is default waiting period in seconds. Maximum is 90.
+const DefaultLongPollWait = 25
+
+// LongPollError is returned when Long Poll server responds with
+// the "failed" code which can not be recovered automatically.
+type LongPollError struct {
+	Failed     LongPollFailed
+	MinVersion *int
+	MaxVersion *int
+}
+
+func (e LongPollError) Error() string {
+	return fmt.Sprintf("long poll failed with code %d", e.Failed)
+}
+
+// longPollResponse contains common fields of Bots and User Long Poll responses.
+type longPollResponse struct {
+	Ts         json.Number       `json:"ts"`
+	Pts        *int              `json:"pts,omitempty"`
+	Failed     LongPollFailed    `json:"failed"`
+	MinVersion *int              `json:"min_version,omitempty"`
+	MaxVersion *int              `json:"max_version,omitempty"`
+	Updates    []json.RawMessage `json:"updates"`
+}
+
+// getLongPollURL builds Long Poll request URL from server address and parameters.
+// Server address may be passed with or without scheme.
+func getLongPollURL(server string, values url.Values) string {
+	if !strings.HasPrefix(server, "http://") && !strings.HasPrefix(server, "https://") {
+		server = "https://" + server
+	}
+
+	return server + "?" + values.Encode()
+}
+
+// doLongPollReq does request to Long Poll server and returns parsed response.
+func doLongPollReq(ctx context.Context, client *http.Client, server string, values url.Values) (lpResp longPollResponse, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getLongPollURL(server, values), nil)
+
+	if err != nil {
+		return lpResp, err
+	}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return lpResp, err
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return lpResp, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return lpResp, fmt.Errorf("long poll server responded with status %s", resp.Status)
+	}
+
+	err = json.Unmarshal(respBody, &lpResp)
+
+	return lpResp, err
+}
+
+// setLongPollWait sets wait period limited by allowed values.
+func setLongPollWait(vs url.Values, wait int) {
+	if wait <= 0 {
+		wait = DefaultLongPollWait
+	}
+
+	if wait > 90 {
+		wait = 90
+	}
+
+	vs.Set("wait", strconv.Itoa(wait))
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
@@ -17,6 +18,27 @@ func (f TestRoundTrip) RoundTrip(req *http.Request) (*http.Response, error) {
 	return f(req)
 }
 
+// newServerTestClient starts test server with handler and returns client that sends API requests to it
+// and URL of the server.
+func newServerTestClient(t *testing.T, handler http.Handler) (*http.Client, string) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == apiHost {
+			req.URL.Scheme = serverURL.Scheme
+			req.URL.Host = serverURL.Host
+		}
+
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	return &http.Client{Transport: transport}, server.URL
+}
+
 func NewTestClient(t *testing.T, token, methodName string, requestValues url.Values, responseBodyRaw []byte) *http.Client {
 	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
 		assert.Equal(t, apiScheme, req.URL.Scheme)
@@ -19,6 +19,11 @@ func (r Account_Ban_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_Ban_Request) Validate() (err error) {
+	return
+}
+
 // Account_Ban ...
 // May execute with listed access token types:
 //    [ user ]
@@ -26,6 +31,9 @@ func (r Account_Ban_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.ban
 func (vk *VK) Account_Ban(ctx context.Context, req Account_Ban_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -61,6 +69,17 @@ func (r Account_ChangePassword_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_ChangePassword_Request) Validate() (err error) {
+	if err = checkRequired("new_password", r.NewPassword != ""); err != nil {
+		return
+	}
+	if err = checkMinLength("new_password", r.NewPassword, 6); err != nil {
+		return
+	}
+	return
+}
+
 // Account_ChangePassword Changes a user password after access is successfully restored with the [vk.com/dev/auth.restore|auth.restore] method.
 // May execute with listed access token types:
 //    [ user ]
@@ -68,6 +87,9 @@ func (r Account_ChangePassword_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.changePassword
 func (vk *VK) Account_ChangePassword(ctx context.Context, req Account_ChangePassword_Request, options ...Option) (resp Account_ChangePassword_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -98,6 +120,24 @@ func (r Account_GetActiveOffers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_GetActiveOffers_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Account_GetActiveOffers Returns a list of active ads (offers) which executed by the user will bring him/her respective number of votes to his balance in the application.
 // May execute with listed access token types:
 //    [ user ]
@@ -105,6 +145,9 @@ func (r Account_GetActiveOffers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.getActiveOffers
 func (vk *VK) Account_GetActiveOffers(ctx context.Context, req Account_GetActiveOffers_Request, options ...Option) (resp Account_GetActiveOffers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -126,6 +169,14 @@ func (r Account_GetAppPermissions_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_GetAppPermissions_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Account_GetAppPermissions Gets settings of the user in this application.
 // May execute with listed access token types:
 //    [ user ]
@@ -133,6 +184,9 @@ func (r Account_GetAppPermissions_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/account.getAppPermissions
 func (vk *VK) Account_GetAppPermissions(ctx context.Context, req Account_GetAppPermissions_Request, options ...Option) (resp Account_GetAppPermissions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -163,6 +217,24 @@ func (r Account_GetBanned_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_GetBanned_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Account_GetBanned Returns a user's blacklist.
 // May execute with listed access token types:
 //    [ user ]
@@ -170,6 +242,9 @@ func (r Account_GetBanned_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.getBanned
 func (vk *VK) Account_GetBanned(ctx context.Context, req Account_GetBanned_Request, options ...Option) (resp Account_GetBanned_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -218,6 +293,23 @@ func (r Account_GetCounters_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_GetCounters_Request) Validate() (err error) {
+	if r.Filter != nil {
+		for _, v := range *r.Filter {
+			if err = checkStringEnum("filter", string(v), "friends", "messages", "photos", "notes", "gifts", "events", "groups", "sdk", "friends_suggestions", "notifications", "app_requests", "friends_recommendations"); err != nil {
+				return
+			}
+		}
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Account_GetCounters Returns non-null values of user counters.
 // May execute with listed access token types:
 //    [ user ]
@@ -225,6 +317,9 @@ func (r Account_GetCounters_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.getCounters
 func (vk *VK) Account_GetCounters(ctx context.Context, req Account_GetCounters_Request, options ...Option) (resp Account_GetCounters_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -260,6 +355,18 @@ func (r Account_GetInfo_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_GetInfo_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "country", "https_required", "own_posts_default", "no_wall_replies", "intro", "lang"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Account_GetInfo Returns current account info.
 // May execute with listed access token types:
 //    [ user ]
@@ -267,6 +374,9 @@ func (r Account_GetInfo_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.getInfo
 func (vk *VK) Account_GetInfo(ctx context.Context, req Account_GetInfo_Request, options ...Option) (resp Account_GetInfo_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -301,6 +411,11 @@ func (r Account_GetPushSettings_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_GetPushSettings_Request) Validate() (err error) {
+	return
+}
+
 // Account_GetPushSettings Gets settings of push notifications.
 // May execute with listed access token types:
 //    [ user ]
@@ -308,6 +423,9 @@ func (r Account_GetPushSettings_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.getPushSettings
 func (vk *VK) Account_GetPushSettings(ctx context.Context, req Account_GetPushSettings_Request, options ...Option) (resp Account_GetPushSettings_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -355,6 +473,17 @@ func (r Account_RegisterDevice_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_RegisterDevice_Request) Validate() (err error) {
+	if err = checkRequired("token", r.Token != ""); err != nil {
+		return
+	}
+	if err = checkRequired("device_id", r.DeviceId != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Account_RegisterDevice Subscribes an iOS/Android/Windows Phone-based device to receive push notifications
 // May execute with listed access token types:
 //    [ user ]
@@ -362,6 +491,9 @@ func (r Account_RegisterDevice_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.registerDevice
 func (vk *VK) Account_RegisterDevice(ctx context.Context, req Account_RegisterDevice_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -485,6 +617,55 @@ func (r Account_SaveProfileInfo_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_SaveProfileInfo_Request) Validate() (err error) {
+	if r.CancelRequestId != nil {
+		if err = checkMinimum("cancel_request_id", float64(*r.CancelRequestId), 0); err != nil {
+			return
+		}
+	}
+	if r.Sex != nil {
+		if err = checkMinimum("sex", float64(*r.Sex), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("sex", int(*r.Sex), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Relation != nil {
+		if err = checkMinimum("relation", float64(*r.Relation), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("relation", int(*r.Relation), 1, 2, 3, 4, 5, 6, 7, 0); err != nil {
+			return
+		}
+	}
+	if r.RelationPartnerId != nil {
+		if err = checkMinimum("relation_partner_id", float64(*r.RelationPartnerId), 0); err != nil {
+			return
+		}
+	}
+	if r.BdateVisibility != nil {
+		if err = checkMinimum("bdate_visibility", float64(*r.BdateVisibility), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("bdate_visibility", int(*r.BdateVisibility), 1, 2, 0); err != nil {
+			return
+		}
+	}
+	if r.CountryId != nil {
+		if err = checkMinimum("country_id", float64(*r.CountryId), 0); err != nil {
+			return
+		}
+	}
+	if r.CityId != nil {
+		if err = checkMinimum("city_id", float64(*r.CityId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Account_SaveProfileInfo Edits current profile info.
 // May execute with listed access token types:
 //    [ user ]
@@ -493,6 +674,9 @@ func (r Account_SaveProfileInfo_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.saveProfileInfo
 func (vk *VK) Account_SaveProfileInfo(ctx context.Context, req Account_SaveProfileInfo_Request, options ...Option) (resp Account_SaveProfileInfo_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 16+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -527,6 +711,16 @@ func (r Account_SetInfo_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_SetInfo_Request) Validate() (err error) {
+	if r.Name != nil {
+		if err = checkStringEnum("name", string(*r.Name), "intro", "no_wall_replies", "own_posts_default"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Account_SetInfo Allows to edit the current account info.
 // May execute with listed access token types:
 //    [ user ]
@@ -534,6 +728,9 @@ func (r Account_SetInfo_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.setInfo
 func (vk *VK) Account_SetInfo(ctx context.Context, req Account_SetInfo_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -568,6 +765,11 @@ func (r Account_SetOnline_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_SetOnline_Request) Validate() (err error) {
+	return
+}
+
 // Account_SetOnline Marks the current user as online for 15 minutes.
 // May execute with listed access token types:
 //    [ user ]
@@ -575,6 +777,9 @@ func (r Account_SetOnline_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.setOnline
 func (vk *VK) Account_SetOnline(ctx context.Context, req Account_SetOnline_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -608,6 +813,14 @@ func (r Account_SetPushSettings_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_SetPushSettings_Request) Validate() (err error) {
+	if err = checkRequired("device_id", r.DeviceId != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Account_SetPushSettings Change push settings.
 // May execute with listed access token types:
 //    [ user ]
@@ -615,6 +828,9 @@ func (r Account_SetPushSettings_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.setPushSettings
 func (vk *VK) Account_SetPushSettings(ctx context.Context, req Account_SetPushSettings_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -651,6 +867,11 @@ func (r Account_SetSilenceMode_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_SetSilenceMode_Request) Validate() (err error) {
+	return
+}
+
 // Account_SetSilenceMode Mutes push notifications for the set period of time.
 // May execute with listed access token types:
 //    [ user ]
@@ -658,6 +879,9 @@ func (r Account_SetSilenceMode_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.setSilenceMode
 func (vk *VK) Account_SetSilenceMode(ctx context.Context, req Account_SetSilenceMode_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -679,6 +903,11 @@ func (r Account_Unban_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_Unban_Request) Validate() (err error) {
+	return
+}
+
 // Account_Unban ...
 // May execute with listed access token types:
 //    [ user ]
@@ -686,6 +915,9 @@ func (r Account_Unban_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/account.unban
 func (vk *VK) Account_Unban(ctx context.Context, req Account_Unban_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -712,6 +944,11 @@ func (r Account_UnregisterDevice_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Account_UnregisterDevice_Request) Validate() (err error) {
+	return
+}
+
 // Account_UnregisterDevice Unsubscribes a device from push notifications.
 // May execute with listed access token types:
 //    [ user ]
@@ -719,6 +956,9 @@ func (r Account_UnregisterDevice_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/account.unregisterDevice
 func (vk *VK) Account_UnregisterDevice(ctx context.Context, req Account_UnregisterDevice_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -747,6 +987,11 @@ func (r Ads_AddOfficeUsers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_AddOfficeUsers_Request) Validate() (err error) {
+	return
+}
+
 // Ads_AddOfficeUsers Adds managers and/or supervisors to advertising account.
 // May execute with listed access token types:
 //    [ user ]
@@ -755,6 +1000,9 @@ func (r Ads_AddOfficeUsers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.addOfficeUsers
 func (vk *VK) Ads_AddOfficeUsers(ctx context.Context, req Ads_AddOfficeUsers_Request, options ...Option) (resp Ads_AddOfficeUsers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -795,6 +1043,20 @@ func (r Ads_CheckLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_CheckLink_Request) Validate() (err error) {
+	if err = checkRequired("link_type", r.LinkType != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("link_type", string(r.LinkType), "community", "post", "application", "video", "site"); err != nil {
+		return
+	}
+	if err = checkRequired("link_url", r.LinkUrl != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_CheckLink Allows to check the ad link.
 // May execute with listed access token types:
 //    [ user ]
@@ -802,6 +1064,9 @@ func (r Ads_CheckLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.checkLink
 func (vk *VK) Ads_CheckLink(ctx context.Context, req Ads_CheckLink_Request, options ...Option) (resp Ads_CheckLink_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -824,6 +1089,14 @@ func (r Ads_CreateAds_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_CreateAds_Request) Validate() (err error) {
+	if err = checkRequired("data", r.Data != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_CreateAds Creates ads.
 // May execute with listed access token types:
 //    [ user ]
@@ -832,6 +1105,9 @@ func (r Ads_CreateAds_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.createAds
 func (vk *VK) Ads_CreateAds(ctx context.Context, req Ads_CreateAds_Request, options ...Option) (resp Ads_CreateAds_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -854,6 +1130,14 @@ func (r Ads_CreateCampaigns_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_CreateCampaigns_Request) Validate() (err error) {
+	if err = checkRequired("data", r.Data != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_CreateCampaigns Creates advertising campaigns.
 // May execute with listed access token types:
 //    [ user ]
@@ -862,6 +1146,9 @@ func (r Ads_CreateCampaigns_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.createCampaigns
 func (vk *VK) Ads_CreateCampaigns(ctx context.Context, req Ads_CreateCampaigns_Request, options ...Option) (resp Ads_CreateCampaigns_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -884,6 +1171,14 @@ func (r Ads_CreateClients_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_CreateClients_Request) Validate() (err error) {
+	if err = checkRequired("data", r.Data != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_CreateClients Creates clients of an advertising agency.
 // May execute with listed access token types:
 //    [ user ]
@@ -892,6 +1187,9 @@ func (r Ads_CreateClients_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.createClients
 func (vk *VK) Ads_CreateClients(ctx context.Context, req Ads_CreateClients_Request, options ...Option) (resp Ads_CreateClients_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -932,6 +1230,20 @@ func (r Ads_CreateTargetGroup_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_CreateTargetGroup_Request) Validate() (err error) {
+	if err = checkRequired("name", r.Name != ""); err != nil {
+		return
+	}
+	if err = checkMinimum("lifetime", float64(r.Lifetime), 1); err != nil {
+		return
+	}
+	if err = checkMaximum("lifetime", float64(r.Lifetime), 720); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_CreateTargetGroup Creates a group to re-target ads for users who visited advertiser's site (viewed information about the product, registered, etc.).
 // May execute with listed access token types:
 //    [ user ]
@@ -940,6 +1252,9 @@ func (r Ads_CreateTargetGroup_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.createTargetGroup
 func (vk *VK) Ads_CreateTargetGroup(ctx context.Context, req Ads_CreateTargetGroup_Request, options ...Option) (resp Ads_CreateTargetGroup_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -962,6 +1277,14 @@ func (r Ads_DeleteAds_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_DeleteAds_Request) Validate() (err error) {
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_DeleteAds Archives ads.
 // May execute with listed access token types:
 //    [ user ]
@@ -970,6 +1293,9 @@ func (r Ads_DeleteAds_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.deleteAds
 func (vk *VK) Ads_DeleteAds(ctx context.Context, req Ads_DeleteAds_Request, options ...Option) (resp Ads_DeleteAds_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -992,6 +1318,14 @@ func (r Ads_DeleteCampaigns_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_DeleteCampaigns_Request) Validate() (err error) {
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_DeleteCampaigns Archives advertising campaigns.
 // May execute with listed access token types:
 //    [ user ]
@@ -1000,6 +1334,9 @@ func (r Ads_DeleteCampaigns_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.deleteCampaigns
 func (vk *VK) Ads_DeleteCampaigns(ctx context.Context, req Ads_DeleteCampaigns_Request, options ...Option) (resp Ads_DeleteCampaigns_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1022,6 +1359,14 @@ func (r Ads_DeleteClients_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_DeleteClients_Request) Validate() (err error) {
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_DeleteClients Archives clients of an advertising agency.
 // May execute with listed access token types:
 //    [ user ]
@@ -1030,6 +1375,9 @@ func (r Ads_DeleteClients_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.deleteClients
 func (vk *VK) Ads_DeleteClients(ctx context.Context, req Ads_DeleteClients_Request, options ...Option) (resp Ads_DeleteClients_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1057,6 +1405,11 @@ func (r Ads_DeleteTargetGroup_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_DeleteTargetGroup_Request) Validate() (err error) {
+	return
+}
+
 // Ads_DeleteTargetGroup Deletes a retarget group.
 // May execute with listed access token types:
 //    [ user ]
@@ -1065,6 +1418,9 @@ func (r Ads_DeleteTargetGroup_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.deleteTargetGroup
 func (vk *VK) Ads_DeleteTargetGroup(ctx context.Context, req Ads_DeleteTargetGroup_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1132,6 +1488,11 @@ func (r Ads_GetAds_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetAds_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetAds Returns number of ads.
 // May execute with listed access token types:
 //    [ user ]
@@ -1140,6 +1501,9 @@ func (r Ads_GetAds_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getAds
 func (vk *VK) Ads_GetAds(ctx context.Context, req Ads_GetAds_Request, options ...Option) (resp Ads_GetAds_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1194,6 +1558,11 @@ func (r Ads_GetAdsLayout_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetAdsLayout_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetAdsLayout Returns descriptions of ad layouts.
 // May execute with listed access token types:
 //    [ user ]
@@ -1202,6 +1571,9 @@ func (r Ads_GetAdsLayout_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getAdsLayout
 func (vk *VK) Ads_GetAdsLayout(ctx context.Context, req Ads_GetAdsLayout_Request, options ...Option) (resp Ads_GetAdsLayout_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1251,6 +1623,11 @@ func (r Ads_GetAdsTargeting_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetAdsTargeting_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetAdsTargeting Returns ad targeting parameters.
 // May execute with listed access token types:
 //    [ user ]
@@ -1259,6 +1636,9 @@ func (r Ads_GetAdsTargeting_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getAdsTargeting
 func (vk *VK) Ads_GetAdsTargeting(ctx context.Context, req Ads_GetAdsTargeting_Request, options ...Option) (resp Ads_GetAdsTargeting_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1278,6 +1658,11 @@ func (r Ads_GetBudget_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetBudget_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetBudget Returns current budget of the advertising account.
 // May execute with listed access token types:
 //    [ user ]
@@ -1286,6 +1671,9 @@ func (r Ads_GetBudget_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getBudget
 func (vk *VK) Ads_GetBudget(ctx context.Context, req Ads_GetBudget_Request, options ...Option) (resp Ads_GetBudget_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1334,14 +1722,29 @@ func (r Ads_GetCampaigns_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
-// Ads_GetCampaigns Returns a list of campaigns in an advertising account.
-// May execute with listed access token types:
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetCampaigns_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "ads_count"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// Ads_GetCampaigns Returns a list of campaigns in an advertising account.
+// May execute with listed access token types:
 //    [ user ]
 // When executing method, may return one of global or with listed codes API errors:
 //    [ Error_WeightedFlood ]
 //
 // https://dev.vk.com/method/ads.getCampaigns
 func (vk *VK) Ads_GetCampaigns(ctx context.Context, req Ads_GetCampaigns_Request, options ...Option) (resp Ads_GetCampaigns_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1363,6 +1766,11 @@ func (r Ads_GetCategories_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetCategories_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetCategories Returns a list of possible ad categories.
 // May execute with listed access token types:
 //    [ user ]
@@ -1370,6 +1778,9 @@ func (r Ads_GetCategories_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getCategories
 func (vk *VK) Ads_GetCategories(ctx context.Context, req Ads_GetCategories_Request, options ...Option) (resp Ads_GetCategories_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1389,6 +1800,11 @@ func (r Ads_GetClients_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetClients_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetClients Returns a list of advertising agency's clients.
 // May execute with listed access token types:
 //    [ user ]
@@ -1397,6 +1813,9 @@ func (r Ads_GetClients_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getClients
 func (vk *VK) Ads_GetClients(ctx context.Context, req Ads_GetClients_Request, options ...Option) (resp Ads_GetClients_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1446,6 +1865,32 @@ func (r Ads_GetDemographics_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetDemographics_Request) Validate() (err error) {
+	if err = checkRequired("ids_type", r.IdsType != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("ids_type", string(r.IdsType), "ad", "campaign"); err != nil {
+		return
+	}
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	if err = checkRequired("period", r.Period != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("period", string(r.Period), "day", "month", "overall"); err != nil {
+		return
+	}
+	if err = checkRequired("date_from", r.DateFrom != ""); err != nil {
+		return
+	}
+	if err = checkRequired("date_to", r.DateTo != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_GetDemographics Returns demographics for ads or campaigns.
 // May execute with listed access token types:
 //    [ user ]
@@ -1454,6 +1899,9 @@ func (r Ads_GetDemographics_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getDemographics
 func (vk *VK) Ads_GetDemographics(ctx context.Context, req Ads_GetDemographics_Request, options ...Option) (resp Ads_GetDemographics_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1473,6 +1921,11 @@ func (r Ads_GetFloodStats_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetFloodStats_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetFloodStats Returns information about current state of a counter — number of remaining runs of methods and time to the next counter nulling in seconds.
 // May execute with listed access token types:
 //    [ user ]
@@ -1480,6 +1933,9 @@ func (r Ads_GetFloodStats_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getFloodStats
 func (vk *VK) Ads_GetFloodStats(ctx context.Context, req Ads_GetFloodStats_Request, options ...Option) (resp Ads_GetFloodStats_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1524,6 +1980,24 @@ func (r Ads_GetLookalikeRequests_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetLookalikeRequests_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("limit", float64(*r.Limit), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Ads_GetLookalikeRequests ...
 // May execute with listed access token types:
 //    [ user ]
@@ -1532,6 +2006,9 @@ func (r Ads_GetLookalikeRequests_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/ads.getLookalikeRequests
 func (vk *VK) Ads_GetLookalikeRequests(ctx context.Context, req Ads_GetLookalikeRequests_Request, options ...Option) (resp Ads_GetLookalikeRequests_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1551,6 +2028,17 @@ func (r Ads_GetMusicians_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetMusicians_Request) Validate() (err error) {
+	if err = checkRequired("artist_name", r.ArtistName != ""); err != nil {
+		return
+	}
+	if err = checkMinLength("artist_name", r.ArtistName, 3); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_GetMusicians ...
 // May execute with listed access token types:
 //    [ user ]
@@ -1559,6 +2047,9 @@ func (r Ads_GetMusicians_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getMusicians
 func (vk *VK) Ads_GetMusicians(ctx context.Context, req Ads_GetMusicians_Request, options ...Option) (resp Ads_GetMusicians_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1581,6 +2072,21 @@ func (r Ads_GetMusiciansByIds_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetMusiciansByIds_Request) Validate() (err error) {
+	if r.Ids != nil {
+		if err = checkMaxItems("ids", len(*r.Ids), 200); err != nil {
+			return
+		}
+		for _, v := range *r.Ids {
+			if err = checkMinimum("ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Ads_GetMusiciansByIds ...
 // May execute with listed access token types:
 //    [ user ]
@@ -1589,6 +2095,9 @@ func (r Ads_GetMusiciansByIds_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getMusiciansByIds
 func (vk *VK) Ads_GetMusiciansByIds(ctx context.Context, req Ads_GetMusiciansByIds_Request, options ...Option) (resp Ads_GetMusicians_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1608,6 +2117,11 @@ func (r Ads_GetOfficeUsers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetOfficeUsers_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetOfficeUsers Returns a list of managers and supervisors of advertising account.
 // May execute with listed access token types:
 //    [ user ]
@@ -1616,6 +2130,9 @@ func (r Ads_GetOfficeUsers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getOfficeUsers
 func (vk *VK) Ads_GetOfficeUsers(ctx context.Context, req Ads_GetOfficeUsers_Request, options ...Option) (resp Ads_GetOfficeUsers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1648,6 +2165,20 @@ func (r Ads_GetPostsReach_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetPostsReach_Request) Validate() (err error) {
+	if err = checkRequired("ids_type", r.IdsType != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("ids_type", string(r.IdsType), "ad", "campaign"); err != nil {
+		return
+	}
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_GetPostsReach Returns detailed statistics of promoted posts reach from campaigns and ads.
 // May execute with listed access token types:
 //    [ user ]
@@ -1656,6 +2187,9 @@ func (r Ads_GetPostsReach_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getPostsReach
 func (vk *VK) Ads_GetPostsReach(ctx context.Context, req Ads_GetPostsReach_Request, options ...Option) (resp Ads_GetPostsReach_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1678,6 +2212,11 @@ func (r Ads_GetRejectionReason_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetRejectionReason_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetRejectionReason Returns a reason of ad rejection for pre-moderation.
 // May execute with listed access token types:
 //    [ user ]
@@ -1686,6 +2225,9 @@ func (r Ads_GetRejectionReason_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getRejectionReason
 func (vk *VK) Ads_GetRejectionReason(ctx context.Context, req Ads_GetRejectionReason_Request, options ...Option) (resp Ads_GetRejectionReason_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1751,6 +2293,39 @@ func (r Ads_GetStatistics_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetStatistics_Request) Validate() (err error) {
+	if err = checkRequired("ids_type", r.IdsType != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("ids_type", string(r.IdsType), "ad", "campaign", "client", "office"); err != nil {
+		return
+	}
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	if err = checkRequired("period", r.Period != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("period", string(r.Period), "day", "month", "overall"); err != nil {
+		return
+	}
+	if err = checkRequired("date_from", r.DateFrom != ""); err != nil {
+		return
+	}
+	if err = checkRequired("date_to", r.DateTo != ""); err != nil {
+		return
+	}
+	if r.StatsFields != nil {
+		for _, v := range *r.StatsFields {
+			if err = checkStringEnum("stats_fields", string(v), "views_times"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Ads_GetStatistics Returns statistics of performance indicators for ads, campaigns, clients or the whole account.
 // May execute with listed access token types:
 //    [ user ]
@@ -1759,6 +2334,9 @@ func (r Ads_GetStatistics_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getStatistics
 func (vk *VK) Ads_GetStatistics(ctx context.Context, req Ads_GetStatistics_Request, options ...Option) (resp Ads_GetStatistics_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1828,6 +2406,22 @@ func (r Ads_GetSuggestions_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetSuggestions_Request) Validate() (err error) {
+	if err = checkRequired("section", r.Section != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("section", string(r.Section), "countries", "regions", "cities", "districts", "stations", "streets", "schools", "interests", "positions", "group_types", "religions", "browsers"); err != nil {
+		return
+	}
+	if r.Lang != nil {
+		if err = checkStringEnum("lang", string(*r.Lang), "ru", "ua", "en"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Ads_GetSuggestions Returns a set of auto-suggestions for various targeting parameters.
 // May execute with listed access token types:
 //    [ user ]
@@ -1835,6 +2429,9 @@ func (r Ads_GetSuggestions_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getSuggestions
 func (vk *VK) Ads_GetSuggestions(ctx context.Context, req Ads_GetSuggestions_Request, options ...Option) (resp Ads_GetSuggestions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1864,6 +2461,11 @@ func (r Ads_GetTargetGroups_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetTargetGroups_Request) Validate() (err error) {
+	return
+}
+
 // Ads_GetTargetGroups Returns a list of target groups.
 // May execute with listed access token types:
 //    [ user ]
@@ -1872,6 +2474,9 @@ func (r Ads_GetTargetGroups_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getTargetGroups
 func (vk *VK) Ads_GetTargetGroups(ctx context.Context, req Ads_GetTargetGroups_Request, options ...Option) (resp Ads_GetTargetGroups_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1958,6 +2563,19 @@ func (r Ads_GetTargetingStats_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetTargetingStats_Request) Validate() (err error) {
+	if r.AdFormat != nil {
+		if err = checkIntEnum("ad_format", int(*r.AdFormat), 1, 2, 3, 4, 7, 8, 9, 10); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("link_url", r.LinkUrl != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_GetTargetingStats Returns the size of targeting audience, and also recommended values for CPC and CPM.
 // May execute with listed access token types:
 //    [ user ]
@@ -1966,6 +2584,9 @@ func (r Ads_GetTargetingStats_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getTargetingStats
 func (vk *VK) Ads_GetTargetingStats(ctx context.Context, req Ads_GetTargetingStats_Request, options ...Option) (resp Ads_GetTargetingStats_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 15+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -1999,6 +2620,14 @@ func (r Ads_GetUploadURL_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_GetUploadURL_Request) Validate() (err error) {
+	if err = checkIntEnum("ad_format", int(r.AdFormat), 1, 2, 3, 4, 7); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_GetUploadURL Returns URL to upload an ad photo to.
 // May execute with listed access token types:
 //    [ user ]
@@ -2006,6 +2635,9 @@ func (r Ads_GetUploadURL_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.getUploadURL
 func (vk *VK) Ads_GetUploadURL(ctx context.Context, req Ads_GetUploadURL_Request, options ...Option) (resp Ads_GetUploadURL_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2049,6 +2681,14 @@ func (r Ads_ImportTargetContacts_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_ImportTargetContacts_Request) Validate() (err error) {
+	if err = checkRequired("contacts", r.Contacts != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_ImportTargetContacts Imports a list of advertiser's contacts to count VK registered users against the target group.
 // May execute with listed access token types:
 //    [ user ]
@@ -2057,6 +2697,9 @@ func (r Ads_ImportTargetContacts_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/ads.importTargetContacts
 func (vk *VK) Ads_ImportTargetContacts(ctx context.Context, req Ads_ImportTargetContacts_Request, options ...Option) (resp Ads_ImportTargetContacts_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2079,6 +2722,14 @@ func (r Ads_RemoveOfficeUsers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_RemoveOfficeUsers_Request) Validate() (err error) {
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_RemoveOfficeUsers Removes managers and/or supervisors from advertising account.
 // May execute with listed access token types:
 //    [ user ]
@@ -2087,6 +2738,9 @@ func (r Ads_RemoveOfficeUsers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.removeOfficeUsers
 func (vk *VK) Ads_RemoveOfficeUsers(ctx context.Context, req Ads_RemoveOfficeUsers_Request, options ...Option) (resp Ads_RemoveOfficeUsers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2109,6 +2763,14 @@ func (r Ads_UpdateAds_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_UpdateAds_Request) Validate() (err error) {
+	if err = checkRequired("data", r.Data != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_UpdateAds Edits ads.
 // May execute with listed access token types:
 //    [ user ]
@@ -2117,6 +2779,9 @@ func (r Ads_UpdateAds_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.updateAds
 func (vk *VK) Ads_UpdateAds(ctx context.Context, req Ads_UpdateAds_Request, options ...Option) (resp Ads_UpdateAds_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2139,6 +2804,14 @@ func (r Ads_UpdateCampaigns_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_UpdateCampaigns_Request) Validate() (err error) {
+	if err = checkRequired("data", r.Data != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_UpdateCampaigns Edits advertising campaigns.
 // May execute with listed access token types:
 //    [ user ]
@@ -2147,6 +2820,9 @@ func (r Ads_UpdateCampaigns_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.updateCampaigns
 func (vk *VK) Ads_UpdateCampaigns(ctx context.Context, req Ads_UpdateCampaigns_Request, options ...Option) (resp Ads_UpdateCampaigns_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2169,6 +2845,14 @@ func (r Ads_UpdateClients_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_UpdateClients_Request) Validate() (err error) {
+	if err = checkRequired("data", r.Data != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_UpdateClients Edits clients of an advertising agency.
 // May execute with listed access token types:
 //    [ user ]
@@ -2177,6 +2861,9 @@ func (r Ads_UpdateClients_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.updateClients
 func (vk *VK) Ads_UpdateClients(ctx context.Context, req Ads_UpdateClients_Request, options ...Option) (resp Ads_UpdateClients_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2206,6 +2893,14 @@ func (r Ads_UpdateOfficeUsers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_UpdateOfficeUsers_Request) Validate() (err error) {
+	if err = checkMinimum("account_id", float64(r.AccountId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_UpdateOfficeUsers Adds managers and/or supervisors to advertising account.
 // May execute with listed access token types:
 //    [ user ]
@@ -2214,6 +2909,9 @@ func (r Ads_UpdateOfficeUsers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.updateOfficeUsers
 func (vk *VK) Ads_UpdateOfficeUsers(ctx context.Context, req Ads_UpdateOfficeUsers_Request, options ...Option) (resp Ads_UpdateOfficeUsers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2262,6 +2960,20 @@ func (r Ads_UpdateTargetGroup_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Ads_UpdateTargetGroup_Request) Validate() (err error) {
+	if err = checkRequired("name", r.Name != ""); err != nil {
+		return
+	}
+	if err = checkMinimum("lifetime", float64(r.Lifetime), 1); err != nil {
+		return
+	}
+	if err = checkMaximum("lifetime", float64(r.Lifetime), 720); err != nil {
+		return
+	}
+	return
+}
+
 // Ads_UpdateTargetGroup Edits a retarget group.
 // May execute with listed access token types:
 //    [ user ]
@@ -2270,6 +2982,9 @@ func (r Ads_UpdateTargetGroup_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/ads.updateTargetGroup
 func (vk *VK) Ads_UpdateTargetGroup(ctx context.Context, req Ads_UpdateTargetGroup_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2288,6 +3003,11 @@ func (r Adsweb_GetAdCategories_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Adsweb_GetAdCategories_Request) Validate() (err error) {
+	return
+}
+
 // Adsweb_GetAdCategories ...
 // May execute with listed access token types:
 //    [ user ]
@@ -2295,6 +3015,9 @@ func (r Adsweb_GetAdCategories_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/adsweb.getAdCategories
 func (vk *VK) Adsweb_GetAdCategories(ctx context.Context, req Adsweb_GetAdCategories_Request, options ...Option) (resp Adsweb_GetAdCategories_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2349,6 +3072,21 @@ func (r Adsweb_GetAdUnits_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Adsweb_GetAdUnits_Request) Validate() (err error) {
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Adsweb_GetAdUnits ...
 // May execute with listed access token types:
 //    [ user ]
@@ -2356,6 +3094,9 @@ func (r Adsweb_GetAdUnits_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/adsweb.getAdUnits
 func (vk *VK) Adsweb_GetAdUnits(ctx context.Context, req Adsweb_GetAdUnits_Request, options ...Option) (resp Adsweb_GetAdUnits_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2389,6 +3130,21 @@ func (r Adsweb_GetFraudHistory_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Adsweb_GetFraudHistory_Request) Validate() (err error) {
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Adsweb_GetFraudHistory ...
 // May execute with listed access token types:
 //    [ user ]
@@ -2396,6 +3152,9 @@ func (r Adsweb_GetFraudHistory_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/adsweb.getFraudHistory
 func (vk *VK) Adsweb_GetFraudHistory(ctx context.Context, req Adsweb_GetFraudHistory_Request, options ...Option) (resp Adsweb_GetFraudHistory_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2433,6 +3192,21 @@ func (r Adsweb_GetSites_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Adsweb_GetSites_Request) Validate() (err error) {
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Adsweb_GetSites ...
 // May execute with listed access token types:
 //    [ user ]
@@ -2440,6 +3214,9 @@ func (r Adsweb_GetSites_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/adsweb.getSites
 func (vk *VK) Adsweb_GetSites(ctx context.Context, req Adsweb_GetSites_Request, options ...Option) (resp Adsweb_GetSites_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2481,13 +3258,41 @@ func (r Adsweb_GetStatistics_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
-// Adsweb_GetStatistics ...
-// May execute with listed access token types:
+// Validate checks that request fields match limits of the method.
+func (r Adsweb_GetStatistics_Request) Validate() (err error) {
+	if err = checkRequired("ids_type", r.IdsType != ""); err != nil {
+		return
+	}
+	if err = checkRequired("ids", r.Ids != ""); err != nil {
+		return
+	}
+	if err = checkRequired("period", r.Period != ""); err != nil {
+		return
+	}
+	if err = checkRequired("date_from", r.DateFrom != ""); err != nil {
+		return
+	}
+	if err = checkRequired("date_to", r.DateTo != ""); err != nil {
+		return
+	}
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Adsweb_GetStatistics ...
+// May execute with listed access token types:
 //    [ user ]
 // When executing method, may return one of global API errors.
 //
 // https://dev.vk.com/method/adsweb.getStatistics
 func (vk *VK) Adsweb_GetStatistics(ctx context.Context, req Adsweb_GetStatistics_Request, options ...Option) (resp Adsweb_GetStatistics_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2516,6 +3321,17 @@ func (r AppWidgets_GetAppImageUploadServer_Request) fillIn(values url.Values) (e
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_GetAppImageUploadServer_Request) Validate() (err error) {
+	if err = checkRequired("image_type", r.ImageType != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("image_type", string(r.ImageType), "160x160", "160x240", "24x24", "50x50", "510x128"); err != nil {
+		return
+	}
+	return
+}
+
 // AppWidgets_GetAppImageUploadServer Returns a URL for uploading a photo to the community collection for community app widgets
 // May execute with listed access token types:
 //    [ service ]
@@ -2523,6 +3339,9 @@ func (r AppWidgets_GetAppImageUploadServer_Request) fillIn(values url.Values) (e
 //
 // https://dev.vk.com/method/appWidgets.getAppImageUploadServer
 func (vk *VK) AppWidgets_GetAppImageUploadServer(ctx context.Context, req AppWidgets_GetAppImageUploadServer_Request, options ...Option) (resp AppWidgets_GetAppImageUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2567,6 +3386,29 @@ func (r AppWidgets_GetAppImages_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_GetAppImages_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.ImageType != nil {
+		if err = checkStringEnum("image_type", string(*r.ImageType), "160x160", "160x240", "24x24", "50x50", "510x128"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // AppWidgets_GetAppImages Returns an app collection of images for community app widgets
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -2574,6 +3416,9 @@ func (r AppWidgets_GetAppImages_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/appWidgets.getAppImages
 func (vk *VK) AppWidgets_GetAppImages(ctx context.Context, req AppWidgets_GetAppImages_Request, options ...Option) (resp AppWidgets_GetAppImages_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2602,6 +3447,17 @@ func (r AppWidgets_GetGroupImageUploadServer_Request) fillIn(values url.Values)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_GetGroupImageUploadServer_Request) Validate() (err error) {
+	if err = checkRequired("image_type", r.ImageType != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("image_type", string(r.ImageType), "160x160", "160x240", "24x24", "50x50", "510x128"); err != nil {
+		return
+	}
+	return
+}
+
 // AppWidgets_GetGroupImageUploadServer Returns a URL for uploading a photo to the community collection for community app widgets
 // May execute with listed access token types:
 //    [ group ]
@@ -2609,6 +3465,9 @@ func (r AppWidgets_GetGroupImageUploadServer_Request) fillIn(values url.Values)
 //
 // https://dev.vk.com/method/appWidgets.getGroupImageUploadServer
 func (vk *VK) AppWidgets_GetGroupImageUploadServer(ctx context.Context, req AppWidgets_GetGroupImageUploadServer_Request, options ...Option) (resp AppWidgets_GetGroupImageUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2653,6 +3512,29 @@ func (r AppWidgets_GetGroupImages_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_GetGroupImages_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.ImageType != nil {
+		if err = checkStringEnum("image_type", string(*r.ImageType), "160x160", "160x240", "24x24", "50x50", "510x128"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // AppWidgets_GetGroupImages Returns a community collection of images for community app widgets
 // May execute with listed access token types:
 //    [ group ]
@@ -2660,6 +3542,9 @@ func (r AppWidgets_GetGroupImages_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/appWidgets.getGroupImages
 func (vk *VK) AppWidgets_GetGroupImages(ctx context.Context, req AppWidgets_GetGroupImages_Request, options ...Option) (resp AppWidgets_GetGroupImages_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2681,6 +3566,16 @@ func (r AppWidgets_GetImagesById_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_GetImagesById_Request) Validate() (err error) {
+	if r.Images != nil {
+		if err = checkMaxItems("images", len(*r.Images), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // AppWidgets_GetImagesById Returns an image for community app widgets by its ID
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -2688,6 +3583,9 @@ func (r AppWidgets_GetImagesById_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/appWidgets.getImagesById
 func (vk *VK) AppWidgets_GetImagesById(ctx context.Context, req AppWidgets_GetImagesById_Request, options ...Option) (resp AppWidgets_GetImagesById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2710,6 +3608,17 @@ func (r AppWidgets_SaveAppImage_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_SaveAppImage_Request) Validate() (err error) {
+	if err = checkRequired("hash", r.Hash != ""); err != nil {
+		return
+	}
+	if err = checkRequired("image", r.Image != ""); err != nil {
+		return
+	}
+	return
+}
+
 // AppWidgets_SaveAppImage Allows to save image into app collection for community app widgets
 // May execute with listed access token types:
 //    [ service ]
@@ -2718,6 +3627,9 @@ func (r AppWidgets_SaveAppImage_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/appWidgets.saveAppImage
 func (vk *VK) AppWidgets_SaveAppImage(ctx context.Context, req AppWidgets_SaveAppImage_Request, options ...Option) (resp AppWidgets_SaveAppImage_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2740,6 +3652,17 @@ func (r AppWidgets_SaveGroupImage_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_SaveGroupImage_Request) Validate() (err error) {
+	if err = checkRequired("hash", r.Hash != ""); err != nil {
+		return
+	}
+	if err = checkRequired("image", r.Image != ""); err != nil {
+		return
+	}
+	return
+}
+
 // AppWidgets_SaveGroupImage Allows to save image into community collection for community app widgets
 // May execute with listed access token types:
 //    [ group ]
@@ -2748,6 +3671,9 @@ func (r AppWidgets_SaveGroupImage_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/appWidgets.saveGroupImage
 func (vk *VK) AppWidgets_SaveGroupImage(ctx context.Context, req AppWidgets_SaveGroupImage_Request, options ...Option) (resp AppWidgets_SaveGroupImage_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2783,6 +3709,23 @@ func (r AppWidgets_Update_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r AppWidgets_Update_Request) Validate() (err error) {
+	if err = checkRequired("code", r.Code != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("code", r.Code, 100000); err != nil {
+		return
+	}
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "compact_list", "cover_list", "donation", "list", "match", "matches", "table", "text", "tiles"); err != nil {
+		return
+	}
+	return
+}
+
 // AppWidgets_Update Allows to update community app widget
 // May execute with listed access token types:
 //    [ group ]
@@ -2791,6 +3734,9 @@ func (r AppWidgets_Update_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/appWidgets.update
 func (vk *VK) AppWidgets_Update(ctx context.Context, req AppWidgets_Update_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2880,6 +3826,38 @@ func (r Apps_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_Get_Request) Validate() (err error) {
+	if r.AppId != nil {
+		if err = checkMinimum("app_id", float64(*r.AppId), 0); err != nil {
+			return
+		}
+	}
+	if r.AppIds != nil {
+		if err = checkMaxItems("app_ids", len(*r.AppIds), 100); err != nil {
+			return
+		}
+	}
+	if r.Platform != nil {
+		if err = checkStringEnum("platform", string(*r.Platform), "android", "ios", "web", "winphone"); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Apps_Get Returns applications data.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -2887,6 +3865,9 @@ func (r Apps_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.get
 func (vk *VK) Apps_Get(ctx context.Context, req Apps_Get_Request, options ...Option) (resp Apps_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -2978,6 +3959,41 @@ func (r Apps_GetCatalog_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_GetCatalog_Request) Validate() (err error) {
+	if r.Sort != nil {
+		if err = checkStringEnum("sort", string(*r.Sort), "popular_today", "visitors", "create_date", "growth_rate", "popular_week"); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if err = checkMinimum("count", float64(r.Count), 0); err != nil {
+		return
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GenreId != nil {
+		if err = checkMinimum("genre_id", float64(*r.GenreId), 0); err != nil {
+			return
+		}
+	}
+	if r.Filter != nil {
+		if err = checkStringEnum("filter", string(*r.Filter), "favorite", "featured", "installed", "new"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Apps_GetCatalog Returns a list of applications (apps) available to users in the App Catalog.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -2985,6 +4001,9 @@ func (r Apps_GetCatalog_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.getCatalog
 func (vk *VK) Apps_GetCatalog(ctx context.Context, req Apps_GetCatalog_Request, options ...Option) (resp Apps_GetCatalog_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 13+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3036,6 +4055,36 @@ func (r Apps_GetFriendsList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_GetFriendsList_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 5000); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "invite", "request"); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Apps_GetFriendsList Creates friends list for requests and invites in current app.
 // May execute with listed access token types:
 //    [ user ]
@@ -3043,6 +4092,9 @@ func (r Apps_GetFriendsList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.getFriendsList
 func (vk *VK) Apps_GetFriendsList(ctx context.Context, req Apps_GetFriendsList_Request, options ...Option) (resp Apps_GetFriendsList_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3060,6 +4112,9 @@ func (vk *VK) Apps_GetFriendsList(ctx context.Context, req Apps_GetFriendsList_R
 //
 // https://dev.vk.com/method/apps.getFriendsList
 func (vk *VK) Apps_GetFriendsListExtended(ctx context.Context, req Apps_GetFriendsList_Request, options ...Option) (resp Apps_GetFriendsListExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3094,6 +4149,17 @@ func (r Apps_GetLeaderboard_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_GetLeaderboard_Request) Validate() (err error) {
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "level", "points", "score"); err != nil {
+		return
+	}
+	return
+}
+
 // Apps_GetLeaderboard Returns players rating in the game.
 // May execute with listed access token types:
 //    [ user ]
@@ -3101,6 +4167,9 @@ func (r Apps_GetLeaderboard_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.getLeaderboard
 func (vk *VK) Apps_GetLeaderboard(ctx context.Context, req Apps_GetLeaderboard_Request, options ...Option) (resp Apps_GetLeaderboard_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3118,6 +4187,9 @@ func (vk *VK) Apps_GetLeaderboard(ctx context.Context, req Apps_GetLeaderboard_R
 //
 // https://dev.vk.com/method/apps.getLeaderboard
 func (vk *VK) Apps_GetLeaderboardExtended(ctx context.Context, req Apps_GetLeaderboard_Request, options ...Option) (resp Apps_GetLeaderboardExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3139,6 +4211,14 @@ func (r Apps_GetMiniAppPolicies_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_GetMiniAppPolicies_Request) Validate() (err error) {
+	if err = checkMinimum("app_id", float64(r.AppId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Apps_GetMiniAppPolicies Returns policies and terms given to a mini app.
 // May execute with listed access token types:
 //    [ user ]
@@ -3146,6 +4226,9 @@ func (r Apps_GetMiniAppPolicies_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.getMiniAppPolicies
 func (vk *VK) Apps_GetMiniAppPolicies(ctx context.Context, req Apps_GetMiniAppPolicies_Request, options ...Option) (resp Apps_GetMiniAppPolicies_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3174,6 +4257,16 @@ func (r Apps_GetScopes_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_GetScopes_Request) Validate() (err error) {
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "group", "user"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Apps_GetScopes Returns scopes for auth
 // May execute with listed access token types:
 //    [ user ]
@@ -3181,6 +4274,9 @@ func (r Apps_GetScopes_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.getScopes
 func (vk *VK) Apps_GetScopes(ctx context.Context, req Apps_GetScopes_Request, options ...Option) (resp Apps_GetScopes_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3201,6 +4297,14 @@ func (r Apps_GetScore_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_GetScore_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Apps_GetScore Returns user score in app
 // May execute with listed access token types:
 //    [ user ]
@@ -3208,6 +4312,9 @@ func (r Apps_GetScore_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.getScore
 func (vk *VK) Apps_GetScore(ctx context.Context, req Apps_GetScore_Request, options ...Option) (resp Apps_GetScore_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3234,6 +4341,19 @@ func (r Apps_PromoHasActiveGift_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_PromoHasActiveGift_Request) Validate() (err error) {
+	if err = checkMinimum("promo_id", float64(r.PromoId), 0); err != nil {
+		return
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Apps_PromoHasActiveGift ...
 // May execute with listed access token types:
 //    [ user, service ]
@@ -3242,6 +4362,9 @@ func (r Apps_PromoHasActiveGift_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.promoHasActiveGift
 func (vk *VK) Apps_PromoHasActiveGift(ctx context.Context, req Apps_PromoHasActiveGift_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3268,6 +4391,19 @@ func (r Apps_PromoUseGift_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Apps_PromoUseGift_Request) Validate() (err error) {
+	if err = checkMinimum("promo_id", float64(r.PromoId), 0); err != nil {
+		return
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Apps_PromoUseGift ...
 // May execute with listed access token types:
 //    [ user, service ]
@@ -3276,6 +4412,9 @@ func (r Apps_PromoUseGift_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/apps.promoUseGift
 func (vk *VK) Apps_PromoUseGift(ctx context.Context, req Apps_PromoUseGift_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3329,15 +4468,36 @@ func (r Apps_SendRequest_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
-// Apps_SendRequest Sends a request to another user in an app that uses VK authorization.
-// May execute with listed access token types:
-//    [ user ]
-// When executing method, may return one of global API errors.
-//
-// https://dev.vk.com/method/apps.sendRequest
-func (vk *VK) Apps_SendRequest(ctx context.Context, req Apps_SendRequest_Request, options ...Option) (resp Apps_SendRequest_Response, apiErr ApiError, err error) {
-	values := make(url.Values, 8+len(options))
-	if err = req.fillIn(values); err != nil {
+// Validate checks that request fields match limits of the method.
+func (r Apps_SendRequest_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "invite", "request"); err != nil {
+			return
+		}
+	}
+	if r.Name != nil {
+		if err = checkMaxLength("name", *r.Name, 128); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Apps_SendRequest Sends a request to another user in an app that uses VK authorization.
+// May execute with listed access token types:
+//    [ user ]
+// When executing method, may return one of global API errors.
+//
+// https://dev.vk.com/method/apps.sendRequest
+func (vk *VK) Apps_SendRequest(ctx context.Context, req Apps_SendRequest_Request, options ...Option) (resp Apps_SendRequest_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
+	values := make(url.Values, 8+len(options))
+	if err = req.fillIn(values); err != nil {
 		return
 	}
 	setOptions(values, options)
@@ -3358,6 +4518,17 @@ func (r Auth_Restore_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Auth_Restore_Request) Validate() (err error) {
+	if err = checkRequired("phone", r.Phone != ""); err != nil {
+		return
+	}
+	if err = checkRequired("last_name", r.LastName != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Auth_Restore Allows to restore account access using a code received via SMS. " This method is only available for apps with [vk.com/dev/auth_direct|Direct authorization] access. "
 // May execute with listed access token types:
 //    [ user, open, service ]
@@ -3366,6 +4537,9 @@ func (r Auth_Restore_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/auth.restore
 func (vk *VK) Auth_Restore(ctx context.Context, req Auth_Restore_Request, options ...Option) (resp Auth_Restore_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3404,6 +4578,17 @@ func (r Board_AddTopic_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_AddTopic_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Board_AddTopic Creates a new topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3411,6 +4596,9 @@ func (r Board_AddTopic_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.addTopic
 func (vk *VK) Board_AddTopic(ctx context.Context, req Board_AddTopic_Request, options ...Option) (resp Board_AddTopic_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3435,6 +4623,17 @@ func (r Board_CloseTopic_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_CloseTopic_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Board_CloseTopic Closes a topic on a community's discussion board so that comments cannot be posted.
 // May execute with listed access token types:
 //    [ user ]
@@ -3442,6 +4641,9 @@ func (r Board_CloseTopic_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.closeTopic
 func (vk *VK) Board_CloseTopic(ctx context.Context, req Board_CloseTopic_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3492,6 +4694,22 @@ func (r Board_CreateComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_CreateComment_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	if r.StickerId != nil {
+		if err = checkMinimum("sticker_id", float64(*r.StickerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Board_CreateComment Adds a comment on a topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3499,6 +4717,9 @@ func (r Board_CreateComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.createComment
 func (vk *VK) Board_CreateComment(ctx context.Context, req Board_CreateComment_Request, options ...Option) (resp Board_CreateComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3528,6 +4749,20 @@ func (r Board_DeleteComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_DeleteComment_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("comment_id", float64(r.CommentId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Board_DeleteComment Deletes a comment on a topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -3535,6 +4770,9 @@ func (r Board_DeleteComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.deleteComment
 func (vk *VK) Board_DeleteComment(ctx context.Context, req Board_DeleteComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3560,6 +4798,17 @@ func (r Board_DeleteTopic_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_DeleteTopic_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Board_DeleteTopic Deletes a topic from a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3567,6 +4816,9 @@ func (r Board_DeleteTopic_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.deleteTopic
 func (vk *VK) Board_DeleteTopic(ctx context.Context, req Board_DeleteTopic_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3605,6 +4857,20 @@ func (r Board_EditComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_EditComment_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Board_EditComment Edits a comment on a topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3612,6 +4878,9 @@ func (r Board_EditComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.editComment
 func (vk *VK) Board_EditComment(ctx context.Context, req Board_EditComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3640,6 +4909,20 @@ func (r Board_EditTopic_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_EditTopic_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Board_EditTopic Edits the title of a topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3647,6 +4930,9 @@ func (r Board_EditTopic_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.editTopic
 func (vk *VK) Board_EditTopic(ctx context.Context, req Board_EditTopic_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3671,6 +4957,17 @@ func (r Board_FixTopic_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_FixTopic_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Board_FixTopic Pins a topic (fixes its place) to the top of a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3678,6 +4975,9 @@ func (r Board_FixTopic_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.fixTopic
 func (vk *VK) Board_FixTopic(ctx context.Context, req Board_FixTopic_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3738,6 +5038,35 @@ func (r Board_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_GetComments_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	if r.StartCommentId != nil {
+		if err = checkMinimum("start_comment_id", float64(*r.StartCommentId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkStringEnum("sort", string(*r.Sort), "asc", "desc"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Board_GetComments Returns a list of comments on a topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -3745,6 +5074,9 @@ func (r Board_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.getComments
 func (vk *VK) Board_GetComments(ctx context.Context, req Board_GetComments_Request, options ...Option) (resp Board_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3762,6 +5094,9 @@ func (vk *VK) Board_GetComments(ctx context.Context, req Board_GetComments_Reque
 //
 // https://dev.vk.com/method/board.getComments
 func (vk *VK) Board_GetCommentsExtended(ctx context.Context, req Board_GetComments_Request, options ...Option) (resp Board_GetCommentsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3837,6 +5172,42 @@ func (r Board_GetTopics_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_GetTopics_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.Order != nil {
+		if err = checkIntEnum("order", int(*r.Order), 1, 2, -1, -2, 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Preview != nil {
+		if err = checkIntEnum("preview", int(*r.Preview), 1, 2, 0); err != nil {
+			return
+		}
+	}
+	if r.PreviewLength != nil {
+		if err = checkMinimum("preview_length", float64(*r.PreviewLength), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Board_GetTopics Returns a list of topics on a community's discussion board.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -3844,6 +5215,9 @@ func (r Board_GetTopics_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.getTopics
 func (vk *VK) Board_GetTopics(ctx context.Context, req Board_GetTopics_Request, options ...Option) (resp Board_GetTopics_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3861,6 +5235,9 @@ func (vk *VK) Board_GetTopics(ctx context.Context, req Board_GetTopics_Request,
 //
 // https://dev.vk.com/method/board.getTopics
 func (vk *VK) Board_GetTopicsExtended(ctx context.Context, req Board_GetTopics_Request, options ...Option) (resp Board_GetTopicsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3886,6 +5263,17 @@ func (r Board_OpenTopic_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_OpenTopic_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Board_OpenTopic Re-opens a previously closed topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3893,6 +5281,9 @@ func (r Board_OpenTopic_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.openTopic
 func (vk *VK) Board_OpenTopic(ctx context.Context, req Board_OpenTopic_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3922,6 +5313,20 @@ func (r Board_RestoreComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_RestoreComment_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Board_RestoreComment Restores a comment deleted from a topic on a community's discussion board.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -3929,6 +5334,9 @@ func (r Board_RestoreComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.restoreComment
 func (vk *VK) Board_RestoreComment(ctx context.Context, req Board_RestoreComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3953,6 +5361,17 @@ func (r Board_UnfixTopic_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Board_UnfixTopic_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("topic_id", float64(r.TopicId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Board_UnfixTopic Unpins a pinned topic from the top of a community's discussion board.
 // May execute with listed access token types:
 //    [ user ]
@@ -3960,6 +5379,9 @@ func (r Board_UnfixTopic_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/board.unfixTopic
 func (vk *VK) Board_UnfixTopic(ctx context.Context, req Board_UnfixTopic_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -3994,6 +5416,27 @@ func (r Database_GetChairs_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetChairs_Request) Validate() (err error) {
+	if err = checkMinimum("faculty_id", float64(r.FacultyId), 0); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 10000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetChairs Returns list of chairs on a specified faculty.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4001,6 +5444,9 @@ func (r Database_GetChairs_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/database.getChairs
 func (vk *VK) Database_GetChairs(ctx context.Context, req Database_GetChairs_Request, options ...Option) (resp Database_GetChairs_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4051,6 +5497,32 @@ func (r Database_GetCities_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetCities_Request) Validate() (err error) {
+	if err = checkMinimum("country_id", float64(r.CountryId), 0); err != nil {
+		return
+	}
+	if r.RegionId != nil {
+		if err = checkMinimum("region_id", float64(*r.RegionId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetCities Returns a list of cities.
 // May execute with listed access token types:
 //    [ user ]
@@ -4058,6 +5530,9 @@ func (r Database_GetCities_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/database.getCities
 func (vk *VK) Database_GetCities(ctx context.Context, req Database_GetCities_Request, options ...Option) (resp Database_GetCities_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4080,6 +5555,21 @@ func (r Database_GetCitiesById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetCitiesById_Request) Validate() (err error) {
+	if r.CityIds != nil {
+		if err = checkMaxItems("city_ids", len(*r.CityIds), 1000); err != nil {
+			return
+		}
+		for _, v := range *r.CityIds {
+			if err = checkMinimum("city_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Database_GetCitiesById Returns information about cities by their IDs.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4087,6 +5577,9 @@ func (r Database_GetCitiesById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/database.getCitiesById
 func (vk *VK) Database_GetCitiesById(ctx context.Context, req Database_GetCitiesById_Request, options ...Option) (resp Database_GetCitiesById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4127,14 +5620,35 @@ func (r Database_GetCountries_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
-// Database_GetCountries Returns a list of countries.
-// May execute with listed access token types:
-//    [ user ]
-// When executing method, may return one of global API errors.
-//
-// https://dev.vk.com/method/database.getCountries
-func (vk *VK) Database_GetCountries(ctx context.Context, req Database_GetCountries_Request, options ...Option) (resp Database_GetCountries_Response, apiErr ApiError, err error) {
-	values := make(url.Values, 6+len(options))
+// Validate checks that request fields match limits of the method.
+func (r Database_GetCountries_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Database_GetCountries Returns a list of countries.
+// May execute with listed access token types:
+//    [ user ]
+// When executing method, may return one of global API errors.
+//
+// https://dev.vk.com/method/database.getCountries
+func (vk *VK) Database_GetCountries(ctx context.Context, req Database_GetCountries_Request, options ...Option) (resp Database_GetCountries_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
+	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
 	}
@@ -4156,6 +5670,21 @@ func (r Database_GetCountriesById_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetCountriesById_Request) Validate() (err error) {
+	if r.CountryIds != nil {
+		if err = checkMaxItems("country_ids", len(*r.CountryIds), 1000); err != nil {
+			return
+		}
+		for _, v := range *r.CountryIds {
+			if err = checkMinimum("country_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Database_GetCountriesById Returns information about countries by their IDs.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4163,6 +5692,9 @@ func (r Database_GetCountriesById_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/database.getCountriesById
 func (vk *VK) Database_GetCountriesById(ctx context.Context, req Database_GetCountriesById_Request, options ...Option) (resp Database_GetCountriesById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4197,6 +5729,27 @@ func (r Database_GetFaculties_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetFaculties_Request) Validate() (err error) {
+	if err = checkMinimum("university_id", float64(r.UniversityId), 0); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 10000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetFaculties Returns a list of faculties (i.e., university departments).
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4204,6 +5757,9 @@ func (r Database_GetFaculties_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/database.getFaculties
 func (vk *VK) Database_GetFaculties(ctx context.Context, req Database_GetFaculties_Request, options ...Option) (resp Database_GetFaculties_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4240,6 +5796,27 @@ func (r Database_GetMetroStations_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetMetroStations_Request) Validate() (err error) {
+	if err = checkMinimum("city_id", float64(r.CityId), 0); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 500); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetMetroStations Get metro stations by city
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4247,6 +5824,9 @@ func (r Database_GetMetroStations_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/database.getMetroStations
 func (vk *VK) Database_GetMetroStations(ctx context.Context, req Database_GetMetroStations_Request, options ...Option) (resp Database_GetMetroStations_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4269,6 +5849,21 @@ func (r Database_GetMetroStationsById_Request) fillIn(values url.Values) (err er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetMetroStationsById_Request) Validate() (err error) {
+	if r.StationIds != nil {
+		if err = checkMaxItems("station_ids", len(*r.StationIds), 30); err != nil {
+			return
+		}
+		for _, v := range *r.StationIds {
+			if err = checkMinimum("station_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Database_GetMetroStationsById Get metro station by his id
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4276,6 +5871,9 @@ func (r Database_GetMetroStationsById_Request) fillIn(values url.Values) (err er
 //
 // https://dev.vk.com/method/database.getMetroStationsById
 func (vk *VK) Database_GetMetroStationsById(ctx context.Context, req Database_GetMetroStationsById_Request, options ...Option) (resp Database_GetMetroStationsById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4315,6 +5913,27 @@ func (r Database_GetRegions_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetRegions_Request) Validate() (err error) {
+	if err = checkMinimum("country_id", float64(r.CountryId), 0); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetRegions Returns a list of regions.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4322,6 +5941,9 @@ func (r Database_GetRegions_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/database.getRegions
 func (vk *VK) Database_GetRegions(ctx context.Context, req Database_GetRegions_Request, options ...Option) (resp Database_GetRegions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4344,6 +5966,16 @@ func (r Database_GetSchoolClasses_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetSchoolClasses_Request) Validate() (err error) {
+	if r.CountryId != nil {
+		if err = checkMinimum("country_id", float64(*r.CountryId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetSchoolClasses Returns a list of school classes specified for the country.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4351,6 +5983,9 @@ func (r Database_GetSchoolClasses_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/database.getSchoolClasses
 func (vk *VK) Database_GetSchoolClasses(ctx context.Context, req Database_GetSchoolClasses_Request, options ...Option) (resp Database_GetSchoolClasses_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4390,6 +6025,27 @@ func (r Database_GetSchools_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetSchools_Request) Validate() (err error) {
+	if err = checkMinimum("city_id", float64(r.CityId), 0); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 10000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetSchools Returns a list of schools.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4397,6 +6053,9 @@ func (r Database_GetSchools_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/database.getSchools
 func (vk *VK) Database_GetSchools(ctx context.Context, req Database_GetSchools_Request, options ...Option) (resp Database_GetSchools_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4444,6 +6103,34 @@ func (r Database_GetUniversities_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Database_GetUniversities_Request) Validate() (err error) {
+	if r.CountryId != nil {
+		if err = checkMinimum("country_id", float64(*r.CountryId), 0); err != nil {
+			return
+		}
+	}
+	if r.CityId != nil {
+		if err = checkMinimum("city_id", float64(*r.CityId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 10000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Database_GetUniversities Returns a list of higher education institutions.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -4451,6 +6138,9 @@ func (r Database_GetUniversities_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/database.getUniversities
 func (vk *VK) Database_GetUniversities(ctx context.Context, req Database_GetUniversities_Request, options ...Option) (resp Database_GetUniversities_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4480,6 +6170,14 @@ func (r Docs_Add_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_Add_Request) Validate() (err error) {
+	if err = checkMinimum("doc_id", float64(r.DocId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Docs_Add Copies a document to a user's or community's document list.
 // May execute with listed access token types:
 //    [ user ]
@@ -4487,6 +6185,9 @@ func (r Docs_Add_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.add
 func (vk *VK) Docs_Add(ctx context.Context, req Docs_Add_Request, options ...Option) (resp Docs_Add_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4511,6 +6212,14 @@ func (r Docs_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_Delete_Request) Validate() (err error) {
+	if err = checkMinimum("doc_id", float64(r.DocId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Docs_Delete Deletes a user or community document.
 // May execute with listed access token types:
 //    [ user ]
@@ -4519,6 +6228,9 @@ func (r Docs_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.delete
 func (vk *VK) Docs_Delete(ctx context.Context, req Docs_Delete_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4551,6 +6263,20 @@ func (r Docs_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("doc_id", float64(r.DocId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 128); err != nil {
+		return
+	}
+	return
+}
+
 // Docs_Edit Edits a document.
 // May execute with listed access token types:
 //    [ user ]
@@ -4559,6 +6285,9 @@ func (r Docs_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.edit
 func (vk *VK) Docs_Edit(ctx context.Context, req Docs_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4618,6 +6347,29 @@ func (r Docs_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_Get_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Type != nil {
+		if err = checkMinimum("type", float64(*r.Type), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("type", int(*r.Type), 0, 1, 2, 3, 4, 5, 6, 7, 8); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Docs_Get Returns detailed information about user or community documents.
 // May execute with listed access token types:
 //    [ user ]
@@ -4625,6 +6377,9 @@ func (r Docs_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.get
 func (vk *VK) Docs_Get(ctx context.Context, req Docs_Get_Request, options ...Option) (resp Docs_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4650,6 +6405,11 @@ func (r Docs_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_GetById_Request) Validate() (err error) {
+	return
+}
+
 // Docs_GetById Returns information about documents by their IDs.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -4657,6 +6417,9 @@ func (r Docs_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.getById
 func (vk *VK) Docs_GetById(ctx context.Context, req Docs_GetById_Request, options ...Option) (resp Docs_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4693,6 +6456,16 @@ func (r Docs_GetMessagesUploadServer_Request) fillIn(values url.Values) (err err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_GetMessagesUploadServer_Request) Validate() (err error) {
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "audio_message", "doc", "graffiti"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Docs_GetMessagesUploadServer Returns the server address for document upload.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -4701,6 +6474,9 @@ func (r Docs_GetMessagesUploadServer_Request) fillIn(values url.Values) (err err
 //
 // https://dev.vk.com/method/docs.getMessagesUploadServer
 func (vk *VK) Docs_GetMessagesUploadServer(ctx context.Context, req Docs_GetMessagesUploadServer_Request, options ...Option) (resp Docs_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4721,6 +6497,11 @@ func (r Docs_GetTypes_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_GetTypes_Request) Validate() (err error) {
+	return
+}
+
 // Docs_GetTypes Returns documents types available for current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -4728,6 +6509,9 @@ func (r Docs_GetTypes_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.getTypes
 func (vk *VK) Docs_GetTypes(ctx context.Context, req Docs_GetTypes_Request, options ...Option) (resp Docs_GetTypes_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4751,6 +6535,16 @@ func (r Docs_GetUploadServer_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_GetUploadServer_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Docs_GetUploadServer Returns the server address for document upload.
 // May execute with listed access token types:
 //    [ user ]
@@ -4758,6 +6552,9 @@ func (r Docs_GetUploadServer_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.getUploadServer
 func (vk *VK) Docs_GetUploadServer(ctx context.Context, req Docs_GetUploadServer_Request, options ...Option) (resp Docs_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4781,6 +6578,16 @@ func (r Docs_GetWallUploadServer_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_GetWallUploadServer_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Docs_GetWallUploadServer Returns the server address for document upload onto a user's or community's wall.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -4788,6 +6595,9 @@ func (r Docs_GetWallUploadServer_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/docs.getWallUploadServer
 func (vk *VK) Docs_GetWallUploadServer(ctx context.Context, req Docs_GetWallUploadServer_Request, options ...Option) (resp Base_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4822,6 +6632,14 @@ func (r Docs_Save_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_Save_Request) Validate() (err error) {
+	if err = checkRequired("file", r.File != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Docs_Save Saves a document after [vk.com/dev/upload_files_2|uploading it to a server].
 // May execute with listed access token types:
 //    [ user, group ]
@@ -4830,6 +6648,9 @@ func (r Docs_Save_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.save
 func (vk *VK) Docs_Save(ctx context.Context, req Docs_Save_Request, options ...Option) (resp Docs_Save_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4871,6 +6692,27 @@ func (r Docs_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Docs_Search_Request) Validate() (err error) {
+	if err = checkRequired("q", r.Q != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("q", r.Q, 512); err != nil {
+		return
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Docs_Search Returns a list of documents matching the search criteria.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -4878,6 +6720,9 @@ func (r Docs_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/docs.search
 func (vk *VK) Docs_Search(ctx context.Context, req Docs_Search_Request, options ...Option) (resp Docs_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4914,14 +6759,35 @@ func (r Donut_GetFriends_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
-// Donut_GetFriends ...
-// May execute with listed access token types:
-//    [ user ]
-// When executing method, may return one of global API errors.
-//
-// https://dev.vk.com/method/donut.getFriends
-func (vk *VK) Donut_GetFriends(ctx context.Context, req Donut_GetFriends_Request, options ...Option) (resp Groups_GetMembersFields_Response, apiErr ApiError, err error) {
-	values := make(url.Values, 6+len(options))
+// Validate checks that request fields match limits of the method.
+func (r Donut_GetFriends_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Donut_GetFriends ...
+// May execute with listed access token types:
+//    [ user ]
+// When executing method, may return one of global API errors.
+//
+// https://dev.vk.com/method/donut.getFriends
+func (vk *VK) Donut_GetFriends(ctx context.Context, req Donut_GetFriends_Request, options ...Option) (resp Groups_GetMembersFields_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
+	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
 	}
@@ -4940,6 +6806,11 @@ func (r Donut_GetSubscription_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Donut_GetSubscription_Request) Validate() (err error) {
+	return
+}
+
 // Donut_GetSubscription ...
 // May execute with listed access token types:
 //    [ user ]
@@ -4948,6 +6819,9 @@ func (r Donut_GetSubscription_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/donut.getSubscription
 func (vk *VK) Donut_GetSubscription(ctx context.Context, req Donut_GetSubscription_Request, options ...Option) (resp Donut_GetSubscription_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -4985,6 +6859,31 @@ func (r Donut_GetSubscriptions_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Donut_GetSubscriptions_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Donut_GetSubscriptions Returns a list of user's VK Donut subscriptions.
 // May execute with listed access token types:
 //    [ user ]
@@ -4992,6 +6891,9 @@ func (r Donut_GetSubscriptions_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/donut.getSubscriptions
 func (vk *VK) Donut_GetSubscriptions(ctx context.Context, req Donut_GetSubscriptions_Request, options ...Option) (resp Donut_GetSubscriptions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5011,6 +6913,11 @@ func (r Donut_IsDon_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Donut_IsDon_Request) Validate() (err error) {
+	return
+}
+
 // Donut_IsDon ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5018,6 +6925,9 @@ func (r Donut_IsDon_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/donut.isDon
 func (vk *VK) Donut_IsDon(ctx context.Context, req Donut_IsDon_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5040,6 +6950,16 @@ func (r DownloadedGames_GetPaidStatus_Request) fillIn(values url.Values) (err er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r DownloadedGames_GetPaidStatus_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // DownloadedGames_GetPaidStatus ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5048,6 +6968,9 @@ func (r DownloadedGames_GetPaidStatus_Request) fillIn(values url.Values) (err er
 //
 // https://dev.vk.com/method/downloadedGames.getPaidStatus
 func (vk *VK) DownloadedGames_GetPaidStatus(ctx context.Context, req DownloadedGames_GetPaidStatus_Request, options ...Option) (resp DownloadedGames_PaidStatus_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5066,6 +6989,14 @@ func (r Fave_AddArticle_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_AddArticle_Request) Validate() (err error) {
+	if err = checkRequired("url", r.Url != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Fave_AddArticle ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5074,6 +7005,9 @@ func (r Fave_AddArticle_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.addArticle
 func (vk *VK) Fave_AddArticle(ctx context.Context, req Fave_AddArticle_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5093,6 +7027,14 @@ func (r Fave_AddLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_AddLink_Request) Validate() (err error) {
+	if err = checkRequired("link", r.Link != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Fave_AddLink Adds a link to user faves.
 // May execute with listed access token types:
 //    [ user ]
@@ -5100,6 +7042,9 @@ func (r Fave_AddLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.addLink
 func (vk *VK) Fave_AddLink(ctx context.Context, req Fave_AddLink_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5128,6 +7073,21 @@ func (r Fave_AddPage_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_AddPage_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Fave_AddPage ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5135,6 +7095,9 @@ func (r Fave_AddPage_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.addPage
 func (vk *VK) Fave_AddPage(ctx context.Context, req Fave_AddPage_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5160,6 +7123,11 @@ func (r Fave_AddPost_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_AddPost_Request) Validate() (err error) {
+	return
+}
+
 // Fave_AddPost ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5167,6 +7135,9 @@ func (r Fave_AddPost_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.addPost
 func (vk *VK) Fave_AddPost(ctx context.Context, req Fave_AddPost_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5192,6 +7163,11 @@ func (r Fave_AddProduct_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_AddProduct_Request) Validate() (err error) {
+	return
+}
+
 // Fave_AddProduct ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5199,6 +7175,9 @@ func (r Fave_AddProduct_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.addProduct
 func (vk *VK) Fave_AddProduct(ctx context.Context, req Fave_AddProduct_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5232,6 +7211,21 @@ func (r Fave_AddTag_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_AddTag_Request) Validate() (err error) {
+	if r.Name != nil {
+		if err = checkMaxLength("name", *r.Name, 50); err != nil {
+			return
+		}
+	}
+	if r.Position != nil {
+		if err = checkStringEnum("position", string(*r.Position), "back", "front"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Fave_AddTag ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5240,6 +7234,9 @@ func (r Fave_AddTag_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.addTag
 func (vk *VK) Fave_AddTag(ctx context.Context, req Fave_AddTag_Request, options ...Option) (resp Fave_AddTag_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5265,6 +7262,11 @@ func (r Fave_AddVideo_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_AddVideo_Request) Validate() (err error) {
+	return
+}
+
 // Fave_AddVideo ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5272,6 +7274,9 @@ func (r Fave_AddVideo_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.addVideo
 func (vk *VK) Fave_AddVideo(ctx context.Context, req Fave_AddVideo_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5293,6 +7298,17 @@ func (r Fave_EditTag_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_EditTag_Request) Validate() (err error) {
+	if err = checkRequired("name", r.Name != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("name", r.Name, 50); err != nil {
+		return
+	}
+	return
+}
+
 // Fave_EditTag ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5300,6 +7316,9 @@ func (r Fave_EditTag_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.editTag
 func (vk *VK) Fave_EditTag(ctx context.Context, req Fave_EditTag_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5366,6 +7385,36 @@ func (r Fave_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_Get_Request) Validate() (err error) {
+	if r.ItemType != nil {
+		if err = checkStringEnum("item_type", string(*r.ItemType), "article", "clip", "link", "narrative", "page", "podcast", "post", "product", "video", "youla_product"); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Fave_Get ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5373,6 +7422,9 @@ func (r Fave_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.get
 func (vk *VK) Fave_Get(ctx context.Context, req Fave_Get_Request, options ...Option) (resp Fave_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5390,6 +7442,9 @@ func (vk *VK) Fave_Get(ctx context.Context, req Fave_Get_Request, options ...Opt
 //
 // https://dev.vk.com/method/fave.get
 func (vk *VK) Fave_GetExtended(ctx context.Context, req Fave_Get_Request, options ...Option) (resp Fave_GetExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5444,6 +7499,39 @@ func (r Fave_GetPages_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_GetPages_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("offset", float64(*r.Offset), 10000); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 500); err != nil {
+			return
+		}
+	}
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "groups", "hints", "users"); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Fave_GetPages ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5451,6 +7539,9 @@ func (r Fave_GetPages_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.getPages
 func (vk *VK) Fave_GetPages(ctx context.Context, req Fave_GetPages_Request, options ...Option) (resp Fave_GetPages_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5499,6 +7590,14 @@ func (r Fave_RemoveArticle_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_RemoveArticle_Request) Validate() (err error) {
+	if err = checkMinimum("article_id", float64(r.ArticleId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Fave_RemoveArticle ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5506,6 +7605,9 @@ func (r Fave_RemoveArticle_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.removeArticle
 func (vk *VK) Fave_RemoveArticle(ctx context.Context, req Fave_RemoveArticle_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5532,6 +7634,11 @@ func (r Fave_RemoveLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_RemoveLink_Request) Validate() (err error) {
+	return
+}
+
 // Fave_RemoveLink Removes link from the user's faves.
 // May execute with listed access token types:
 //    [ user ]
@@ -5539,6 +7646,9 @@ func (r Fave_RemoveLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.removeLink
 func (vk *VK) Fave_RemoveLink(ctx context.Context, req Fave_RemoveLink_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5565,6 +7675,11 @@ func (r Fave_RemovePage_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_RemovePage_Request) Validate() (err error) {
+	return
+}
+
 // Fave_RemovePage ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5572,6 +7687,9 @@ func (r Fave_RemovePage_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.removePage
 func (vk *VK) Fave_RemovePage(ctx context.Context, req Fave_RemovePage_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5593,6 +7711,11 @@ func (r Fave_RemovePost_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_RemovePost_Request) Validate() (err error) {
+	return
+}
+
 // Fave_RemovePost ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5600,6 +7723,9 @@ func (r Fave_RemovePost_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.removePost
 func (vk *VK) Fave_RemovePost(ctx context.Context, req Fave_RemovePost_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5621,6 +7747,11 @@ func (r Fave_RemoveProduct_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_RemoveProduct_Request) Validate() (err error) {
+	return
+}
+
 // Fave_RemoveProduct ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5628,6 +7759,9 @@ func (r Fave_RemoveProduct_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.removeProduct
 func (vk *VK) Fave_RemoveProduct(ctx context.Context, req Fave_RemoveProduct_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5646,6 +7780,11 @@ func (r Fave_RemoveTag_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_RemoveTag_Request) Validate() (err error) {
+	return
+}
+
 // Fave_RemoveTag ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5653,6 +7792,9 @@ func (r Fave_RemoveTag_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.removeTag
 func (vk *VK) Fave_RemoveTag(ctx context.Context, req Fave_RemoveTag_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5674,6 +7816,11 @@ func (r Fave_RemoveVideo_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_RemoveVideo_Request) Validate() (err error) {
+	return
+}
+
 // Fave_RemoveVideo ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5681,6 +7828,9 @@ func (r Fave_RemoveVideo_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.removeVideo
 func (vk *VK) Fave_RemoveVideo(ctx context.Context, req Fave_RemoveVideo_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5701,6 +7851,11 @@ func (r Fave_ReorderTags_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_ReorderTags_Request) Validate() (err error) {
+	return
+}
+
 // Fave_ReorderTags ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5708,6 +7863,9 @@ func (r Fave_ReorderTags_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.reorderTags
 func (vk *VK) Fave_ReorderTags(ctx context.Context, req Fave_ReorderTags_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5740,6 +7898,21 @@ func (r Fave_SetPageTags_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_SetPageTags_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Fave_SetPageTags ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5748,6 +7921,9 @@ func (r Fave_SetPageTags_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.setPageTags
 func (vk *VK) Fave_SetPageTags(ctx context.Context, req Fave_SetPageTags_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5804,6 +7980,16 @@ func (r Fave_SetTags_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_SetTags_Request) Validate() (err error) {
+	if r.ItemType != nil {
+		if err = checkStringEnum("item_type", string(*r.ItemType), "article", "clip", "link", "narrative", "page", "podcast", "post", "product", "video", "youla_product"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Fave_SetTags ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5812,6 +7998,9 @@ func (r Fave_SetTags_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/fave.setTags
 func (vk *VK) Fave_SetTags(ctx context.Context, req Fave_SetTags_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5840,6 +8029,21 @@ func (r Fave_TrackPageInteraction_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Fave_TrackPageInteraction_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Fave_TrackPageInteraction ...
 // May execute with listed access token types:
 //    [ user ]
@@ -5847,6 +8051,9 @@ func (r Fave_TrackPageInteraction_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/fave.trackPageInteraction
 func (vk *VK) Fave_TrackPageInteraction(ctx context.Context, req Fave_TrackPageInteraction_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5880,6 +8087,16 @@ func (r Friends_Add_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_Add_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_Add Approves or creates a friend request.
 // May execute with listed access token types:
 //    [ user ]
@@ -5888,6 +8105,9 @@ func (r Friends_Add_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.add
 func (vk *VK) Friends_Add(ctx context.Context, req Friends_Add_Request, options ...Option) (resp Friends_Add_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5913,6 +8133,21 @@ func (r Friends_AddList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_AddList_Request) Validate() (err error) {
+	if err = checkRequired("name", r.Name != ""); err != nil {
+		return
+	}
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Friends_AddList Creates a new friend list for the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -5921,6 +8156,9 @@ func (r Friends_AddList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.addList
 func (vk *VK) Friends_AddList(ctx context.Context, req Friends_AddList_Request, options ...Option) (resp Friends_AddList_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5948,6 +8186,16 @@ func (r Friends_AreFriends_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_AreFriends_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		if err = checkMaxItems("user_ids", len(*r.UserIds), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_AreFriends Checks the current user's friendship status with other specified users.
 // May execute with listed access token types:
 //    [ user ]
@@ -5955,6 +8203,9 @@ func (r Friends_AreFriends_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.areFriends
 func (vk *VK) Friends_AreFriends(ctx context.Context, req Friends_AreFriends_Request, options ...Option) (resp Friends_AreFriends_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5972,6 +8223,9 @@ func (vk *VK) Friends_AreFriends(ctx context.Context, req Friends_AreFriends_Req
 //
 // https://dev.vk.com/method/friends.areFriends
 func (vk *VK) Friends_AreFriendsExtended(ctx context.Context, req Friends_AreFriends_Request, options ...Option) (resp Friends_AreFriendsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -5996,6 +8250,16 @@ func (r Friends_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_Delete_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_Delete Declines a friend request or deletes a user from the current user's friend list.
 // May execute with listed access token types:
 //    [ user ]
@@ -6003,6 +8267,9 @@ func (r Friends_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.delete
 func (vk *VK) Friends_Delete(ctx context.Context, req Friends_Delete_Request, options ...Option) (resp Friends_Delete_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6037,6 +8304,17 @@ func (r Friends_DeleteList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_DeleteList_Request) Validate() (err error) {
+	if err = checkMinimum("list_id", float64(r.ListId), 0); err != nil {
+		return
+	}
+	if err = checkMaximum("list_id", float64(r.ListId), 24); err != nil {
+		return
+	}
+	return
+}
+
 // Friends_DeleteList Deletes a friend list of the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -6045,6 +8323,9 @@ func (r Friends_DeleteList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.deleteList
 func (vk *VK) Friends_DeleteList(ctx context.Context, req Friends_DeleteList_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6071,6 +8352,21 @@ func (r Friends_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if r.ListIds != nil {
+		for _, v := range *r.ListIds {
+			if err = checkMinimum("list_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Friends_Edit Edits the friend lists of the selected user.
 // May execute with listed access token types:
 //    [ user ]
@@ -6078,6 +8374,9 @@ func (r Friends_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.edit
 func (vk *VK) Friends_Edit(ctx context.Context, req Friends_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6121,6 +8420,35 @@ func (r Friends_EditList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_EditList_Request) Validate() (err error) {
+	if err = checkMinimum("list_id", float64(r.ListId), 0); err != nil {
+		return
+	}
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.AddUserIds != nil {
+		for _, v := range *r.AddUserIds {
+			if err = checkMinimum("add_user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.DeleteUserIds != nil {
+		for _, v := range *r.DeleteUserIds {
+			if err = checkMinimum("delete_user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Friends_EditList Edits a friend list of the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -6129,6 +8457,9 @@ func (r Friends_EditList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.editList
 func (vk *VK) Friends_EditList(ctx context.Context, req Friends_EditList_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6214,6 +8545,48 @@ func (r Friends_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_Get_Request) Validate() (err error) {
+	if r.Order != nil {
+		if err = checkStringEnum("order", string(*r.Order), "hints", "random", "mobile", "name", "smart"); err != nil {
+			return
+		}
+	}
+	if r.ListId != nil {
+		if err = checkMinimum("list_id", float64(*r.ListId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	if r.Ref != nil {
+		if err = checkMaxLength("ref", *r.Ref, 255); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_Get Returns a list of user IDs or detailed information about a user's friends.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -6221,6 +8594,9 @@ func (r Friends_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.get
 func (vk *VK) Friends_Get(ctx context.Context, req Friends_Get_Request, options ...Option) (resp Friends_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6262,6 +8638,18 @@ func (r Friends_GetByPhones_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetByPhones_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Friends_GetByPhones Returns a list of the current user's friends whose phone numbers, validated or specified in a profile, are in a given list.
 // May execute with listed access token types:
 //    [ user ]
@@ -6269,6 +8657,9 @@ func (r Friends_GetByPhones_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.getByPhones
 func (vk *VK) Friends_GetByPhones(ctx context.Context, req Friends_GetByPhones_Request, options ...Option) (resp Friends_GetByPhones_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6297,6 +8688,16 @@ func (r Friends_GetLists_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetLists_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_GetLists Returns a list of the user's friend lists.
 // May execute with listed access token types:
 //    [ user ]
@@ -6304,6 +8705,9 @@ func (r Friends_GetLists_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.getLists
 func (vk *VK) Friends_GetLists(ctx context.Context, req Friends_GetLists_Request, options ...Option) (resp Friends_GetLists_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6351,6 +8755,31 @@ func (r Friends_GetMutual_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetMutual_Request) Validate() (err error) {
+	if r.SourceUid != nil {
+		if err = checkMinimum("source_uid", float64(*r.SourceUid), 0); err != nil {
+			return
+		}
+	}
+	if r.TargetUid != nil {
+		if err = checkMinimum("target_uid", float64(*r.TargetUid), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_GetMutual Returns a list of user IDs of the mutual friends of two users.
 // May execute with listed access token types:
 //    [ user ]
@@ -6358,6 +8787,9 @@ func (r Friends_GetMutual_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.getMutual
 func (vk *VK) Friends_GetMutual(ctx context.Context, req Friends_GetMutual_Request, options ...Option) (resp Friends_GetMutual_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6405,6 +8837,36 @@ func (r Friends_GetMutualTargetUIDs_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetMutualTargetUIDs_Request) Validate() (err error) {
+	if r.SourceUid != nil {
+		if err = checkMinimum("source_uid", float64(*r.SourceUid), 0); err != nil {
+			return
+		}
+	}
+	if r.TargetUids != nil {
+		if err = checkMaxItems("target_uids", len(*r.TargetUids), 100); err != nil {
+			return
+		}
+		for _, v := range *r.TargetUids {
+			if err = checkMinimum("target_uids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_GetMutualTargetUIDs Returns a list of user IDs of the mutual friends of two users.
 // May execute with listed access token types:
 //    [ user ]
@@ -6412,6 +8874,9 @@ func (r Friends_GetMutualTargetUIDs_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/friends.getMutual
 func (vk *VK) Friends_GetMutualTargetUIDs(ctx context.Context, req Friends_GetMutualTargetUIDs_Request, options ...Option) (resp Friends_GetMutualTargetUids_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6458,6 +8923,31 @@ func (r Friends_GetOnline_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetOnline_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.ListId != nil {
+		if err = checkMinimum("list_id", float64(*r.ListId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_GetOnline Returns a list of user IDs of a user's friends who are online.
 // May execute with listed access token types:
 //    [ user ]
@@ -6465,6 +8955,9 @@ func (r Friends_GetOnline_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.getOnline
 func (vk *VK) Friends_GetOnline(ctx context.Context, req Friends_GetOnline_Request, options ...Option) (resp Friends_GetOnline_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6482,6 +8975,9 @@ func (vk *VK) Friends_GetOnline(ctx context.Context, req Friends_GetOnline_Reque
 //
 // https://dev.vk.com/method/friends.getOnline
 func (vk *VK) Friends_GetOnlineOnlineMobile(ctx context.Context, req Friends_GetOnline_Request, options ...Option) (resp Friends_GetOnlineOnlineMobile_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6507,6 +9003,19 @@ func (r Friends_GetRecent_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetRecent_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_GetRecent Returns a list of user IDs of the current user's recently added friends.
 // May execute with listed access token types:
 //    [ user ]
@@ -6514,6 +9023,9 @@ func (r Friends_GetRecent_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.getRecent
 func (vk *VK) Friends_GetRecent(ctx context.Context, req Friends_GetRecent_Request, options ...Option) (resp Friends_GetRecent_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6591,6 +9103,44 @@ func (r Friends_GetRequests_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetRequests_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkMinimum("sort", float64(*r.Sort), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("sort", int(*r.Sort), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Ref != nil {
+		if err = checkMaxLength("ref", *r.Ref, 255); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Friends_GetRequests Returns information about the current user's incoming and outgoing friend requests.
 // May execute with listed access token types:
 //    [ user ]
@@ -6598,6 +9148,9 @@ func (r Friends_GetRequests_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.getRequests
 func (vk *VK) Friends_GetRequests(ctx context.Context, req Friends_GetRequests_Request, options ...Option) (resp Friends_GetRequests_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6615,6 +9168,9 @@ func (vk *VK) Friends_GetRequests(ctx context.Context, req Friends_GetRequests_R
 //
 // https://dev.vk.com/method/friends.getRequests
 func (vk *VK) Friends_GetRequestsExtended(ctx context.Context, req Friends_GetRequests_Request, options ...Option) (resp Friends_GetRequestsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6686,6 +9242,43 @@ func (r Friends_GetSuggestions_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Friends_GetSuggestions_Request) Validate() (err error) {
+	if r.Filter != nil {
+		for _, v := range *r.Filter {
+			if err = checkStringEnum("filter", string(v), "mutual", "contacts", "mutual_contacts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 500); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Friends_GetSuggestions Returns a list of profiles of users whom the current user may know.
 // May execute with listed access token types:
 //    [ user ]
@@ -6693,6 +9286,9 @@ func (r Friends_GetSuggestions_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/friends.getSuggestions
 func (vk *VK) Friends_GetSuggestions(ctx context.Context, req Friends_GetSuggestions_Request, options ...Option) (resp Friends_GetSuggestions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6760,14 +9356,52 @@ func (r Friends_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
-// Friends_Search Returns a list of friends matching the search criteria.
-// May execute with listed access token types:
-//    [ user ]
-// When executing method, may return one of global API errors.
-//
-// https://dev.vk.com/method/friends.search
-func (vk *VK) Friends_Search(ctx context.Context, req Friends_Search_Request, options ...Option) (resp Friends_Search_Response, apiErr ApiError, err error) {
-	values := make(url.Values, 8+len(options))
+// Validate checks that request fields match limits of the method.
+func (r Friends_Search_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 1); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "Nom", "Gen", "Dat", "Acc", "Ins", "Abl"); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Friends_Search Returns a list of friends matching the search criteria.
+// May execute with listed access token types:
+//    [ user ]
+// When executing method, may return one of global API errors.
+//
+// https://dev.vk.com/method/friends.search
+func (vk *VK) Friends_Search(ctx context.Context, req Friends_Search_Request, options ...Option) (resp Friends_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
+	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
 	}
@@ -6802,6 +9436,26 @@ func (r Gifts_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Gifts_Get_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Gifts_Get Returns a list of user gifts.
 // May execute with listed access token types:
 //    [ user ]
@@ -6809,6 +9463,9 @@ func (r Gifts_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/gifts.get
 func (vk *VK) Gifts_Get(ctx context.Context, req Gifts_Get_Request, options ...Option) (resp Gifts_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6876,6 +9533,59 @@ func (r Groups_AddAddress_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_AddAddress_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 255); err != nil {
+		return
+	}
+	if err = checkRequired("address", r.Address != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("address", r.Address, 255); err != nil {
+		return
+	}
+	if r.AdditionalAddress != nil {
+		if err = checkMaxLength("additional_address", *r.AdditionalAddress, 400); err != nil {
+			return
+		}
+	}
+	if err = checkMinimum("country_id", float64(r.CountryId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("city_id", float64(r.CityId), 1); err != nil {
+		return
+	}
+	if r.MetroId != nil {
+		if err = checkMinimum("metro_id", float64(*r.MetroId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkMinimum("latitude", r.Latitude, -90); err != nil {
+		return
+	}
+	if err = checkMaximum("latitude", r.Latitude, 90); err != nil {
+		return
+	}
+	if err = checkMinimum("longitude", r.Longitude, -180); err != nil {
+		return
+	}
+	if err = checkMaximum("longitude", r.Longitude, 180); err != nil {
+		return
+	}
+	if r.WorkInfoStatus != nil {
+		if err = checkStringEnum("work_info_status", string(*r.WorkInfoStatus), "no_information", "temporarily_closed", "always_opened", "timetable", "forever_closed"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_AddAddress ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -6884,6 +9594,9 @@ func (r Groups_AddAddress_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.addAddress
 func (vk *VK) Groups_AddAddress(ctx context.Context, req Groups_AddAddress_Request, options ...Option) (resp Groups_AddAddress_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 15+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6914,6 +9627,28 @@ func (r Groups_AddCallbackServer_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_AddCallbackServer_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("url", r.Url != ""); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 14); err != nil {
+		return
+	}
+	if r.SecretKey != nil {
+		if err = checkMaxLength("secret_key", *r.SecretKey, 50); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_AddCallbackServer ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -6922,6 +9657,9 @@ func (r Groups_AddCallbackServer_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/groups.addCallbackServer
 func (vk *VK) Groups_AddCallbackServer(ctx context.Context, req Groups_AddCallbackServer_Request, options ...Option) (resp Groups_AddCallbackServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6951,6 +9689,17 @@ func (r Groups_AddLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_AddLink_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("link", r.Link != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_AddLink Allows to add a link to the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -6958,6 +9707,9 @@ func (r Groups_AddLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.addLink
 func (vk *VK) Groups_AddLink(ctx context.Context, req Groups_AddLink_Request, options ...Option) (resp Groups_AddLink_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -6984,6 +9736,17 @@ func (r Groups_ApproveRequest_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_ApproveRequest_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_ApproveRequest Allows to approve join request to the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -6992,6 +9755,9 @@ func (r Groups_ApproveRequest_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.approveRequest
 func (vk *VK) Groups_ApproveRequest(ctx context.Context, req Groups_ApproveRequest_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7035,6 +9801,24 @@ func (r Groups_Ban_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Ban_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.EndDate != nil {
+		if err = checkMinimum("end_date", float64(*r.EndDate), 0); err != nil {
+			return
+		}
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_Ban ...
 // May execute with listed access token types:
 //    [ user ]
@@ -7042,6 +9826,9 @@ func (r Groups_Ban_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.ban
 func (vk *VK) Groups_Ban(ctx context.Context, req Groups_Ban_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7107,6 +9894,37 @@ func (r Groups_Create_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Create_Request) Validate() (err error) {
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "event", "group", "public"); err != nil {
+			return
+		}
+	}
+	if r.PublicCategory != nil {
+		if err = checkMinimum("public_category", float64(*r.PublicCategory), 0); err != nil {
+			return
+		}
+	}
+	if r.PublicSubcategory != nil {
+		if err = checkMinimum("public_subcategory", float64(*r.PublicSubcategory), 0); err != nil {
+			return
+		}
+	}
+	if r.Subtype != nil {
+		if err = checkMinimum("subtype", float64(*r.Subtype), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("subtype", int(*r.Subtype), 1, 2, 3, 4); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_Create Creates a new community.
 // May execute with listed access token types:
 //    [ user ]
@@ -7115,6 +9933,9 @@ func (r Groups_Create_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.create
 func (vk *VK) Groups_Create(ctx context.Context, req Groups_Create_Request, options ...Option) (resp Groups_Create_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7138,6 +9959,17 @@ func (r Groups_DeleteAddress_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_DeleteAddress_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("address_id", float64(r.AddressId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_DeleteAddress ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -7146,6 +9978,9 @@ func (r Groups_DeleteAddress_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.deleteAddress
 func (vk *VK) Groups_DeleteAddress(ctx context.Context, req Groups_DeleteAddress_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7169,6 +10004,17 @@ func (r Groups_DeleteCallbackServer_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_DeleteCallbackServer_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("server_id", float64(r.ServerId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_DeleteCallbackServer ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -7177,6 +10023,9 @@ func (r Groups_DeleteCallbackServer_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/groups.deleteCallbackServer
 func (vk *VK) Groups_DeleteCallbackServer(ctx context.Context, req Groups_DeleteCallbackServer_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7202,6 +10051,17 @@ func (r Groups_DeleteLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_DeleteLink_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("link_id", float64(r.LinkId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_DeleteLink Allows to delete a link from the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -7209,6 +10069,9 @@ func (r Groups_DeleteLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.deleteLink
 func (vk *VK) Groups_DeleteLink(ctx context.Context, req Groups_DeleteLink_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7229,6 +10092,14 @@ func (r Groups_DisableOnline_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_DisableOnline_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_DisableOnline ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -7236,6 +10107,9 @@ func (r Groups_DisableOnline_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.disableOnline
 func (vk *VK) Groups_DisableOnline(ctx context.Context, req Groups_DisableOnline_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7271,10 +10145,10 @@ type Groups_Edit_Request struct {
 	Rss *string
 	// Event start date in Unixtime format.
 	//  Minimum: 0
-	EventStartDate *int
+	EventStartDate *UnixTime
 	// Event finish date in Unixtime format.
 	//  Minimum: 0
-	EventFinishDate *int
+	EventFinishDate *UnixTime
 	// Organizer community ID (for events only).
 	//  Format: int64
 	//  Minimum: 0
@@ -7389,10 +10263,10 @@ func (r Groups_Edit_Request) fillIn(values url.Values) (err error) {
 		setString(values, "rss", *r.Rss)
 	}
 	if r.EventStartDate != nil {
-		setInt(values, "event_start_date", *r.EventStartDate)
+		setInt(values, "event_start_date", int(*r.EventStartDate))
 	}
 	if r.EventFinishDate != nil {
-		setInt(values, "event_finish_date", *r.EventFinishDate)
+		setInt(values, "event_finish_date", int(*r.EventFinishDate))
 	}
 	if r.EventGroupId != nil {
 		setInt(values, "event_group_id", *r.EventGroupId)
@@ -7496,61 +10370,227 @@ func (r Groups_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
-// Groups_Edit Edits a community.
-// May execute with listed access token types:
-//    [ user, group ]
-// When executing method, may return one of global or with listed codes API errors:
-//    [ Error_InvalidAddress ]
-//
-// https://dev.vk.com/method/groups.edit
-func (vk *VK) Groups_Edit(ctx context.Context, req Groups_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
-	values := make(url.Values, 47+len(options))
-	if err = req.fillIn(values); err != nil {
+// Validate checks that request fields match limits of the method.
+func (r Groups_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
 		return
 	}
-	setOptions(values, options)
-	apiErr, err = vk.doReq("groups.edit", ctx, values, &resp)
-	return
-}
-
-type Groups_EditAddress_Request struct {
-	//  Format: int64
-	//  Minimum: 1
-	GroupId int
-	//  Minimum: 0
-	AddressId int
-	//  MaxLength: 255
-	Title *string
-	//  MaxLength: 255
-	Address *string
-	//  MaxLength: 400
-	AdditionalAddress *string
-	//  Minimum: 0
-	CountryId *int
-	//  Minimum: 0
-	CityId *int
-	//  Minimum: 0
-	MetroId *int
-	//  Minimum: -90
-	//  Maximum: 90
-	Latitude *float64
-	//  Minimum: -180
-	//  Maximum: 180
-	Longitude      *float64
-	Phone          *string
-	WorkInfoStatus *Groups_AddressWorkInfoStatus
-	Timetable      *string
-	IsMainAddress  *bool
-}
-
-func (r Groups_EditAddress_Request) fillIn(values url.Values) (err error) {
-	setInt(values, "group_id", r.GroupId)
-	setInt(values, "address_id", r.AddressId)
-	if r.Title != nil {
-		setString(values, "title", *r.Title)
+	if r.Access != nil {
+		if err = checkMinimum("access", float64(*r.Access), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("access", int(*r.Access), 0, 1, 2); err != nil {
+			return
+		}
 	}
-	if r.Address != nil {
-		setString(values, "address", *r.Address)
+	if r.Subject != nil {
+		if err = checkIntEnum("subject", int(*r.Subject), 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42); err != nil {
+			return
+		}
+	}
+	if r.EventStartDate != nil {
+		if err = checkMinimum("event_start_date", float64(*r.EventStartDate), 0); err != nil {
+			return
+		}
+	}
+	if r.EventFinishDate != nil {
+		if err = checkMinimum("event_finish_date", float64(*r.EventFinishDate), 0); err != nil {
+			return
+		}
+	}
+	if r.EventGroupId != nil {
+		if err = checkMinimum("event_group_id", float64(*r.EventGroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.PublicCategory != nil {
+		if err = checkMinimum("public_category", float64(*r.PublicCategory), 0); err != nil {
+			return
+		}
+	}
+	if r.PublicSubcategory != nil {
+		if err = checkMinimum("public_subcategory", float64(*r.PublicSubcategory), 0); err != nil {
+			return
+		}
+	}
+	if r.Wall != nil {
+		if err = checkMinimum("wall", float64(*r.Wall), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("wall", int(*r.Wall), 0, 1, 2, 3); err != nil {
+			return
+		}
+	}
+	if r.Topics != nil {
+		if err = checkMinimum("topics", float64(*r.Topics), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("topics", int(*r.Topics), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Photos != nil {
+		if err = checkMinimum("photos", float64(*r.Photos), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("photos", int(*r.Photos), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Video != nil {
+		if err = checkMinimum("video", float64(*r.Video), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("video", int(*r.Video), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Audio != nil {
+		if err = checkMinimum("audio", float64(*r.Audio), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("audio", int(*r.Audio), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Docs != nil {
+		if err = checkMinimum("docs", float64(*r.Docs), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("docs", int(*r.Docs), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Wiki != nil {
+		if err = checkMinimum("wiki", float64(*r.Wiki), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("wiki", int(*r.Wiki), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.AgeLimits != nil {
+		if err = checkMinimum("age_limits", float64(*r.AgeLimits), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("age_limits", int(*r.AgeLimits), 1, 2, 3); err != nil {
+			return
+		}
+	}
+	if r.MarketCountry != nil {
+		if err = checkMaxItems("market_country", len(*r.MarketCountry), 10); err != nil {
+			return
+		}
+	}
+	if r.MarketCity != nil {
+		if err = checkMaxItems("market_city", len(*r.MarketCity), 10); err != nil {
+			return
+		}
+		for _, v := range *r.MarketCity {
+			if err = checkMinimum("market_city", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.MarketCurrency != nil {
+		if err = checkMinimum("market_currency", float64(*r.MarketCurrency), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("market_currency", int(*r.MarketCurrency), 643, 980, 398, 978, 840); err != nil {
+			return
+		}
+	}
+	if r.MarketContact != nil {
+		if err = checkMinimum("market_contact", float64(*r.MarketContact), 0); err != nil {
+			return
+		}
+	}
+	if r.MarketWiki != nil {
+		if err = checkMinimum("market_wiki", float64(*r.MarketWiki), 0); err != nil {
+			return
+		}
+	}
+	if r.MainSection != nil {
+		if err = checkMinimum("main_section", float64(*r.MainSection), 0); err != nil {
+			return
+		}
+	}
+	if r.SecondarySection != nil {
+		if err = checkMinimum("secondary_section", float64(*r.SecondarySection), 0); err != nil {
+			return
+		}
+	}
+	if r.Country != nil {
+		if err = checkMinimum("country", float64(*r.Country), 0); err != nil {
+			return
+		}
+	}
+	if r.City != nil {
+		if err = checkMinimum("city", float64(*r.City), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Groups_Edit Edits a community.
+// May execute with listed access token types:
+//    [ user, group ]
+// When executing method, may return one of global or with listed codes API errors:
+//    [ Error_InvalidAddress ]
+//
+// https://dev.vk.com/method/groups.edit
+func (vk *VK) Groups_Edit(ctx context.Context, req Groups_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
+	values := make(url.Values, 47+len(options))
+	if err = req.fillIn(values); err != nil {
+		return
+	}
+	setOptions(values, options)
+	apiErr, err = vk.doReq("groups.edit", ctx, values, &resp)
+	return
+}
+
+type Groups_EditAddress_Request struct {
+	//  Format: int64
+	//  Minimum: 1
+	GroupId int
+	//  Minimum: 0
+	AddressId int
+	//  MaxLength: 255
+	Title *string
+	//  MaxLength: 255
+	Address *string
+	//  MaxLength: 400
+	AdditionalAddress *string
+	//  Minimum: 0
+	CountryId *int
+	//  Minimum: 0
+	CityId *int
+	//  Minimum: 0
+	MetroId *int
+	//  Minimum: -90
+	//  Maximum: 90
+	Latitude *float64
+	//  Minimum: -180
+	//  Maximum: 180
+	Longitude      *float64
+	Phone          *string
+	WorkInfoStatus *Groups_AddressWorkInfoStatus
+	Timetable      *string
+	IsMainAddress  *bool
+}
+
+func (r Groups_EditAddress_Request) fillIn(values url.Values) (err error) {
+	setInt(values, "group_id", r.GroupId)
+	setInt(values, "address_id", r.AddressId)
+	if r.Title != nil {
+		setString(values, "title", *r.Title)
+	}
+	if r.Address != nil {
+		setString(values, "address", *r.Address)
 	}
 	if r.AdditionalAddress != nil {
 		setString(values, "additional_address", *r.AdditionalAddress)
@@ -7585,6 +10625,68 @@ func (r Groups_EditAddress_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_EditAddress_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("address_id", float64(r.AddressId), 0); err != nil {
+		return
+	}
+	if r.Title != nil {
+		if err = checkMaxLength("title", *r.Title, 255); err != nil {
+			return
+		}
+	}
+	if r.Address != nil {
+		if err = checkMaxLength("address", *r.Address, 255); err != nil {
+			return
+		}
+	}
+	if r.AdditionalAddress != nil {
+		if err = checkMaxLength("additional_address", *r.AdditionalAddress, 400); err != nil {
+			return
+		}
+	}
+	if r.CountryId != nil {
+		if err = checkMinimum("country_id", float64(*r.CountryId), 0); err != nil {
+			return
+		}
+	}
+	if r.CityId != nil {
+		if err = checkMinimum("city_id", float64(*r.CityId), 0); err != nil {
+			return
+		}
+	}
+	if r.MetroId != nil {
+		if err = checkMinimum("metro_id", float64(*r.MetroId), 0); err != nil {
+			return
+		}
+	}
+	if r.Latitude != nil {
+		if err = checkMinimum("latitude", *r.Latitude, -90); err != nil {
+			return
+		}
+		if err = checkMaximum("latitude", *r.Latitude, 90); err != nil {
+			return
+		}
+	}
+	if r.Longitude != nil {
+		if err = checkMinimum("longitude", *r.Longitude, -180); err != nil {
+			return
+		}
+		if err = checkMaximum("longitude", *r.Longitude, 180); err != nil {
+			return
+		}
+	}
+	if r.WorkInfoStatus != nil {
+		if err = checkStringEnum("work_info_status", string(*r.WorkInfoStatus), "no_information", "temporarily_closed", "always_opened", "timetable", "forever_closed"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_EditAddress ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -7593,6 +10695,9 @@ func (r Groups_EditAddress_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.editAddress
 func (vk *VK) Groups_EditAddress(ctx context.Context, req Groups_EditAddress_Request, options ...Option) (resp Groups_EditAddress_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 16+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7626,6 +10731,31 @@ func (r Groups_EditCallbackServer_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_EditCallbackServer_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("server_id", float64(r.ServerId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("url", r.Url != ""); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 14); err != nil {
+		return
+	}
+	if r.SecretKey != nil {
+		if err = checkMaxLength("secret_key", *r.SecretKey, 50); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_EditCallbackServer ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -7634,6 +10764,9 @@ func (r Groups_EditCallbackServer_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/groups.editCallbackServer
 func (vk *VK) Groups_EditCallbackServer(ctx context.Context, req Groups_EditCallbackServer_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7664,6 +10797,17 @@ func (r Groups_EditLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_EditLink_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("link_id", float64(r.LinkId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_EditLink Allows to edit a link in the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -7671,6 +10815,9 @@ func (r Groups_EditLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.editLink
 func (vk *VK) Groups_EditLink(ctx context.Context, req Groups_EditLink_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7722,6 +10869,22 @@ func (r Groups_EditManager_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_EditManager_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if r.Role != nil {
+		if err = checkStringEnum("role", string(*r.Role), "moderator", "editor", "administrator", "advertiser"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_EditManager Allows to add, remove or edit the community manager.
 // May execute with listed access token types:
 //    [ user ]
@@ -7730,6 +10893,9 @@ func (r Groups_EditManager_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.editManager
 func (vk *VK) Groups_EditManager(ctx context.Context, req Groups_EditManager_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7750,6 +10916,14 @@ func (r Groups_EnableOnline_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_EnableOnline_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_EnableOnline ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -7757,6 +10931,9 @@ func (r Groups_EnableOnline_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.enableOnline
 func (vk *VK) Groups_EnableOnline(ctx context.Context, req Groups_EnableOnline_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7809,6 +10986,43 @@ func (r Groups_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Get_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 1); err != nil {
+			return
+		}
+	}
+	if r.Filter != nil {
+		for _, v := range *r.Filter {
+			if err = checkStringEnum("filter", string(v), "admin", "editor", "moder", "advertiser", "groups", "publics", "events", "has_addresses"); err != nil {
+				return
+			}
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "market", "member_status", "is_favorite", "is_subscribed", "is_subscribed_podcasts", "can_subscribe_podcasts", "city", "country", "verified", "description", "wiki_page", "members_count", "requests_count", "counters", "cover", "can_post", "can_suggest", "can_upload_story", "can_upload_doc", "can_upload_video", "can_upload_clip", "can_see_all_posts", "can_create_topic", "crop_photo", "activity", "fixed_post", "has_photo", "status", "main_album_id", "links", "contacts", "site", "main_section", "secondary_section", "wall", "trending", "can_message", "is_market_cart_enabled", "is_messages_blocked", "can_send_notify", "has_group_channel", "group_channel", "online_status", "start_date", "finish_date", "age_limits", "ban_info", "action_button", "author_id", "phone", "has_market_app", "addresses", "live_covers", "is_adult", "is_hidden_from_feed", "can_subscribe_posts", "warning_notification", "msg_push_allowed", "stories_archive_count", "video_live_level", "video_live_count", "clips_count", "has_unseen_stories", "is_business", "textlives_count", "members_count_text"); err != nil {
+				return
+			}
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_Get Returns a list of the communities to which a user belongs.
 // May execute with listed access token types:
 //    [ user ]
@@ -7817,6 +11031,9 @@ func (r Groups_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.get
 func (vk *VK) Groups_Get(ctx context.Context, req Groups_Get_Request, options ...Option) (resp Groups_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7835,6 +11052,9 @@ func (vk *VK) Groups_Get(ctx context.Context, req Groups_Get_Request, options ..
 //
 // https://dev.vk.com/method/groups.get
 func (vk *VK) Groups_GetExtended(ctx context.Context, req Groups_Get_Request, options ...Option) (resp Groups_GetObjectExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7898,6 +11118,57 @@ func (r Groups_GetAddresses_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetAddresses_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.AddressIds != nil {
+		if err = checkMaxItems("address_ids", len(*r.AddressIds), 100); err != nil {
+			return
+		}
+		for _, v := range *r.AddressIds {
+			if err = checkMinimum("address_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.Latitude != nil {
+		if err = checkMinimum("latitude", *r.Latitude, -90); err != nil {
+			return
+		}
+		if err = checkMaximum("latitude", *r.Latitude, 90); err != nil {
+			return
+		}
+	}
+	if r.Longitude != nil {
+		if err = checkMinimum("longitude", *r.Longitude, -180); err != nil {
+			return
+		}
+		if err = checkMaximum("longitude", *r.Longitude, 180); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "id", "title", "address", "additional_address", "country_id", "city_id", "metro_station_id", "latitude", "longitude", "distance", "work_info_status", "timetable", "phone", "time_offset"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Groups_GetAddresses Returns a list of community addresses.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -7906,6 +11177,9 @@ func (r Groups_GetAddresses_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getAddresses
 func (vk *VK) Groups_GetAddresses(ctx context.Context, req Groups_GetAddresses_Request, options ...Option) (resp Groups_GetAddresses_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7954,6 +11228,34 @@ func (r Groups_GetBanned_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetBanned_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Groups_GetBanned Returns a list of users on a community blacklist.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -7962,6 +11264,9 @@ func (r Groups_GetBanned_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getBanned
 func (vk *VK) Groups_GetBanned(ctx context.Context, req Groups_GetBanned_Request, options ...Option) (resp Groups_GetBanned_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -7997,6 +11302,18 @@ func (r Groups_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetById_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "market", "member_status", "is_favorite", "is_subscribed", "is_subscribed_podcasts", "can_subscribe_podcasts", "city", "country", "verified", "description", "wiki_page", "members_count", "requests_count", "counters", "cover", "can_post", "can_suggest", "can_upload_story", "can_upload_doc", "can_upload_video", "can_upload_clip", "can_see_all_posts", "can_create_topic", "crop_photo", "activity", "fixed_post", "has_photo", "status", "main_album_id", "links", "contacts", "site", "main_section", "secondary_section", "wall", "trending", "can_message", "is_market_cart_enabled", "is_messages_blocked", "can_send_notify", "has_group_channel", "group_channel", "online_status", "start_date", "finish_date", "age_limits", "ban_info", "action_button", "author_id", "phone", "has_market_app", "addresses", "live_covers", "is_adult", "is_hidden_from_feed", "can_subscribe_posts", "warning_notification", "msg_push_allowed", "stories_archive_count", "video_live_level", "video_live_count", "clips_count", "has_unseen_stories", "is_business", "textlives_count", "members_count_text"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Groups_GetById Returns information about communities by their IDs.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -8004,6 +11321,9 @@ func (r Groups_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getById
 func (vk *VK) Groups_GetById(ctx context.Context, req Groups_GetById_Request, options ...Option) (resp Groups_GetByIdObjectLegacy_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8025,6 +11345,14 @@ func (r Groups_GetCallbackConfirmationCode_Request) fillIn(values url.Values) (e
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetCallbackConfirmationCode_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_GetCallbackConfirmationCode Returns Callback API confirmation code for the community.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -8032,6 +11360,9 @@ func (r Groups_GetCallbackConfirmationCode_Request) fillIn(values url.Values) (e
 //
 // https://dev.vk.com/method/groups.getCallbackConfirmationCode
 func (vk *VK) Groups_GetCallbackConfirmationCode(ctx context.Context, req Groups_GetCallbackConfirmationCode_Request, options ...Option) (resp Groups_GetCallbackConfirmationCode_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8057,6 +11388,21 @@ func (r Groups_GetCallbackServers_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetCallbackServers_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.ServerIds != nil {
+		for _, v := range *r.ServerIds {
+			if err = checkMinimum("server_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Groups_GetCallbackServers ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -8064,6 +11410,9 @@ func (r Groups_GetCallbackServers_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/groups.getCallbackServers
 func (vk *VK) Groups_GetCallbackServers(ctx context.Context, req Groups_GetCallbackServers_Request, options ...Option) (resp Groups_GetCallbackServers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8086,7 +11435,20 @@ type Groups_GetCallbackSettings_Request struct {
 func (r Groups_GetCallbackSettings_Request) fillIn(values url.Values) (err error) {
 	setInt(values, "group_id", r.GroupId)
 	if r.ServerId != nil {
-		setInt(values, "server_id", *r.ServerId)
+		setInt(values, "server_id", *r.ServerId)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetCallbackSettings_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.ServerId != nil {
+		if err = checkMinimum("server_id", float64(*r.ServerId), 0); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -8099,6 +11461,9 @@ func (r Groups_GetCallbackSettings_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/groups.getCallbackSettings
 func (vk *VK) Groups_GetCallbackSettings(ctx context.Context, req Groups_GetCallbackSettings_Request, options ...Option) (resp Groups_GetCallbackSettings_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8128,6 +11493,24 @@ func (r Groups_GetCatalog_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetCatalog_Request) Validate() (err error) {
+	if r.CategoryId != nil {
+		if err = checkMinimum("category_id", float64(*r.CategoryId), 0); err != nil {
+			return
+		}
+	}
+	if r.SubcategoryId != nil {
+		if err = checkMinimum("subcategory_id", float64(*r.SubcategoryId), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("subcategory_id", float64(*r.SubcategoryId), 99); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_GetCatalog Returns communities list for a catalog category.
 // May execute with listed access token types:
 //    [ user ]
@@ -8136,6 +11519,9 @@ func (r Groups_GetCatalog_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getCatalog
 func (vk *VK) Groups_GetCatalog(ctx context.Context, req Groups_GetCatalog_Request, options ...Option) (resp Groups_GetCatalog_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8158,6 +11544,11 @@ func (r Groups_GetCatalogInfo_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetCatalogInfo_Request) Validate() (err error) {
+	return
+}
+
 // Groups_GetCatalogInfo Returns categories list for communities catalog
 // May execute with listed access token types:
 //    [ user ]
@@ -8165,6 +11556,9 @@ func (r Groups_GetCatalogInfo_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getCatalogInfo
 func (vk *VK) Groups_GetCatalogInfo(ctx context.Context, req Groups_GetCatalogInfo_Request, options ...Option) (resp Groups_GetCatalogInfo_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8182,6 +11576,9 @@ func (vk *VK) Groups_GetCatalogInfo(ctx context.Context, req Groups_GetCatalogIn
 //
 // https://dev.vk.com/method/groups.getCatalogInfo
 func (vk *VK) Groups_GetCatalogInfoExtended(ctx context.Context, req Groups_GetCatalogInfo_Request, options ...Option) (resp Groups_GetCatalogInfoExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8241,6 +11638,36 @@ func (r Groups_GetInvitedUsers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetInvitedUsers_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_GetInvitedUsers Returns invited users list of a community
 // May execute with listed access token types:
 //    [ user ]
@@ -8248,6 +11675,9 @@ func (r Groups_GetInvitedUsers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getInvitedUsers
 func (vk *VK) Groups_GetInvitedUsers(ctx context.Context, req Groups_GetInvitedUsers_Request, options ...Option) (resp Groups_GetInvitedUsers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8277,6 +11707,21 @@ func (r Groups_GetInvites_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetInvites_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_GetInvites Returns a list of invitations to join communities and events.
 // May execute with listed access token types:
 //    [ user ]
@@ -8284,6 +11729,9 @@ func (r Groups_GetInvites_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getInvites
 func (vk *VK) Groups_GetInvites(ctx context.Context, req Groups_GetInvites_Request, options ...Option) (resp Groups_GetInvites_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8301,6 +11749,9 @@ func (vk *VK) Groups_GetInvites(ctx context.Context, req Groups_GetInvites_Reque
 //
 // https://dev.vk.com/method/groups.getInvites
 func (vk *VK) Groups_GetInvitesExtended(ctx context.Context, req Groups_GetInvites_Request, options ...Option) (resp Groups_GetInvitesExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8323,6 +11774,14 @@ func (r Groups_GetLongPollServer_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetLongPollServer_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_GetLongPollServer Returns the data needed to query a Long Poll server for events
 // May execute with listed access token types:
 //    [ user, group ]
@@ -8330,6 +11789,9 @@ func (r Groups_GetLongPollServer_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/groups.getLongPollServer
 func (vk *VK) Groups_GetLongPollServer(ctx context.Context, req Groups_GetLongPollServer_Request, options ...Option) (resp Groups_GetLongPollServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8351,6 +11813,14 @@ func (r Groups_GetLongPollSettings_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetLongPollSettings_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_GetLongPollSettings Returns Long Poll notification settings
 // May execute with listed access token types:
 //    [ user, group ]
@@ -8358,6 +11828,9 @@ func (r Groups_GetLongPollSettings_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/groups.getLongPollSettings
 func (vk *VK) Groups_GetLongPollSettings(ctx context.Context, req Groups_GetLongPollSettings_Request, options ...Option) (resp Groups_GetLongPollSettings_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8429,6 +11902,38 @@ func (r Groups_GetMembers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetMembers_Request) Validate() (err error) {
+	if r.Sort != nil {
+		if err = checkStringEnum("sort", string(*r.Sort), "id_asc", "id_desc", "time_asc", "time_desc"); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.Filter != nil {
+		if err = checkStringEnum("filter", string(*r.Filter), "friends", "unsure", "managers", "donut"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_GetMembers Returns a list of community members.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -8437,6 +11942,9 @@ func (r Groups_GetMembers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getMembers
 func (vk *VK) Groups_GetMembers(ctx context.Context, req Groups_GetMembers_Request, options ...Option) (resp Groups_GetMembers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8480,6 +11988,34 @@ func (r Groups_GetRequests_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetRequests_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Groups_GetRequests Returns a list of requests to the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -8487,6 +12023,9 @@ func (r Groups_GetRequests_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getRequests
 func (vk *VK) Groups_GetRequests(ctx context.Context, req Groups_GetRequests_Request, options ...Option) (resp Groups_GetRequests_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8508,6 +12047,14 @@ func (r Groups_GetSettings_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetSettings_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_GetSettings Returns community settings.
 // May execute with listed access token types:
 //    [ user ]
@@ -8515,6 +12062,9 @@ func (r Groups_GetSettings_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getSettings
 func (vk *VK) Groups_GetSettings(ctx context.Context, req Groups_GetSettings_Request, options ...Option) (resp Groups_GetSettings_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8535,6 +12085,14 @@ func (r Groups_GetTagList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_GetTagList_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_GetTagList List of group's tags
 // May execute with listed access token types:
 //    [ user, group ]
@@ -8542,6 +12100,9 @@ func (r Groups_GetTagList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.getTagList
 func (vk *VK) Groups_GetTagList(ctx context.Context, req Groups_GetTagList_Request, options ...Option) (resp Groups_GetTagList_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8581,6 +12142,17 @@ func (r Groups_Invite_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Invite_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_Invite Allows to invite friends to the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -8589,6 +12161,9 @@ func (r Groups_Invite_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.invite
 func (vk *VK) Groups_Invite(ctx context.Context, req Groups_Invite_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8615,6 +12190,19 @@ func (r Groups_IsMember_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_IsMember_Request) Validate() (err error) {
+	if err = checkRequired("group_id", r.GroupId != ""); err != nil {
+		return
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_IsMember Returns information specifying whether a user is a member of a community.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -8622,6 +12210,9 @@ func (r Groups_IsMember_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.isMember
 func (vk *VK) Groups_IsMember(ctx context.Context, req Groups_IsMember_Request, options ...Option) (resp Groups_IsMember_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8639,6 +12230,9 @@ func (vk *VK) Groups_IsMember(ctx context.Context, req Groups_IsMember_Request,
 //
 // https://dev.vk.com/method/groups.isMember
 func (vk *VK) Groups_IsMemberExtended(ctx context.Context, req Groups_IsMember_Request, options ...Option) (resp Groups_IsMemberExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8665,6 +12259,21 @@ func (r Groups_IsMemberUserIDs_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_IsMemberUserIDs_Request) Validate() (err error) {
+	if err = checkRequired("group_id", r.GroupId != ""); err != nil {
+		return
+	}
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 1); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Groups_IsMemberUserIDs Returns information specifying whether a user is a member of a community.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -8672,6 +12281,9 @@ func (r Groups_IsMemberUserIDs_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.isMember
 func (vk *VK) Groups_IsMemberUserIDs(ctx context.Context, req Groups_IsMemberUserIDs_Request, options ...Option) (resp Groups_IsMemberUserIds_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8689,6 +12301,9 @@ func (vk *VK) Groups_IsMemberUserIDs(ctx context.Context, req Groups_IsMemberUse
 //
 // https://dev.vk.com/method/groups.isMember
 func (vk *VK) Groups_IsMemberExtendedUserIDs(ctx context.Context, req Groups_IsMemberUserIDs_Request, options ...Option) (resp Groups_IsMemberUserIdsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8718,6 +12333,16 @@ func (r Groups_Join_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Join_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 1); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_Join With this method you can join the group or public page, and also confirm your participation in an event.
 // May execute with listed access token types:
 //    [ user ]
@@ -8726,6 +12351,9 @@ func (r Groups_Join_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.join
 func (vk *VK) Groups_Join(ctx context.Context, req Groups_Join_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8747,6 +12375,14 @@ func (r Groups_Leave_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Leave_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_Leave With this method you can leave a group, public page, or event.
 // May execute with listed access token types:
 //    [ user ]
@@ -8755,6 +12391,9 @@ func (r Groups_Leave_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.leave
 func (vk *VK) Groups_Leave(ctx context.Context, req Groups_Leave_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8781,6 +12420,17 @@ func (r Groups_RemoveUser_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_RemoveUser_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_RemoveUser Removes a user from the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -8788,6 +12438,9 @@ func (r Groups_RemoveUser_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.removeUser
 func (vk *VK) Groups_RemoveUser(ctx context.Context, req Groups_RemoveUser_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8819,6 +12472,22 @@ func (r Groups_ReorderLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_ReorderLink_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("link_id", float64(r.LinkId), 0); err != nil {
+		return
+	}
+	if r.After != nil {
+		if err = checkMinimum("after", float64(*r.After), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_ReorderLink Allows to reorder links in the community.
 // May execute with listed access token types:
 //    [ user ]
@@ -8826,6 +12495,9 @@ func (r Groups_ReorderLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.reorderLink
 func (vk *VK) Groups_ReorderLink(ctx context.Context, req Groups_ReorderLink_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -8910,6 +12582,47 @@ func (r Groups_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Search_Request) Validate() (err error) {
+	if err = checkRequired("q", r.Q != ""); err != nil {
+		return
+	}
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "group", "page", "event"); err != nil {
+			return
+		}
+	}
+	if r.CountryId != nil {
+		if err = checkMinimum("country_id", float64(*r.CountryId), 0); err != nil {
+			return
+		}
+	}
+	if r.CityId != nil {
+		if err = checkMinimum("city_id", float64(*r.CityId), 0); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkIntEnum("sort", int(*r.Sort), 0, 1, 2, 3, 4, 5); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_Search Returns a list of communities matching the search criteria.
 // May execute with listed access token types:
 //    [ user ]
@@ -8917,6 +12630,9 @@ func (r Groups_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.search
 func (vk *VK) Groups_Search(ctx context.Context, req Groups_Search_Request, options ...Option) (resp Groups_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9191,6 +12907,19 @@ func (r Groups_SetCallbackSettings_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_SetCallbackSettings_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.ServerId != nil {
+		if err = checkMinimum("server_id", float64(*r.ServerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_SetCallbackSettings Allow to set notifications settings for group.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9199,6 +12928,9 @@ func (r Groups_SetCallbackSettings_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/groups.setCallbackSettings
 func (vk *VK) Groups_SetCallbackSettings(ctx context.Context, req Groups_SetCallbackSettings_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 57+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9460,6 +13192,14 @@ func (r Groups_SetLongPollSettings_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_SetLongPollSettings_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_SetLongPollSettings Sets Long Poll notification settings
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9467,6 +13207,9 @@ func (r Groups_SetLongPollSettings_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/groups.setLongPollSettings
 func (vk *VK) Groups_SetLongPollSettings(ctx context.Context, req Groups_SetLongPollSettings_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 54+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9503,6 +13246,14 @@ func (r Groups_SetSettings_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_SetSettings_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_SetSettings ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9510,6 +13261,9 @@ func (r Groups_SetSettings_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.setSettings
 func (vk *VK) Groups_SetSettings(ctx context.Context, req Groups_SetSettings_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9540,6 +13294,22 @@ func (r Groups_SetUserNote_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_SetUserNote_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if r.Note != nil {
+		if err = checkMaxLength("note", *r.Note, 96); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_SetUserNote In order to save note about group participant
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9547,6 +13317,9 @@ func (r Groups_SetUserNote_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.setUserNote
 func (vk *VK) Groups_SetUserNote(ctx context.Context, req Groups_SetUserNote_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9599,6 +13372,25 @@ func (r Groups_TagAdd_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_TagAdd_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("tag_name", r.TagName != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("tag_name", r.TagName, 20); err != nil {
+		return
+	}
+	if r.TagColor != nil {
+		if err = checkStringEnum("tag_color", string(*r.TagColor), "454647", "45678f", "4bb34b", "5181b8", "539b9c", "5c9ce6", "63b9ba", "6bc76b", "76787a", "792ec0", "7a6c4f", "7ececf", "9e8d6b", "a162de", "aaaeb3", "bbaa84", "e64646", "ff5c5c", "ffa000", "ffc107"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Groups_TagAdd Add new group's tag
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9606,6 +13398,9 @@ func (r Groups_TagAdd_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.tagAdd
 func (vk *VK) Groups_TagAdd(ctx context.Context, req Groups_TagAdd_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9644,6 +13439,29 @@ func (r Groups_TagBind_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_TagBind_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("tag_id", float64(r.TagId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkMaximum("user_id", float64(r.UserId), 2e+09); err != nil {
+		return
+	}
+	if err = checkRequired("act", r.Act != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("act", string(r.Act), "bind", "unbind"); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_TagBind Bind or unbind group's tag to user
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9651,6 +13469,9 @@ func (r Groups_TagBind_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.tagBind
 func (vk *VK) Groups_TagBind(ctx context.Context, req Groups_TagBind_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9674,6 +13495,17 @@ func (r Groups_TagDelete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_TagDelete_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("tag_id", float64(r.TagId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_TagDelete Delete group's tag
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9681,6 +13513,9 @@ func (r Groups_TagDelete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.tagDelete
 func (vk *VK) Groups_TagDelete(ctx context.Context, req Groups_TagDelete_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9707,6 +13542,23 @@ func (r Groups_TagUpdate_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_TagUpdate_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("tag_id", float64(r.TagId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("tag_name", r.TagName != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("tag_name", r.TagName, 20); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_TagUpdate Update group's tag
 // May execute with listed access token types:
 //    [ user, group ]
@@ -9714,6 +13566,9 @@ func (r Groups_TagUpdate_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.tagUpdate
 func (vk *VK) Groups_TagUpdate(ctx context.Context, req Groups_TagUpdate_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9740,6 +13595,20 @@ func (r Groups_ToggleMarket_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_ToggleMarket_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("state", r.State != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("state", string(r.State), "none", "basic", "advanced"); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_ToggleMarket ...
 // May execute with listed access token types:
 //    [ user ]
@@ -9748,6 +13617,9 @@ func (r Groups_ToggleMarket_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.toggleMarket
 func (vk *VK) Groups_ToggleMarket(ctx context.Context, req Groups_ToggleMarket_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9773,6 +13645,14 @@ func (r Groups_Unban_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Groups_Unban_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Groups_Unban ...
 // May execute with listed access token types:
 //    [ user ]
@@ -9780,6 +13660,9 @@ func (r Groups_Unban_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/groups.unban
 func (vk *VK) Groups_Unban(ctx context.Context, req Groups_Unban_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9849,6 +13732,55 @@ func (r LeadForms_Create_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r LeadForms_Create_Request) Validate() (err error) {
+	if err = checkRequired("name", r.Name != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("name", r.Name, 100); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 60); err != nil {
+		return
+	}
+	if err = checkRequired("description", r.Description != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("description", r.Description, 600); err != nil {
+		return
+	}
+	if err = checkRequired("questions", r.Questions != ""); err != nil {
+		return
+	}
+	if err = checkRequired("policy_link_url", r.PolicyLinkUrl != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("policy_link_url", r.PolicyLinkUrl, 200); err != nil {
+		return
+	}
+	if r.Confirmation != nil {
+		if err = checkMaxLength("confirmation", *r.Confirmation, 300); err != nil {
+			return
+		}
+	}
+	if r.SiteLinkUrl != nil {
+		if err = checkMaxLength("site_link_url", *r.SiteLinkUrl, 200); err != nil {
+			return
+		}
+	}
+	if r.NotifyAdmins != nil {
+		for _, v := range *r.NotifyAdmins {
+			if err = checkMinimum("notify_admins", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // LeadForms_Create ...
 // May execute with listed access token types:
 //    [ user ]
@@ -9856,6 +13788,9 @@ func (r LeadForms_Create_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/leadForms.create
 func (vk *VK) LeadForms_Create(ctx context.Context, req LeadForms_Create_Request, options ...Option) (resp LeadForms_Create_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 16+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9876,6 +13811,11 @@ func (r LeadForms_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r LeadForms_Delete_Request) Validate() (err error) {
+	return
+}
+
 // LeadForms_Delete ...
 // May execute with listed access token types:
 //    [ user ]
@@ -9884,6 +13824,9 @@ func (r LeadForms_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/leadForms.delete
 func (vk *VK) LeadForms_Delete(ctx context.Context, req LeadForms_Delete_Request, options ...Option) (resp LeadForms_Delete_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9904,6 +13847,11 @@ func (r LeadForms_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r LeadForms_Get_Request) Validate() (err error) {
+	return
+}
+
 // LeadForms_Get ...
 // May execute with listed access token types:
 //    [ user ]
@@ -9912,6 +13860,9 @@ func (r LeadForms_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/leadForms.get
 func (vk *VK) LeadForms_Get(ctx context.Context, req LeadForms_Get_Request, options ...Option) (resp LeadForms_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9943,6 +13894,19 @@ func (r LeadForms_GetLeads_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r LeadForms_GetLeads_Request) Validate() (err error) {
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("limit", float64(*r.Limit), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // LeadForms_GetLeads ...
 // May execute with listed access token types:
 //    [ user ]
@@ -9951,6 +13915,9 @@ func (r LeadForms_GetLeads_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/leadForms.getLeads
 func (vk *VK) LeadForms_GetLeads(ctx context.Context, req LeadForms_GetLeads_Request, options ...Option) (resp LeadForms_GetLeads_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -9982,6 +13949,11 @@ func (r LeadForms_List_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r LeadForms_List_Request) Validate() (err error) {
+	return
+}
+
 // LeadForms_List ...
 // May execute with listed access token types:
 //    [ user ]
@@ -9989,6 +13961,9 @@ func (r LeadForms_List_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/leadForms.list
 func (vk *VK) LeadForms_List(ctx context.Context, req LeadForms_List_Request, options ...Option) (resp LeadForms_List_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10060,6 +14035,55 @@ func (r LeadForms_Update_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r LeadForms_Update_Request) Validate() (err error) {
+	if err = checkRequired("name", r.Name != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("name", r.Name, 100); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 60); err != nil {
+		return
+	}
+	if err = checkRequired("description", r.Description != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("description", r.Description, 600); err != nil {
+		return
+	}
+	if err = checkRequired("questions", r.Questions != ""); err != nil {
+		return
+	}
+	if err = checkRequired("policy_link_url", r.PolicyLinkUrl != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("policy_link_url", r.PolicyLinkUrl, 200); err != nil {
+		return
+	}
+	if r.Confirmation != nil {
+		if err = checkMaxLength("confirmation", *r.Confirmation, 300); err != nil {
+			return
+		}
+	}
+	if r.SiteLinkUrl != nil {
+		if err = checkMaxLength("site_link_url", *r.SiteLinkUrl, 200); err != nil {
+			return
+		}
+	}
+	if r.NotifyAdmins != nil {
+		for _, v := range *r.NotifyAdmins {
+			if err = checkMinimum("notify_admins", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // LeadForms_Update ...
 // May execute with listed access token types:
 //    [ user ]
@@ -10068,6 +14092,9 @@ func (r LeadForms_Update_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/leadForms.update
 func (vk *VK) LeadForms_Update(ctx context.Context, req LeadForms_Update_Request, options ...Option) (resp LeadForms_Create_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 17+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10102,6 +14129,20 @@ func (r Likes_Add_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Likes_Add_Request) Validate() (err error) {
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "post", "comment", "photo", "audio", "video", "note", "market", "photo_comment", "video_comment", "topic_comment", "market_comment", "sitepage", "textpost"); err != nil {
+		return
+	}
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Likes_Add Adds the specified object to the 'Likes' list of the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -10110,6 +14151,9 @@ func (r Likes_Add_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/likes.add
 func (vk *VK) Likes_Add(ctx context.Context, req Likes_Add_Request, options ...Option) (resp Likes_Add_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10144,6 +14188,20 @@ func (r Likes_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Likes_Delete_Request) Validate() (err error) {
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "post", "comment", "photo", "audio", "video", "note", "market", "photo_comment", "video_comment", "topic_comment", "market_comment", "sitepage", "textpost"); err != nil {
+		return
+	}
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Likes_Delete Deletes the specified object from the 'Likes' list of the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -10151,6 +14209,9 @@ func (r Likes_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/likes.delete
 func (vk *VK) Likes_Delete(ctx context.Context, req Likes_Delete_Request, options ...Option) (resp Likes_Delete_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10230,6 +14291,40 @@ func (r Likes_GetList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Likes_GetList_Request) Validate() (err error) {
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "post", "comment", "photo", "audio", "video", "note", "market", "photo_comment", "video_comment", "topic_comment", "market_comment", "sitepage", "textpost"); err != nil {
+		return
+	}
+	if r.Filter != nil {
+		if err = checkStringEnum("filter", string(*r.Filter), "likes", "copies"); err != nil {
+			return
+		}
+	}
+	if r.FriendsOnly != nil {
+		if err = checkIntEnum("friends_only", int(*r.FriendsOnly), 0, 1, 2, 3); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Likes_GetList Returns a list of IDs of users who added the specified object to their 'Likes' list.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -10238,6 +14333,9 @@ func (r Likes_GetList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/likes.getList
 func (vk *VK) Likes_GetList(ctx context.Context, req Likes_GetList_Request, options ...Option) (resp Likes_GetList_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10256,6 +14354,9 @@ func (vk *VK) Likes_GetList(ctx context.Context, req Likes_GetList_Request, opti
 //
 // https://dev.vk.com/method/likes.getList
 func (vk *VK) Likes_GetListExtended(ctx context.Context, req Likes_GetList_Request, options ...Option) (resp Likes_GetListExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10293,6 +14394,25 @@ func (r Likes_IsLiked_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Likes_IsLiked_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "post", "comment", "photo", "audio", "video", "note", "market", "photo_comment", "video_comment", "topic_comment", "market_comment", "sitepage", "textpost"); err != nil {
+		return
+	}
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Likes_IsLiked Checks for the object in the 'Likes' list of the specified user.
 // May execute with listed access token types:
 //    [ user ]
@@ -10300,6 +14420,9 @@ func (r Likes_IsLiked_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/likes.isLiked
 func (vk *VK) Likes_IsLiked(ctx context.Context, req Likes_IsLiked_Request, options ...Option) (resp Likes_IsLiked_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10397,6 +14520,99 @@ func (r Market_Add_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_Add_Request) Validate() (err error) {
+	if err = checkRequired("name", r.Name != ""); err != nil {
+		return
+	}
+	if err = checkMinLength("name", r.Name, 4); err != nil {
+		return
+	}
+	if err = checkMaxLength("name", r.Name, 100); err != nil {
+		return
+	}
+	if err = checkRequired("description", r.Description != ""); err != nil {
+		return
+	}
+	if err = checkMinLength("description", r.Description, 10); err != nil {
+		return
+	}
+	if err = checkMinimum("category_id", float64(r.CategoryId), 0); err != nil {
+		return
+	}
+	if r.Price != nil {
+		if err = checkMinimum("price", *r.Price, 0); err != nil {
+			return
+		}
+	}
+	if r.OldPrice != nil {
+		if err = checkMinimum("old_price", *r.OldPrice, 0.01); err != nil {
+			return
+		}
+	}
+	if r.MainPhotoId != nil {
+		if err = checkMinimum("main_photo_id", float64(*r.MainPhotoId), 0); err != nil {
+			return
+		}
+	}
+	if r.PhotoIds != nil {
+		if err = checkMaxItems("photo_ids", len(*r.PhotoIds), 4); err != nil {
+			return
+		}
+		for _, v := range *r.PhotoIds {
+			if err = checkMinimum("photo_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.Url != nil {
+		if err = checkMinLength("url", *r.Url, 0); err != nil {
+			return
+		}
+		if err = checkMaxLength("url", *r.Url, 320); err != nil {
+			return
+		}
+	}
+	if r.DimensionWidth != nil {
+		if err = checkMinimum("dimension_width", float64(*r.DimensionWidth), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("dimension_width", float64(*r.DimensionWidth), 100000); err != nil {
+			return
+		}
+	}
+	if r.DimensionHeight != nil {
+		if err = checkMinimum("dimension_height", float64(*r.DimensionHeight), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("dimension_height", float64(*r.DimensionHeight), 100000); err != nil {
+			return
+		}
+	}
+	if r.DimensionLength != nil {
+		if err = checkMinimum("dimension_length", float64(*r.DimensionLength), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("dimension_length", float64(*r.DimensionLength), 100000); err != nil {
+			return
+		}
+	}
+	if r.Weight != nil {
+		if err = checkMinimum("weight", float64(*r.Weight), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("weight", float64(*r.Weight), 1e+08); err != nil {
+			return
+		}
+	}
+	if r.Sku != nil {
+		if err = checkMaxLength("sku", *r.Sku, 50); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_Add Ads a new item to the market.
 // May execute with listed access token types:
 //    [ user ]
@@ -10405,6 +14621,9 @@ func (r Market_Add_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.add
 func (vk *VK) Market_Add(ctx context.Context, req Market_Add_Request, options ...Option) (resp Market_Add_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 17+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10445,6 +14664,22 @@ func (r Market_AddAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_AddAlbum_Request) Validate() (err error) {
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 128); err != nil {
+		return
+	}
+	if r.PhotoId != nil {
+		if err = checkMinimum("photo_id", float64(*r.PhotoId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_AddAlbum Creates new collection of items
 // May execute with listed access token types:
 //    [ user ]
@@ -10453,6 +14688,9 @@ func (r Market_AddAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.addAlbum
 func (vk *VK) Market_AddAlbum(ctx context.Context, req Market_AddAlbum_Request, options ...Option) (resp Market_AddAlbum_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10483,6 +14721,25 @@ func (r Market_AddToAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_AddToAlbum_Request) Validate() (err error) {
+	if r.ItemIds != nil {
+		for _, v := range *r.ItemIds {
+			if err = checkMinimum("item_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.AlbumIds != nil {
+		for _, v := range *r.AlbumIds {
+			if err = checkMinimum("album_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Market_AddToAlbum Adds an item to one or multiple collections.
 // May execute with listed access token types:
 //    [ user ]
@@ -10491,6 +14748,9 @@ func (r Market_AddToAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.addToAlbum
 func (vk *VK) Market_AddToAlbum(ctx context.Context, req Market_AddToAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10546,6 +14806,24 @@ func (r Market_CreateComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_CreateComment_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	if r.ReplyToComment != nil {
+		if err = checkMinimum("reply_to_comment", float64(*r.ReplyToComment), 0); err != nil {
+			return
+		}
+	}
+	if r.StickerId != nil {
+		if err = checkMinimum("sticker_id", float64(*r.StickerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_CreateComment Creates a new comment for an item.
 // May execute with listed access token types:
 //    [ user ]
@@ -10553,6 +14831,9 @@ func (r Market_CreateComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.createComment
 func (vk *VK) Market_CreateComment(ctx context.Context, req Market_CreateComment_Request, options ...Option) (resp Market_CreateComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10577,6 +14858,14 @@ func (r Market_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_Delete_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Market_Delete Deletes an item.
 // May execute with listed access token types:
 //    [ user ]
@@ -10585,6 +14874,9 @@ func (r Market_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.delete
 func (vk *VK) Market_Delete(ctx context.Context, req Market_Delete_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10609,6 +14901,14 @@ func (r Market_DeleteAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_DeleteAlbum_Request) Validate() (err error) {
+	if err = checkMinimum("album_id", float64(r.AlbumId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Market_DeleteAlbum Deletes a collection of items.
 // May execute with listed access token types:
 //    [ user ]
@@ -10617,6 +14917,9 @@ func (r Market_DeleteAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.deleteAlbum
 func (vk *VK) Market_DeleteAlbum(ctx context.Context, req Market_DeleteAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10641,6 +14944,14 @@ func (r Market_DeleteComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_DeleteComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Market_DeleteComment Deletes an item's comment
 // May execute with listed access token types:
 //    [ user ]
@@ -10648,6 +14959,9 @@ func (r Market_DeleteComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.deleteComment
 func (vk *VK) Market_DeleteComment(ctx context.Context, req Market_DeleteComment_Request, options ...Option) (resp Market_DeleteComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10755,6 +15069,102 @@ func (r Market_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	if r.Name != nil {
+		if err = checkMinLength("name", *r.Name, 4); err != nil {
+			return
+		}
+		if err = checkMaxLength("name", *r.Name, 100); err != nil {
+			return
+		}
+	}
+	if r.Description != nil {
+		if err = checkMinLength("description", *r.Description, 10); err != nil {
+			return
+		}
+	}
+	if r.CategoryId != nil {
+		if err = checkMinimum("category_id", float64(*r.CategoryId), 0); err != nil {
+			return
+		}
+	}
+	if r.Price != nil {
+		if err = checkMinimum("price", *r.Price, 0); err != nil {
+			return
+		}
+	}
+	if r.OldPrice != nil {
+		if err = checkMinimum("old_price", *r.OldPrice, 0.01); err != nil {
+			return
+		}
+	}
+	if r.MainPhotoId != nil {
+		if err = checkMinimum("main_photo_id", float64(*r.MainPhotoId), 0); err != nil {
+			return
+		}
+	}
+	if r.PhotoIds != nil {
+		if err = checkMaxItems("photo_ids", len(*r.PhotoIds), 4); err != nil {
+			return
+		}
+		for _, v := range *r.PhotoIds {
+			if err = checkMinimum("photo_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.Url != nil {
+		if err = checkMinLength("url", *r.Url, 0); err != nil {
+			return
+		}
+		if err = checkMaxLength("url", *r.Url, 320); err != nil {
+			return
+		}
+	}
+	if r.DimensionWidth != nil {
+		if err = checkMinimum("dimension_width", float64(*r.DimensionWidth), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("dimension_width", float64(*r.DimensionWidth), 100000); err != nil {
+			return
+		}
+	}
+	if r.DimensionHeight != nil {
+		if err = checkMinimum("dimension_height", float64(*r.DimensionHeight), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("dimension_height", float64(*r.DimensionHeight), 100000); err != nil {
+			return
+		}
+	}
+	if r.DimensionLength != nil {
+		if err = checkMinimum("dimension_length", float64(*r.DimensionLength), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("dimension_length", float64(*r.DimensionLength), 100000); err != nil {
+			return
+		}
+	}
+	if r.Weight != nil {
+		if err = checkMinimum("weight", float64(*r.Weight), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("weight", float64(*r.Weight), 1e+08); err != nil {
+			return
+		}
+	}
+	if r.Sku != nil {
+		if err = checkMaxLength("sku", *r.Sku, 50); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_Edit Edits an item.
 // May execute with listed access token types:
 //    [ user ]
@@ -10763,6 +15173,9 @@ func (r Market_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.edit
 func (vk *VK) Market_Edit(ctx context.Context, req Market_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 18+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10807,6 +15220,25 @@ func (r Market_EditAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_EditAlbum_Request) Validate() (err error) {
+	if err = checkMinimum("album_id", float64(r.AlbumId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("title", r.Title, 128); err != nil {
+		return
+	}
+	if r.PhotoId != nil {
+		if err = checkMinimum("photo_id", float64(*r.PhotoId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_EditAlbum Edits a collection of items
 // May execute with listed access token types:
 //    [ user ]
@@ -10815,6 +15247,9 @@ func (r Market_EditAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.editAlbum
 func (vk *VK) Market_EditAlbum(ctx context.Context, req Market_EditAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10848,6 +15283,14 @@ func (r Market_EditComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_EditComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Market_EditComment Chages item comment's text
 // May execute with listed access token types:
 //    [ user ]
@@ -10855,6 +15298,9 @@ func (r Market_EditComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.editComment
 func (vk *VK) Market_EditComment(ctx context.Context, req Market_EditComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -10911,25 +15357,93 @@ func (r Market_EditOrder_Request) fillIn(values url.Values) (err error) {
 		setInt(values, "status", *r.Status)
 	}
 	if r.TrackNumber != nil {
-		setString(values, "track_number", *r.TrackNumber)
+		setString(values, "track_number", *r.TrackNumber)
+	}
+	if r.PaymentStatus != nil {
+		setString(values, "payment_status", string(*r.PaymentStatus))
+	}
+	if r.DeliveryPrice != nil {
+		setInt(values, "delivery_price", *r.DeliveryPrice)
+	}
+	if r.Width != nil {
+		setInt(values, "width", *r.Width)
+	}
+	if r.Length != nil {
+		setInt(values, "length", *r.Length)
+	}
+	if r.Height != nil {
+		setInt(values, "height", *r.Height)
+	}
+	if r.Weight != nil {
+		setInt(values, "weight", *r.Weight)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Market_EditOrder_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("order_id", float64(r.OrderId), 0); err != nil {
+		return
+	}
+	if r.MerchantComment != nil {
+		if err = checkMaxLength("merchant_comment", *r.MerchantComment, 800); err != nil {
+			return
+		}
+	}
+	if r.Status != nil {
+		if err = checkMinimum("status", float64(*r.Status), 0); err != nil {
+			return
+		}
+	}
+	if r.TrackNumber != nil {
+		if err = checkMaxLength("track_number", *r.TrackNumber, 60); err != nil {
+			return
+		}
 	}
 	if r.PaymentStatus != nil {
-		setString(values, "payment_status", string(*r.PaymentStatus))
+		if err = checkStringEnum("payment_status", string(*r.PaymentStatus), "not_paid", "paid", "returned"); err != nil {
+			return
+		}
 	}
 	if r.DeliveryPrice != nil {
-		setInt(values, "delivery_price", *r.DeliveryPrice)
+		if err = checkMinimum("delivery_price", float64(*r.DeliveryPrice), 0); err != nil {
+			return
+		}
 	}
 	if r.Width != nil {
-		setInt(values, "width", *r.Width)
+		if err = checkMinimum("width", float64(*r.Width), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("width", float64(*r.Width), 100000); err != nil {
+			return
+		}
 	}
 	if r.Length != nil {
-		setInt(values, "length", *r.Length)
+		if err = checkMinimum("length", float64(*r.Length), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("length", float64(*r.Length), 100000); err != nil {
+			return
+		}
 	}
 	if r.Height != nil {
-		setInt(values, "height", *r.Height)
+		if err = checkMinimum("height", float64(*r.Height), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("height", float64(*r.Height), 100000); err != nil {
+			return
+		}
 	}
 	if r.Weight != nil {
-		setInt(values, "weight", *r.Weight)
+		if err = checkMinimum("weight", float64(*r.Weight), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("weight", float64(*r.Weight), 1e+08); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -10942,6 +15456,9 @@ func (r Market_EditOrder_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.editOrder
 func (vk *VK) Market_EditOrder(ctx context.Context, req Market_EditOrder_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 13+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11003,6 +15520,29 @@ func (r Market_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_Get_Request) Validate() (err error) {
+	if r.AlbumId != nil {
+		if err = checkMinimum("album_id", float64(*r.AlbumId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_Get Returns items list for a community.
 // May execute with listed access token types:
 //    [ user ]
@@ -11010,6 +15550,9 @@ func (r Market_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.get
 func (vk *VK) Market_Get(ctx context.Context, req Market_Get_Request, options ...Option) (resp Market_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11027,6 +15570,9 @@ func (vk *VK) Market_Get(ctx context.Context, req Market_Get_Request, options ..
 //
 // https://dev.vk.com/method/market.get
 func (vk *VK) Market_GetExtended(ctx context.Context, req Market_Get_Request, options ...Option) (resp Market_GetExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11053,6 +15599,18 @@ func (r Market_GetAlbumById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetAlbumById_Request) Validate() (err error) {
+	if r.AlbumIds != nil {
+		for _, v := range *r.AlbumIds {
+			if err = checkMinimum("album_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Market_GetAlbumById Returns items album's data
 // May execute with listed access token types:
 //    [ user ]
@@ -11060,6 +15618,9 @@ func (r Market_GetAlbumById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getAlbumById
 func (vk *VK) Market_GetAlbumById(ctx context.Context, req Market_GetAlbumById_Request, options ...Option) (resp Market_GetAlbumById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11094,6 +15655,24 @@ func (r Market_GetAlbums_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetAlbums_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_GetAlbums Returns community's market collections list.
 // May execute with listed access token types:
 //    [ user ]
@@ -11101,6 +15680,9 @@ func (r Market_GetAlbums_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getAlbums
 func (vk *VK) Market_GetAlbums(ctx context.Context, req Market_GetAlbums_Request, options ...Option) (resp Market_GetAlbums_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11122,6 +15704,16 @@ func (r Market_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetById_Request) Validate() (err error) {
+	if r.ItemIds != nil {
+		if err = checkMaxItems("item_ids", len(*r.ItemIds), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_GetById Returns information about market items by their ids.
 // May execute with listed access token types:
 //    [ user ]
@@ -11129,6 +15721,9 @@ func (r Market_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getById
 func (vk *VK) Market_GetById(ctx context.Context, req Market_GetById_Request, options ...Option) (resp Market_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11146,6 +15741,9 @@ func (vk *VK) Market_GetById(ctx context.Context, req Market_GetById_Request, op
 //
 // https://dev.vk.com/method/market.getById
 func (vk *VK) Market_GetByIdExtended(ctx context.Context, req Market_GetById_Request, options ...Option) (resp Market_GetByIdExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11177,6 +15775,24 @@ func (r Market_GetCategories_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetCategories_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_GetCategories Returns a list of market categories.
 // May execute with listed access token types:
 //    [ user ]
@@ -11184,6 +15800,9 @@ func (r Market_GetCategories_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getCategories
 func (vk *VK) Market_GetCategories(ctx context.Context, req Market_GetCategories_Request, options ...Option) (resp Market_GetCategories_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11259,6 +15878,44 @@ func (r Market_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetComments_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	if r.StartCommentId != nil {
+		if err = checkMinimum("start_comment_id", float64(*r.StartCommentId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkStringEnum("sort", string(*r.Sort), "asc", "desc"); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Market_GetComments Returns comments list for an item.
 // May execute with listed access token types:
 //    [ user ]
@@ -11267,6 +15924,9 @@ func (r Market_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getComments
 func (vk *VK) Market_GetComments(ctx context.Context, req Market_GetComments_Request, options ...Option) (resp Market_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11300,6 +15960,27 @@ func (r Market_GetGroupOrders_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetGroupOrders_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 50); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_GetGroupOrders Get market orders
 // May execute with listed access token types:
 //    [ user, group ]
@@ -11308,6 +15989,9 @@ func (r Market_GetGroupOrders_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getGroupOrders
 func (vk *VK) Market_GetGroupOrders(ctx context.Context, req Market_GetGroupOrders_Request, options ...Option) (resp Market_GetGroupOrders_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11337,6 +16021,19 @@ func (r Market_GetOrderById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetOrderById_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkMinimum("order_id", float64(r.OrderId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Market_GetOrderById Get order
 // May execute with listed access token types:
 //    [ user, group ]
@@ -11344,6 +16041,9 @@ func (r Market_GetOrderById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getOrderById
 func (vk *VK) Market_GetOrderById(ctx context.Context, req Market_GetOrderById_Request, options ...Option) (resp Market_GetOrderById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11380,6 +16080,29 @@ func (r Market_GetOrderItems_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetOrderItems_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkMinimum("order_id", float64(r.OrderId), 0); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_GetOrderItems Get market items in the order
 // May execute with listed access token types:
 //    [ user, group ]
@@ -11387,6 +16110,9 @@ func (r Market_GetOrderItems_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getOrderItems
 func (vk *VK) Market_GetOrderItems(ctx context.Context, req Market_GetOrderItems_Request, options ...Option) (resp Market_GetOrderItems_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11426,6 +16152,24 @@ func (r Market_GetOrders_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_GetOrders_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 10); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_GetOrders ...
 // May execute with listed access token types:
 //    [ user ]
@@ -11433,6 +16177,9 @@ func (r Market_GetOrders_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.getOrders
 func (vk *VK) Market_GetOrders(ctx context.Context, req Market_GetOrders_Request, options ...Option) (resp Market_GetOrders_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11450,6 +16197,9 @@ func (vk *VK) Market_GetOrders(ctx context.Context, req Market_GetOrders_Request
 //
 // https://dev.vk.com/method/market.getOrders
 func (vk *VK) Market_GetOrdersExtended(ctx context.Context, req Market_GetOrders_Request, options ...Option) (resp Market_GetOrdersExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11480,6 +16230,21 @@ func (r Market_RemoveFromAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_RemoveFromAlbum_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	if r.AlbumIds != nil {
+		for _, v := range *r.AlbumIds {
+			if err = checkMinimum("album_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Market_RemoveFromAlbum Removes an item from one or multiple collections.
 // May execute with listed access token types:
 //    [ user ]
@@ -11488,6 +16253,9 @@ func (r Market_RemoveFromAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.removeFromAlbum
 func (vk *VK) Market_RemoveFromAlbum(ctx context.Context, req Market_RemoveFromAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11523,6 +16291,21 @@ func (r Market_ReorderAlbums_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_ReorderAlbums_Request) Validate() (err error) {
+	if r.Before != nil {
+		if err = checkMinimum("before", float64(*r.Before), 0); err != nil {
+			return
+		}
+	}
+	if r.After != nil {
+		if err = checkMinimum("after", float64(*r.After), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_ReorderAlbums Reorders the collections list.
 // May execute with listed access token types:
 //    [ user ]
@@ -11531,6 +16314,9 @@ func (r Market_ReorderAlbums_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.reorderAlbums
 func (vk *VK) Market_ReorderAlbums(ctx context.Context, req Market_ReorderAlbums_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11572,6 +16358,24 @@ func (r Market_ReorderItems_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_ReorderItems_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	if r.Before != nil {
+		if err = checkMinimum("before", float64(*r.Before), 0); err != nil {
+			return
+		}
+	}
+	if r.After != nil {
+		if err = checkMinimum("after", float64(*r.After), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_ReorderItems Changes item place in a collection.
 // May execute with listed access token types:
 //    [ user ]
@@ -11580,6 +16384,9 @@ func (r Market_ReorderItems_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.reorderItems
 func (vk *VK) Market_ReorderItems(ctx context.Context, req Market_ReorderItems_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11623,6 +16430,22 @@ func (r Market_Report_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_Report_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("reason", int(*r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_Report Sends a complaint to the item.
 // May execute with listed access token types:
 //    [ user ]
@@ -11630,6 +16453,9 @@ func (r Market_Report_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.report
 func (vk *VK) Market_Report(ctx context.Context, req Market_Report_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11670,6 +16496,20 @@ func (r Market_ReportComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_ReportComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("reason", float64(r.Reason), 0); err != nil {
+		return
+	}
+	if err = checkIntEnum("reason", int(r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+		return
+	}
+	return
+}
+
 // Market_ReportComment Sends a complaint to the item's comment.
 // May execute with listed access token types:
 //    [ user ]
@@ -11677,6 +16517,9 @@ func (r Market_ReportComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.reportComment
 func (vk *VK) Market_ReportComment(ctx context.Context, req Market_ReportComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11701,6 +16544,14 @@ func (r Market_Restore_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_Restore_Request) Validate() (err error) {
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Market_Restore Restores recently deleted item
 // May execute with listed access token types:
 //    [ user ]
@@ -11709,6 +16560,9 @@ func (r Market_Restore_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.restore
 func (vk *VK) Market_Restore(ctx context.Context, req Market_Restore_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11733,6 +16587,14 @@ func (r Market_RestoreComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_RestoreComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Market_RestoreComment Restores a recently deleted comment
 // May execute with listed access token types:
 //    [ user ]
@@ -11740,6 +16602,9 @@ func (r Market_RestoreComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.restoreComment
 func (vk *VK) Market_RestoreComment(ctx context.Context, req Market_RestoreComment_Request, options ...Option) (resp Market_RestoreComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11834,6 +16699,57 @@ func (r Market_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_Search_Request) Validate() (err error) {
+	if r.AlbumId != nil {
+		if err = checkMinimum("album_id", float64(*r.AlbumId), 0); err != nil {
+			return
+		}
+	}
+	if r.PriceFrom != nil {
+		if err = checkMinimum("price_from", float64(*r.PriceFrom), 0); err != nil {
+			return
+		}
+	}
+	if r.PriceTo != nil {
+		if err = checkMinimum("price_to", float64(*r.PriceTo), 0); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkIntEnum("sort", int(*r.Sort), 0, 1, 2, 3); err != nil {
+			return
+		}
+	}
+	if r.Rev != nil {
+		if err = checkMinimum("rev", float64(*r.Rev), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("rev", int(*r.Rev), 0, 1); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Status != nil {
+		if err = checkMaxItems("status", len(*r.Status), 2); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_Search Searches market items in a community's catalog
 // May execute with listed access token types:
 //    [ user ]
@@ -11841,6 +16757,9 @@ func (r Market_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.search
 func (vk *VK) Market_Search(ctx context.Context, req Market_Search_Request, options ...Option) (resp Market_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11858,6 +16777,9 @@ func (vk *VK) Market_Search(ctx context.Context, req Market_Search_Request, opti
 //
 // https://dev.vk.com/method/market.search
 func (vk *VK) Market_SearchExtended(ctx context.Context, req Market_Search_Request, options ...Option) (resp Market_SearchExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11939,6 +16861,57 @@ func (r Market_SearchItems_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Market_SearchItems_Request) Validate() (err error) {
+	if err = checkRequired("q", r.Q != ""); err != nil {
+		return
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 300); err != nil {
+			return
+		}
+	}
+	if r.CategoryId != nil {
+		if err = checkMinimum("category_id", float64(*r.CategoryId), 0); err != nil {
+			return
+		}
+	}
+	if r.PriceFrom != nil {
+		if err = checkMinimum("price_from", float64(*r.PriceFrom), 0); err != nil {
+			return
+		}
+	}
+	if r.PriceTo != nil {
+		if err = checkMinimum("price_to", float64(*r.PriceTo), 0); err != nil {
+			return
+		}
+	}
+	if r.SortBy != nil {
+		if err = checkIntEnum("sort_by", int(*r.SortBy), 1, 2, 3); err != nil {
+			return
+		}
+	}
+	if r.SortDirection != nil {
+		if err = checkIntEnum("sort_direction", int(*r.SortDirection), 0, 1); err != nil {
+			return
+		}
+	}
+	if r.Country != nil {
+		if err = checkMinimum("country", float64(*r.Country), 0); err != nil {
+			return
+		}
+	}
+	if r.City != nil {
+		if err = checkMinimum("city", float64(*r.City), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Market_SearchItems ...
 // May execute with listed access token types:
 //    [ user ]
@@ -11946,6 +16919,9 @@ func (r Market_SearchItems_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/market.searchItems
 func (vk *VK) Market_SearchItems(ctx context.Context, req Market_SearchItems_Request, options ...Option) (resp Market_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -11980,6 +16956,30 @@ func (r Messages_AddChatUser_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_AddChatUser_Request) Validate() (err error) {
+	if err = checkMinimum("chat_id", float64(r.ChatId), 0); err != nil {
+		return
+	}
+	if err = checkMaximum("chat_id", float64(r.ChatId), 1e+08); err != nil {
+		return
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.VisibleMessagesCount != nil {
+		if err = checkMinimum("visible_messages_count", float64(*r.VisibleMessagesCount), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("visible_messages_count", float64(*r.VisibleMessagesCount), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_AddChatUser Adds a new user to a chat.
 // May execute with listed access token types:
 //    [ user ]
@@ -11988,6 +16988,9 @@ func (r Messages_AddChatUser_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.addChatUser
 func (vk *VK) Messages_AddChatUser(ctx context.Context, req Messages_AddChatUser_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12014,6 +17017,19 @@ func (r Messages_AllowMessagesFromGroup_Request) fillIn(values url.Values) (err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_AllowMessagesFromGroup_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.Key != nil {
+		if err = checkMaxLength("key", *r.Key, 256); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_AllowMessagesFromGroup Allows sending messages from community to the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -12022,6 +17038,9 @@ func (r Messages_AllowMessagesFromGroup_Request) fillIn(values url.Values) (err
 //
 // https://dev.vk.com/method/messages.allowMessagesFromGroup
 func (vk *VK) Messages_AllowMessagesFromGroup(ctx context.Context, req Messages_AllowMessagesFromGroup_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12055,6 +17074,23 @@ func (r Messages_CreateChat_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_CreateChat_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_CreateChat Creates a chat with several participants.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12063,6 +17099,9 @@ func (r Messages_CreateChat_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.createChat
 func (vk *VK) Messages_CreateChat(ctx context.Context, req Messages_CreateChat_Request, options ...Option) (resp Messages_CreateChat_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12114,6 +17153,36 @@ func (r Messages_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_Delete_Request) Validate() (err error) {
+	if r.MessageIds != nil {
+		if err = checkMaxItems("message_ids", len(*r.MessageIds), 1000); err != nil {
+			return
+		}
+		for _, v := range *r.MessageIds {
+			if err = checkMinimum("message_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.Cmids != nil {
+		if err = checkMaxItems("cmids", len(*r.Cmids), 100); err != nil {
+			return
+		}
+		for _, v := range *r.Cmids {
+			if err = checkMinimum("cmids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Messages_Delete Deletes one or more messages.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12122,6 +17191,9 @@ func (r Messages_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.delete
 func (vk *VK) Messages_Delete(ctx context.Context, req Messages_Delete_Request, options ...Option) (resp Messages_Delete_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12149,6 +17221,22 @@ func (r Messages_DeleteChatPhoto_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_DeleteChatPhoto_Request) Validate() (err error) {
+	if err = checkMinimum("chat_id", float64(r.ChatId), 0); err != nil {
+		return
+	}
+	if err = checkMaximum("chat_id", float64(r.ChatId), 1e+08); err != nil {
+		return
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_DeleteChatPhoto Deletes a chat's cover picture.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12157,6 +17245,9 @@ func (r Messages_DeleteChatPhoto_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/messages.deleteChatPhoto
 func (vk *VK) Messages_DeleteChatPhoto(ctx context.Context, req Messages_DeleteChatPhoto_Request, options ...Option) (resp Messages_DeleteChatPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12190,6 +17281,16 @@ func (r Messages_DeleteConversation_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_DeleteConversation_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_DeleteConversation Deletes all private messages in a conversation.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12198,6 +17299,9 @@ func (r Messages_DeleteConversation_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/messages.deleteConversation
 func (vk *VK) Messages_DeleteConversation(ctx context.Context, req Messages_DeleteConversation_Request, options ...Option) (resp Messages_DeleteConversation_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12219,6 +17323,14 @@ func (r Messages_DenyMessagesFromGroup_Request) fillIn(values url.Values) (err e
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_DenyMessagesFromGroup_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Messages_DenyMessagesFromGroup Denies sending message from community to the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -12226,6 +17338,9 @@ func (r Messages_DenyMessagesFromGroup_Request) fillIn(values url.Values) (err e
 //
 // https://dev.vk.com/method/messages.denyMessagesFromGroup
 func (vk *VK) Messages_DenyMessagesFromGroup(ctx context.Context, req Messages_DenyMessagesFromGroup_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12314,6 +17429,31 @@ func (r Messages_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_Edit_Request) Validate() (err error) {
+	if r.Message != nil {
+		if err = checkMaxLength("message", *r.Message, 9000); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.MessageId != nil {
+		if err = checkMinimum("message_id", float64(*r.MessageId), 0); err != nil {
+			return
+		}
+	}
+	if r.ConversationMessageId != nil {
+		if err = checkMinimum("conversation_message_id", float64(*r.ConversationMessageId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_Edit Edits the message.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12322,6 +17462,9 @@ func (r Messages_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.edit
 func (vk *VK) Messages_Edit(ctx context.Context, req Messages_Edit_Request, options ...Option) (resp Messages_Edit_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 16+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12348,6 +17491,17 @@ func (r Messages_EditChat_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_EditChat_Request) Validate() (err error) {
+	if err = checkMinimum("chat_id", float64(r.ChatId), 0); err != nil {
+		return
+	}
+	if err = checkMaximum("chat_id", float64(r.ChatId), 1e+08); err != nil {
+		return
+	}
+	return
+}
+
 // Messages_EditChat Edits the title of a chat.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12356,6 +17510,9 @@ func (r Messages_EditChat_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.editChat
 func (vk *VK) Messages_EditChat(ctx context.Context, req Messages_EditChat_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12396,6 +17553,33 @@ func (r Messages_GetByConversationMessageId_Request) fillIn(values url.Values) (
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetByConversationMessageId_Request) Validate() (err error) {
+	if r.ConversationMessageIds != nil {
+		if err = checkMaxItems("conversation_message_ids", len(*r.ConversationMessageIds), 100); err != nil {
+			return
+		}
+		for _, v := range *r.ConversationMessageIds {
+			if err = checkMinimum("conversation_message_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetByConversationMessageId Returns messages by their IDs within the conversation.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12403,6 +17587,9 @@ func (r Messages_GetByConversationMessageId_Request) fillIn(values url.Values) (
 //
 // https://dev.vk.com/method/messages.getByConversationMessageId
 func (vk *VK) Messages_GetByConversationMessageId(ctx context.Context, req Messages_GetByConversationMessageId_Request, options ...Option) (resp Messages_GetByConversationMessageId_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12420,6 +17607,9 @@ func (vk *VK) Messages_GetByConversationMessageId(ctx context.Context, req Messa
 //
 // https://dev.vk.com/method/messages.getByConversationMessageId
 func (vk *VK) Messages_GetByConversationMessageIdExtended(ctx context.Context, req Messages_GetByConversationMessageId_Request, options ...Option) (resp Messages_GetByConversationMessageIdExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12451,17 +17641,49 @@ func (r Messages_GetById_Request) fillIn(values url.Values) (err error) {
 		setInts(values, "message_ids", *r.MessageIds)
 	}
 	if r.PreviewLength != nil {
-		setInt(values, "preview_length", *r.PreviewLength)
+		setInt(values, "preview_length", *r.PreviewLength)
+	}
+	if r.Fields != nil {
+		vs := make([]string, len(*r.Fields))
+		for i, v := range *r.Fields {
+			vs[i] = string(v)
+		}
+		setStrings(values, "fields", vs)
+	}
+	if r.GroupId != nil {
+		setInt(values, "group_id", *r.GroupId)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetById_Request) Validate() (err error) {
+	if r.MessageIds != nil {
+		if err = checkMaxItems("message_ids", len(*r.MessageIds), 100); err != nil {
+			return
+		}
+		for _, v := range *r.MessageIds {
+			if err = checkMinimum("message_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.PreviewLength != nil {
+		if err = checkMinimum("preview_length", float64(*r.PreviewLength), 0); err != nil {
+			return
+		}
 	}
 	if r.Fields != nil {
-		vs := make([]string, len(*r.Fields))
-		for i, v := range *r.Fields {
-			vs[i] = string(v)
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
 		}
-		setStrings(values, "fields", vs)
 	}
 	if r.GroupId != nil {
-		setInt(values, "group_id", *r.GroupId)
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -12473,6 +17695,9 @@ func (r Messages_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.getById
 func (vk *VK) Messages_GetById(ctx context.Context, req Messages_GetById_Request, options ...Option) (resp Messages_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12490,6 +17715,9 @@ func (vk *VK) Messages_GetById(ctx context.Context, req Messages_GetById_Request
 //
 // https://dev.vk.com/method/messages.getById
 func (vk *VK) Messages_GetByIdExtended(ctx context.Context, req Messages_GetById_Request, options ...Option) (resp Messages_GetByIdExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12525,6 +17753,23 @@ func (r Messages_GetChatPreview_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetChatPreview_Request) Validate() (err error) {
+	if r.PeerId != nil {
+		if err = checkMinimum("peer_id", float64(*r.PeerId), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Messages_GetChatPreview ...
 // May execute with listed access token types:
 //    [ user ]
@@ -12533,6 +17778,9 @@ func (r Messages_GetChatPreview_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.getChatPreview
 func (vk *VK) Messages_GetChatPreview(ctx context.Context, req Messages_GetChatPreview_Request, options ...Option) (resp Messages_GetChatPreview_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12568,6 +17816,23 @@ func (r Messages_GetConversationMembers_Request) fillIn(values url.Values) (err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetConversationMembers_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetConversationMembers Returns a list of IDs of users participating in a chat.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12576,6 +17841,9 @@ func (r Messages_GetConversationMembers_Request) fillIn(values url.Values) (err
 //
 // https://dev.vk.com/method/messages.getConversationMembers
 func (vk *VK) Messages_GetConversationMembers(ctx context.Context, req Messages_GetConversationMembers_Request, options ...Option) (resp Messages_GetConversationMembers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12649,6 +17917,46 @@ func (r Messages_GetConversations_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetConversations_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Filter != nil {
+		if err = checkStringEnum("filter", string(*r.Filter), "all", "archive", "important", "unanswered", "unread"); err != nil {
+			return
+		}
+	}
+	if r.StartMessageId != nil {
+		if err = checkMinimum("start_message_id", float64(*r.StartMessageId), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetConversations Returns a list of the current user's conversations.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12657,6 +17965,9 @@ func (r Messages_GetConversations_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/messages.getConversations
 func (vk *VK) Messages_GetConversations(ctx context.Context, req Messages_GetConversations_Request, options ...Option) (resp Messages_GetConversations_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12693,6 +18004,28 @@ func (r Messages_GetConversationsById_Request) fillIn(values url.Values) (err er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetConversationsById_Request) Validate() (err error) {
+	if r.PeerIds != nil {
+		if err = checkMaxItems("peer_ids", len(*r.PeerIds), 100); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetConversationsById Returns conversations by their IDs
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12701,6 +18034,9 @@ func (r Messages_GetConversationsById_Request) fillIn(values url.Values) (err er
 //
 // https://dev.vk.com/method/messages.getConversationsById
 func (vk *VK) Messages_GetConversationsById(ctx context.Context, req Messages_GetConversationsById_Request, options ...Option) (resp Messages_GetConversationsById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12719,6 +18055,9 @@ func (vk *VK) Messages_GetConversationsById(ctx context.Context, req Messages_Ge
 //
 // https://dev.vk.com/method/messages.getConversationsById
 func (vk *VK) Messages_GetConversationsByIdExtended(ctx context.Context, req Messages_GetConversationsById_Request, options ...Option) (resp Messages_GetConversationsByIdExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12791,6 +18130,36 @@ func (r Messages_GetHistory_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetHistory_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Rev != nil {
+		if err = checkIntEnum("rev", int(*r.Rev), 1, 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetHistory Returns message history for the specified user or group chat.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12799,6 +18168,9 @@ func (r Messages_GetHistory_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.getHistory
 func (vk *VK) Messages_GetHistory(ctx context.Context, req Messages_GetHistory_Request, options ...Option) (resp Messages_GetHistory_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12817,6 +18189,9 @@ func (vk *VK) Messages_GetHistory(ctx context.Context, req Messages_GetHistory_R
 //
 // https://dev.vk.com/method/messages.getHistory
 func (vk *VK) Messages_GetHistoryExtended(ctx context.Context, req Messages_GetHistory_Request, options ...Option) (resp Messages_GetHistoryExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12902,6 +18277,44 @@ func (r Messages_GetHistoryAttachments_Request) fillIn(values url.Values) (err e
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetHistoryAttachments_Request) Validate() (err error) {
+	if r.MediaType != nil {
+		if err = checkStringEnum("media_type", string(*r.MediaType), "audio", "audio_message", "doc", "graffiti", "link", "market", "photo", "share", "video", "wall"); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.MaxForwardsLevel != nil {
+		if err = checkMinimum("max_forwards_level", float64(*r.MaxForwardsLevel), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("max_forwards_level", float64(*r.MaxForwardsLevel), 45); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetHistoryAttachments Returns media files from the dialog or group chat.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12909,6 +18322,9 @@ func (r Messages_GetHistoryAttachments_Request) fillIn(values url.Values) (err e
 //
 // https://dev.vk.com/method/messages.getHistoryAttachments
 func (vk *VK) Messages_GetHistoryAttachments(ctx context.Context, req Messages_GetHistoryAttachments_Request, options ...Option) (resp Messages_GetHistoryAttachments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12964,6 +18380,46 @@ func (r Messages_GetImportantMessages_Request) fillIn(values url.Values) (err er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetImportantMessages_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.StartMessageId != nil {
+		if err = checkMinimum("start_message_id", float64(*r.StartMessageId), 0); err != nil {
+			return
+		}
+	}
+	if r.PreviewLength != nil {
+		if err = checkMinimum("preview_length", float64(*r.PreviewLength), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetImportantMessages Returns a list of user's important messages.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -12971,6 +18427,9 @@ func (r Messages_GetImportantMessages_Request) fillIn(values url.Values) (err er
 //
 // https://dev.vk.com/method/messages.getImportantMessages
 func (vk *VK) Messages_GetImportantMessages(ctx context.Context, req Messages_GetImportantMessages_Request, options ...Option) (resp Messages_GetImportantMessages_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -12988,6 +18447,9 @@ func (vk *VK) Messages_GetImportantMessages(ctx context.Context, req Messages_Ge
 //
 // https://dev.vk.com/method/messages.getImportantMessages
 func (vk *VK) Messages_GetImportantMessagesExtended(ctx context.Context, req Messages_GetImportantMessages_Request, options ...Option) (resp Messages_GetImportantMessagesExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13046,6 +18508,38 @@ func (r Messages_GetIntentUsers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetIntentUsers_Request) Validate() (err error) {
+	if err = checkRequired("intent", r.Intent != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("intent", string(r.Intent), "confirmed_notification", "non_promo_newsletter", "promo_newsletter"); err != nil {
+		return
+	}
+	if r.SubscribeId != nil {
+		if err = checkMinimum("subscribe_id", float64(*r.SubscribeId), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("subscribe_id", float64(*r.SubscribeId), 100); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetIntentUsers ...
 // May execute with listed access token types:
 //    [ group ]
@@ -13054,6 +18548,9 @@ func (r Messages_GetIntentUsers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.getIntentUsers
 func (vk *VK) Messages_GetIntentUsers(ctx context.Context, req Messages_GetIntentUsers_Request, options ...Option) (resp Messages_GetIntentUsers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13087,6 +18584,19 @@ func (r Messages_GetInviteLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetInviteLink_Request) Validate() (err error) {
+	if err = checkMinimum("peer_id", float64(r.PeerId), 0); err != nil {
+		return
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetInviteLink ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13095,6 +18605,9 @@ func (r Messages_GetInviteLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.getInviteLink
 func (vk *VK) Messages_GetInviteLink(ctx context.Context, req Messages_GetInviteLink_Request, options ...Option) (resp Messages_GetInviteLink_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13115,6 +18628,11 @@ func (r Messages_GetLastActivity_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetLastActivity_Request) Validate() (err error) {
+	return
+}
+
 // Messages_GetLastActivity Returns a user's current status and date of last activity.
 // May execute with listed access token types:
 //    [ user ]
@@ -13122,6 +18640,9 @@ func (r Messages_GetLastActivity_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/messages.getLastActivity
 func (vk *VK) Messages_GetLastActivity(ctx context.Context, req Messages_GetLastActivity_Request, options ...Option) (resp Messages_GetLastActivity_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13218,6 +18739,66 @@ func (r Messages_GetLongPollHistory_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetLongPollHistory_Request) Validate() (err error) {
+	if r.Ts != nil {
+		if err = checkMinimum("ts", float64(*r.Ts), 0); err != nil {
+			return
+		}
+	}
+	if r.Pts != nil {
+		if err = checkMinimum("pts", float64(*r.Pts), 0); err != nil {
+			return
+		}
+	}
+	if r.PreviewLength != nil {
+		if err = checkMinimum("preview_length", float64(*r.PreviewLength), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.EventsLimit != nil {
+		if err = checkMinimum("events_limit", float64(*r.EventsLimit), 1000); err != nil {
+			return
+		}
+	}
+	if r.MsgsLimit != nil {
+		if err = checkMinimum("msgs_limit", float64(*r.MsgsLimit), 200); err != nil {
+			return
+		}
+	}
+	if r.MaxMsgId != nil {
+		if err = checkMinimum("max_msg_id", float64(*r.MaxMsgId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.LpVersion != nil {
+		if err = checkMinimum("lp_version", float64(*r.LpVersion), 0); err != nil {
+			return
+		}
+	}
+	if r.LastN != nil {
+		if err = checkMinimum("last_n", float64(*r.LastN), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("last_n", float64(*r.LastN), 2000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetLongPollHistory Returns updates in user's private messages.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13226,6 +18807,9 @@ func (r Messages_GetLongPollHistory_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/messages.getLongPollHistory
 func (vk *VK) Messages_GetLongPollHistory(ctx context.Context, req Messages_GetLongPollHistory_Request, options ...Option) (resp Messages_GetLongPollHistory_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 15+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13261,6 +18845,21 @@ func (r Messages_GetLongPollServer_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_GetLongPollServer_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.LpVersion != nil {
+		if err = checkMinimum("lp_version", float64(*r.LpVersion), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_GetLongPollServer Returns data required for connection to a Long Poll server.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13268,6 +18867,9 @@ func (r Messages_GetLongPollServer_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/messages.getLongPollServer
 func (vk *VK) Messages_GetLongPollServer(ctx context.Context, req Messages_GetLongPollServer_Request, options ...Option) (resp Messages_GetLongPollServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13294,6 +18896,17 @@ func (r Messages_IsMessagesFromGroupAllowed_Request) fillIn(values url.Values) (
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_IsMessagesFromGroupAllowed_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Messages_IsMessagesFromGroupAllowed Returns information whether sending messages from the community to current user is allowed.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13302,6 +18915,9 @@ func (r Messages_IsMessagesFromGroupAllowed_Request) fillIn(values url.Values) (
 //
 // https://dev.vk.com/method/messages.isMessagesFromGroupAllowed
 func (vk *VK) Messages_IsMessagesFromGroupAllowed(ctx context.Context, req Messages_IsMessagesFromGroupAllowed_Request, options ...Option) (resp Messages_IsMessagesFromGroupAllowed_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13321,6 +18937,14 @@ func (r Messages_JoinChatByInviteLink_Request) fillIn(values url.Values) (err er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_JoinChatByInviteLink_Request) Validate() (err error) {
+	if err = checkRequired("link", r.Link != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Messages_JoinChatByInviteLink ...
 // May execute with listed access token types:
 //    [ user ]
@@ -13329,6 +18953,9 @@ func (r Messages_JoinChatByInviteLink_Request) fillIn(values url.Values) (err er
 //
 // https://dev.vk.com/method/messages.joinChatByInviteLink
 func (vk *VK) Messages_JoinChatByInviteLink(ctx context.Context, req Messages_JoinChatByInviteLink_Request, options ...Option) (resp Messages_JoinChatByInviteLink_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13361,6 +18988,16 @@ func (r Messages_MarkAsAnsweredConversation_Request) fillIn(values url.Values) (
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_MarkAsAnsweredConversation_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_MarkAsAnsweredConversation Marks and unmarks conversations as unanswered.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13368,6 +19005,9 @@ func (r Messages_MarkAsAnsweredConversation_Request) fillIn(values url.Values) (
 //
 // https://dev.vk.com/method/messages.markAsAnsweredConversation
 func (vk *VK) Messages_MarkAsAnsweredConversation(ctx context.Context, req Messages_MarkAsAnsweredConversation_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13396,6 +19036,23 @@ func (r Messages_MarkAsImportant_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_MarkAsImportant_Request) Validate() (err error) {
+	if r.MessageIds != nil {
+		for _, v := range *r.MessageIds {
+			if err = checkMinimum("message_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.Important != nil {
+		if err = checkMinimum("important", float64(*r.Important), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_MarkAsImportant Marks and unmarks messages as important (starred).
 // May execute with listed access token types:
 //    [ user ]
@@ -13403,6 +19060,9 @@ func (r Messages_MarkAsImportant_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/messages.markAsImportant
 func (vk *VK) Messages_MarkAsImportant(ctx context.Context, req Messages_MarkAsImportant_Request, options ...Option) (resp Messages_MarkAsImportant_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13435,6 +19095,16 @@ func (r Messages_MarkAsImportantConversation_Request) fillIn(values url.Values)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_MarkAsImportantConversation_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_MarkAsImportantConversation Marks and unmarks conversations as important.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13442,6 +19112,9 @@ func (r Messages_MarkAsImportantConversation_Request) fillIn(values url.Values)
 //
 // https://dev.vk.com/method/messages.markAsImportantConversation
 func (vk *VK) Messages_MarkAsImportantConversation(ctx context.Context, req Messages_MarkAsImportantConversation_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13487,6 +19160,28 @@ func (r Messages_MarkAsRead_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_MarkAsRead_Request) Validate() (err error) {
+	if r.MessageIds != nil {
+		for _, v := range *r.MessageIds {
+			if err = checkMinimum("message_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.StartMessageId != nil {
+		if err = checkMinimum("start_message_id", float64(*r.StartMessageId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_MarkAsRead Marks messages as read.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13494,6 +19189,9 @@ func (r Messages_MarkAsRead_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.markAsRead
 func (vk *VK) Messages_MarkAsRead(ctx context.Context, req Messages_MarkAsRead_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13525,6 +19223,21 @@ func (r Messages_Pin_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_Pin_Request) Validate() (err error) {
+	if r.MessageId != nil {
+		if err = checkMinimum("message_id", float64(*r.MessageId), 0); err != nil {
+			return
+		}
+	}
+	if r.ConversationMessageId != nil {
+		if err = checkMinimum("conversation_message_id", float64(*r.ConversationMessageId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_Pin Pin a message.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13533,6 +19246,9 @@ func (r Messages_Pin_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.pin
 func (vk *VK) Messages_Pin(ctx context.Context, req Messages_Pin_Request, options ...Option) (resp Messages_Pin_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13565,6 +19281,17 @@ func (r Messages_RemoveChatUser_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_RemoveChatUser_Request) Validate() (err error) {
+	if err = checkMinimum("chat_id", float64(r.ChatId), 0); err != nil {
+		return
+	}
+	if err = checkMaximum("chat_id", float64(r.ChatId), 1e+08); err != nil {
+		return
+	}
+	return
+}
+
 // Messages_RemoveChatUser Allows the current user to leave a chat or, if the current user started the chat, allows the user to remove another user from the chat.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13573,6 +19300,9 @@ func (r Messages_RemoveChatUser_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.removeChatUser
 func (vk *VK) Messages_RemoveChatUser(ctx context.Context, req Messages_RemoveChatUser_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13600,6 +19330,19 @@ func (r Messages_Restore_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_Restore_Request) Validate() (err error) {
+	if err = checkMinimum("message_id", float64(r.MessageId), 0); err != nil {
+		return
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_Restore Restores a deleted message.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13607,6 +19350,9 @@ func (r Messages_Restore_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.restore
 func (vk *VK) Messages_Restore(ctx context.Context, req Messages_Restore_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13624,7 +19370,7 @@ type Messages_Search_Request struct {
 	PeerId *int
 	// Date to search message before in Unixtime.
 	//  Minimum: 0
-	Date *int
+	Date *UnixTime
 	// Number of characters after which to truncate a previewed message. To preview the full message, specify '0'. "NOTE: Messages are not truncated by default. Messages are truncated by words."
 	//  Default: 0
 	//  Minimum: 0
@@ -13653,7 +19399,7 @@ func (r Messages_Search_Request) fillIn(values url.Values) (err error) {
 		setInt(values, "peer_id", *r.PeerId)
 	}
 	if r.Date != nil {
-		setInt(values, "date", *r.Date)
+		setInt(values, "date", int(*r.Date))
 	}
 	if r.PreviewLength != nil {
 		setInt(values, "preview_length", *r.PreviewLength)
@@ -13673,6 +19419,44 @@ func (r Messages_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_Search_Request) Validate() (err error) {
+	if r.Q != nil {
+		if err = checkMaxLength("q", *r.Q, 9000); err != nil {
+			return
+		}
+	}
+	if r.Date != nil {
+		if err = checkMinimum("date", float64(*r.Date), 0); err != nil {
+			return
+		}
+	}
+	if r.PreviewLength != nil {
+		if err = checkMinimum("preview_length", float64(*r.PreviewLength), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_Search Returns a list of the current user's private messages that match search criteria.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13680,6 +19464,9 @@ func (r Messages_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.search
 func (vk *VK) Messages_Search(ctx context.Context, req Messages_Search_Request, options ...Option) (resp Messages_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13697,6 +19484,9 @@ func (vk *VK) Messages_Search(ctx context.Context, req Messages_Search_Request,
 //
 // https://dev.vk.com/method/messages.search
 func (vk *VK) Messages_SearchExtended(ctx context.Context, req Messages_Search_Request, options ...Option) (resp Messages_SearchExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13742,6 +19532,31 @@ func (r Messages_SearchConversations_Request) fillIn(values url.Values) (err err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_SearchConversations_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 255); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_SearchConversations Returns a list of the current user's conversations that match search criteria.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13749,6 +19564,9 @@ func (r Messages_SearchConversations_Request) fillIn(values url.Values) (err err
 //
 // https://dev.vk.com/method/messages.searchConversations
 func (vk *VK) Messages_SearchConversations(ctx context.Context, req Messages_SearchConversations_Request, options ...Option) (resp Messages_SearchConversations_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13766,6 +19584,9 @@ func (vk *VK) Messages_SearchConversations(ctx context.Context, req Messages_Sea
 //
 // https://dev.vk.com/method/messages.searchConversations
 func (vk *VK) Messages_SearchConversationsExtended(ctx context.Context, req Messages_SearchConversations_Request, options ...Option) (resp Messages_SearchConversationsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -13928,6 +19749,67 @@ func (r Messages_Send_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_Send_Request) Validate() (err error) {
+	if r.ChatId != nil {
+		if err = checkMinimum("chat_id", float64(*r.ChatId), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("chat_id", float64(*r.ChatId), 1e+08); err != nil {
+			return
+		}
+	}
+	if r.UserIds != nil {
+		if err = checkMaxItems("user_ids", len(*r.UserIds), 100); err != nil {
+			return
+		}
+	}
+	if r.Message != nil {
+		if err = checkMaxLength("message", *r.Message, 9000); err != nil {
+			return
+		}
+	}
+	if r.Attachment != nil {
+		if err = checkMaxLength("attachment", *r.Attachment, 9000); err != nil {
+			return
+		}
+	}
+	if r.ForwardMessages != nil {
+		if err = checkMaxItems("forward_messages", len(*r.ForwardMessages), 1000); err != nil {
+			return
+		}
+	}
+	if r.StickerId != nil {
+		if err = checkMinimum("sticker_id", float64(*r.StickerId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.Payload != nil {
+		if err = checkMaxLength("payload", *r.Payload, 1000); err != nil {
+			return
+		}
+	}
+	if r.Intent != nil {
+		if err = checkStringEnum("intent", string(*r.Intent), "account_update", "bot_ad_invite", "bot_ad_promo", "confirmed_notification", "customer_support", "default", "game_notification", "moderated_newsletter", "non_promo_newsletter", "promo_newsletter", "purchase_update"); err != nil {
+			return
+		}
+	}
+	if r.SubscribeId != nil {
+		if err = checkMinimum("subscribe_id", float64(*r.SubscribeId), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("subscribe_id", float64(*r.SubscribeId), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_Send Sends a message.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -13936,6 +19818,9 @@ func (r Messages_Send_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.send
 func (vk *VK) Messages_Send(ctx context.Context, req Messages_Send_Request, options ...Option) (resp Messages_Send_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 25+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14085,14 +19970,80 @@ func (r Messages_SendUserIDs_Request) fillIn(values url.Values) (err error) {
 	if r.DontParseLinks != nil {
 		setBool(values, "dont_parse_links", *r.DontParseLinks)
 	}
-	if r.DisableMentions != nil {
-		setBool(values, "disable_mentions", *r.DisableMentions)
+	if r.DisableMentions != nil {
+		setBool(values, "disable_mentions", *r.DisableMentions)
+	}
+	if r.Intent != nil {
+		setString(values, "intent", string(*r.Intent))
+	}
+	if r.SubscribeId != nil {
+		setInt(values, "subscribe_id", *r.SubscribeId)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Messages_SendUserIDs_Request) Validate() (err error) {
+	if r.PeerIds != nil {
+		if err = checkMaxItems("peer_ids", len(*r.PeerIds), 100); err != nil {
+			return
+		}
+	}
+	if r.ChatId != nil {
+		if err = checkMinimum("chat_id", float64(*r.ChatId), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("chat_id", float64(*r.ChatId), 1e+08); err != nil {
+			return
+		}
+	}
+	if r.UserIds != nil {
+		if err = checkMaxItems("user_ids", len(*r.UserIds), 100); err != nil {
+			return
+		}
+	}
+	if r.Message != nil {
+		if err = checkMaxLength("message", *r.Message, 9000); err != nil {
+			return
+		}
+	}
+	if r.Attachment != nil {
+		if err = checkMaxLength("attachment", *r.Attachment, 9000); err != nil {
+			return
+		}
+	}
+	if r.ForwardMessages != nil {
+		if err = checkMaxItems("forward_messages", len(*r.ForwardMessages), 1000); err != nil {
+			return
+		}
+	}
+	if r.StickerId != nil {
+		if err = checkMinimum("sticker_id", float64(*r.StickerId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.Payload != nil {
+		if err = checkMaxLength("payload", *r.Payload, 1000); err != nil {
+			return
+		}
 	}
 	if r.Intent != nil {
-		setString(values, "intent", string(*r.Intent))
+		if err = checkStringEnum("intent", string(*r.Intent), "account_update", "bot_ad_invite", "bot_ad_promo", "confirmed_notification", "customer_support", "default", "game_notification", "moderated_newsletter", "non_promo_newsletter", "promo_newsletter", "purchase_update"); err != nil {
+			return
+		}
 	}
 	if r.SubscribeId != nil {
-		setInt(values, "subscribe_id", *r.SubscribeId)
+		if err = checkMinimum("subscribe_id", float64(*r.SubscribeId), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("subscribe_id", float64(*r.SubscribeId), 100); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -14105,6 +20056,9 @@ func (r Messages_SendUserIDs_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.send
 func (vk *VK) Messages_SendUserIDs(ctx context.Context, req Messages_SendUserIDs_Request, options ...Option) (resp Messages_SendUserIds_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 25+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14133,6 +20087,19 @@ func (r Messages_SendMessageEventAnswer_Request) fillIn(values url.Values) (err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_SendMessageEventAnswer_Request) Validate() (err error) {
+	if err = checkRequired("event_id", r.EventId != ""); err != nil {
+		return
+	}
+	if r.EventData != nil {
+		if err = checkMaxLength("event_data", *r.EventData, 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_SendMessageEventAnswer ...
 // May execute with listed access token types:
 //    [ group ]
@@ -14140,6 +20107,9 @@ func (r Messages_SendMessageEventAnswer_Request) fillIn(values url.Values) (err
 //
 // https://dev.vk.com/method/messages.sendMessageEventAnswer
 func (vk *VK) Messages_SendMessageEventAnswer(ctx context.Context, req Messages_SendMessageEventAnswer_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14189,6 +20159,21 @@ func (r Messages_SetActivity_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_SetActivity_Request) Validate() (err error) {
+	if r.Type != nil {
+		if err = checkStringEnum("type", string(*r.Type), "audiomessage", "file", "photo", "typing", "video"); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_SetActivity Changes the status of a user as typing in a conversation.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -14197,6 +20182,9 @@ func (r Messages_SetActivity_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.setActivity
 func (vk *VK) Messages_SetActivity(ctx context.Context, req Messages_SetActivity_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14216,6 +20204,14 @@ func (r Messages_SetChatPhoto_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_SetChatPhoto_Request) Validate() (err error) {
+	if err = checkRequired("file", r.File != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Messages_SetChatPhoto Sets a previously-uploaded picture as the cover picture of a chat.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -14224,6 +20220,9 @@ func (r Messages_SetChatPhoto_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.setChatPhoto
 func (vk *VK) Messages_SetChatPhoto(ctx context.Context, req Messages_SetChatPhoto_Request, options ...Option) (resp Messages_SetChatPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14248,6 +20247,16 @@ func (r Messages_Unpin_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Messages_Unpin_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Messages_Unpin ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -14256,6 +20265,9 @@ func (r Messages_Unpin_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/messages.unpin
 func (vk *VK) Messages_Unpin(ctx context.Context, req Messages_Unpin_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14280,6 +20292,11 @@ func (r Newsfeed_AddBan_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_AddBan_Request) Validate() (err error) {
+	return
+}
+
 // Newsfeed_AddBan Prevents news from specified users and communities from appearing in the current user's newsfeed.
 // May execute with listed access token types:
 //    [ user ]
@@ -14287,6 +20304,9 @@ func (r Newsfeed_AddBan_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.addBan
 func (vk *VK) Newsfeed_AddBan(ctx context.Context, req Newsfeed_AddBan_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14313,6 +20333,25 @@ func (r Newsfeed_DeleteBan_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_DeleteBan_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.GroupIds != nil {
+		for _, v := range *r.GroupIds {
+			if err = checkMinimum("group_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Newsfeed_DeleteBan Allows news from previously banned users and communities to be shown in the current user's newsfeed.
 // May execute with listed access token types:
 //    [ user ]
@@ -14320,6 +20359,9 @@ func (r Newsfeed_DeleteBan_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.deleteBan
 func (vk *VK) Newsfeed_DeleteBan(ctx context.Context, req Newsfeed_DeleteBan_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14339,6 +20381,14 @@ func (r Newsfeed_DeleteList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_DeleteList_Request) Validate() (err error) {
+	if err = checkMinimum("list_id", float64(r.ListId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Newsfeed_DeleteList ...
 // May execute with listed access token types:
 //    [ user ]
@@ -14346,6 +20396,9 @@ func (r Newsfeed_DeleteList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.deleteList
 func (vk *VK) Newsfeed_DeleteList(ctx context.Context, req Newsfeed_DeleteList_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14361,10 +20414,10 @@ type Newsfeed_Get_Request struct {
 	ReturnBanned *bool
 	// Earliest timestamp (in Unix time) of a news item to return. By default, 24 hours ago.
 	//  Minimum: 0
-	StartTime *int
+	StartTime *UnixTime
 	// Latest timestamp (in Unix time) of a news item to return. By default, the current time.
 	//  Minimum: 0
-	EndTime *int
+	EndTime *UnixTime
 	// Maximum number of photos to return. By default, '5'.
 	//  Minimum: 0
 	MaxPhotos *int
@@ -14391,10 +20444,10 @@ func (r Newsfeed_Get_Request) fillIn(values url.Values) (err error) {
 		setBool(values, "return_banned", *r.ReturnBanned)
 	}
 	if r.StartTime != nil {
-		setInt(values, "start_time", *r.StartTime)
+		setInt(values, "start_time", int(*r.StartTime))
 	}
 	if r.EndTime != nil {
-		setInt(values, "end_time", *r.EndTime)
+		setInt(values, "end_time", int(*r.EndTime))
 	}
 	if r.MaxPhotos != nil {
 		setInt(values, "max_photos", *r.MaxPhotos)
@@ -14421,6 +20474,45 @@ func (r Newsfeed_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_Get_Request) Validate() (err error) {
+	if r.Filters != nil {
+		for _, v := range *r.Filters {
+			if err = checkStringEnum("filters", string(v), "post", "photo", "photo_tag", "wall_photo", "friend", "audio", "video", "topic", "digest", "stories", "note", "audio_playlist", "clip"); err != nil {
+				return
+			}
+		}
+	}
+	if r.StartTime != nil {
+		if err = checkMinimum("start_time", float64(*r.StartTime), 0); err != nil {
+			return
+		}
+	}
+	if r.EndTime != nil {
+		if err = checkMinimum("end_time", float64(*r.EndTime), 0); err != nil {
+			return
+		}
+	}
+	if r.MaxPhotos != nil {
+		if err = checkMinimum("max_photos", float64(*r.MaxPhotos), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Newsfeed_Get Returns data required to show newsfeed for the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -14428,6 +20520,9 @@ func (r Newsfeed_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.get
 func (vk *VK) Newsfeed_Get(ctx context.Context, req Newsfeed_Get_Request, options ...Option) (resp Newsfeed_Generic_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14468,6 +20563,23 @@ func (r Newsfeed_GetBanned_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_GetBanned_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Newsfeed_GetBanned Returns a list of users and communities banned from the current user's newsfeed.
 // May execute with listed access token types:
 //    [ user ]
@@ -14475,6 +20587,9 @@ func (r Newsfeed_GetBanned_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.getBanned
 func (vk *VK) Newsfeed_GetBanned(ctx context.Context, req Newsfeed_GetBanned_Request, options ...Option) (resp Newsfeed_GetBanned_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14492,6 +20607,9 @@ func (vk *VK) Newsfeed_GetBanned(ctx context.Context, req Newsfeed_GetBanned_Req
 //
 // https://dev.vk.com/method/newsfeed.getBanned
 func (vk *VK) Newsfeed_GetBannedExtended(ctx context.Context, req Newsfeed_GetBanned_Request, options ...Option) (resp Newsfeed_GetBannedExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14513,10 +20631,10 @@ type Newsfeed_GetComments_Request struct {
 	Reposts *string
 	// Earliest timestamp (in Unix time) of a comment to return. By default, 24 hours ago.
 	//  Minimum: 0
-	StartTime *int
+	StartTime *UnixTime
 	// Latest timestamp (in Unix time) of a comment to return. By default, the current time.
 	//  Minimum: 0
-	EndTime *int
+	EndTime *UnixTime
 	//  Default: 0
 	//  Minimum: 0
 	//  Maximum: 10
@@ -14541,10 +20659,10 @@ func (r Newsfeed_GetComments_Request) fillIn(values url.Values) (err error) {
 		setString(values, "reposts", *r.Reposts)
 	}
 	if r.StartTime != nil {
-		setInt(values, "start_time", *r.StartTime)
+		setInt(values, "start_time", int(*r.StartTime))
 	}
 	if r.EndTime != nil {
-		setInt(values, "end_time", *r.EndTime)
+		setInt(values, "end_time", int(*r.EndTime))
 	}
 	if r.LastCommentsCount != nil {
 		setInt(values, "last_comments_count", *r.LastCommentsCount)
@@ -14562,6 +20680,51 @@ func (r Newsfeed_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_GetComments_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Filters != nil {
+		for _, v := range *r.Filters {
+			if err = checkStringEnum("filters", string(v), "post", "photo", "video", "topic", "note"); err != nil {
+				return
+			}
+		}
+	}
+	if r.StartTime != nil {
+		if err = checkMinimum("start_time", float64(*r.StartTime), 0); err != nil {
+			return
+		}
+	}
+	if r.EndTime != nil {
+		if err = checkMinimum("end_time", float64(*r.EndTime), 0); err != nil {
+			return
+		}
+	}
+	if r.LastCommentsCount != nil {
+		if err = checkMinimum("last_comments_count", float64(*r.LastCommentsCount), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("last_comments_count", float64(*r.LastCommentsCount), 10); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Newsfeed_GetComments Returns a list of comments in the current user's newsfeed.
 // May execute with listed access token types:
 //    [ user ]
@@ -14569,6 +20732,9 @@ func (r Newsfeed_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.getComments
 func (vk *VK) Newsfeed_GetComments(ctx context.Context, req Newsfeed_GetComments_Request, options ...Option) (resp Newsfeed_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14590,6 +20756,18 @@ func (r Newsfeed_GetLists_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_GetLists_Request) Validate() (err error) {
+	if r.ListIds != nil {
+		for _, v := range *r.ListIds {
+			if err = checkMinimum("list_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Newsfeed_GetLists Returns a list of newsfeeds followed by the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -14597,6 +20775,9 @@ func (r Newsfeed_GetLists_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.getLists
 func (vk *VK) Newsfeed_GetLists(ctx context.Context, req Newsfeed_GetLists_Request, options ...Option) (resp Newsfeed_GetLists_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14614,6 +20795,9 @@ func (vk *VK) Newsfeed_GetLists(ctx context.Context, req Newsfeed_GetLists_Reque
 //
 // https://dev.vk.com/method/newsfeed.getLists
 func (vk *VK) Newsfeed_GetListsExtended(ctx context.Context, req Newsfeed_GetLists_Request, options ...Option) (resp Newsfeed_GetListsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14630,10 +20814,10 @@ type Newsfeed_GetMentions_Request struct {
 	OwnerId *int
 	// Earliest timestamp (in Unix time) of a post to return. By default, 24 hours ago.
 	//  Minimum: 0
-	StartTime *int
+	StartTime *UnixTime
 	// Latest timestamp (in Unix time) of a post to return. By default, the current time.
 	//  Minimum: 0
-	EndTime *int
+	EndTime *UnixTime
 	// Offset needed to return a specific subset of posts.
 	//  Minimum: 0
 	Offset *int
@@ -14649,10 +20833,10 @@ func (r Newsfeed_GetMentions_Request) fillIn(values url.Values) (err error) {
 		setInt(values, "owner_id", *r.OwnerId)
 	}
 	if r.StartTime != nil {
-		setInt(values, "start_time", *r.StartTime)
+		setInt(values, "start_time", int(*r.StartTime))
 	}
 	if r.EndTime != nil {
-		setInt(values, "end_time", *r.EndTime)
+		setInt(values, "end_time", int(*r.EndTime))
 	}
 	if r.Offset != nil {
 		setInt(values, "offset", *r.Offset)
@@ -14663,6 +20847,34 @@ func (r Newsfeed_GetMentions_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_GetMentions_Request) Validate() (err error) {
+	if r.StartTime != nil {
+		if err = checkMinimum("start_time", float64(*r.StartTime), 0); err != nil {
+			return
+		}
+	}
+	if r.EndTime != nil {
+		if err = checkMinimum("end_time", float64(*r.EndTime), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 50); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Newsfeed_GetMentions Returns a list of posts on user walls in which the current user is mentioned.
 // May execute with listed access token types:
 //    [ user ]
@@ -14670,6 +20882,9 @@ func (r Newsfeed_GetMentions_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.getMentions
 func (vk *VK) Newsfeed_GetMentions(ctx context.Context, req Newsfeed_GetMentions_Request, options ...Option) (resp Newsfeed_GetMentions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14682,10 +20897,10 @@ func (vk *VK) Newsfeed_GetMentions(ctx context.Context, req Newsfeed_GetMentions
 type Newsfeed_GetRecommended_Request struct {
 	// Earliest timestamp (in Unix time) of a news item to return. By default, 24 hours ago.
 	//  Minimum: 0
-	StartTime *int
+	StartTime *UnixTime
 	// Latest timestamp (in Unix time) of a news item to return. By default, the current time.
 	//  Minimum: 0
-	EndTime *int
+	EndTime *UnixTime
 	// Maximum number of photos to return. By default, '5'.
 	//  Minimum: 0
 	MaxPhotos *int
@@ -14699,10 +20914,10 @@ type Newsfeed_GetRecommended_Request struct {
 
 func (r Newsfeed_GetRecommended_Request) fillIn(values url.Values) (err error) {
 	if r.StartTime != nil {
-		setInt(values, "start_time", *r.StartTime)
+		setInt(values, "start_time", int(*r.StartTime))
 	}
 	if r.EndTime != nil {
-		setInt(values, "end_time", *r.EndTime)
+		setInt(values, "end_time", int(*r.EndTime))
 	}
 	if r.MaxPhotos != nil {
 		setInt(values, "max_photos", *r.MaxPhotos)
@@ -14723,6 +20938,38 @@ func (r Newsfeed_GetRecommended_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_GetRecommended_Request) Validate() (err error) {
+	if r.StartTime != nil {
+		if err = checkMinimum("start_time", float64(*r.StartTime), 0); err != nil {
+			return
+		}
+	}
+	if r.EndTime != nil {
+		if err = checkMinimum("end_time", float64(*r.EndTime), 0); err != nil {
+			return
+		}
+	}
+	if r.MaxPhotos != nil {
+		if err = checkMinimum("max_photos", float64(*r.MaxPhotos), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Newsfeed_GetRecommended , Returns a list of newsfeeds recommended to the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -14730,6 +20977,9 @@ func (r Newsfeed_GetRecommended_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.getRecommended
 func (vk *VK) Newsfeed_GetRecommended(ctx context.Context, req Newsfeed_GetRecommended_Request, options ...Option) (resp Newsfeed_Generic_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14773,6 +21023,31 @@ func (r Newsfeed_GetSuggestedSources_Request) fillIn(values url.Values) (err err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_GetSuggestedSources_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Newsfeed_GetSuggestedSources Returns communities and users that current user is suggested to follow.
 // May execute with listed access token types:
 //    [ user ]
@@ -14780,6 +21055,9 @@ func (r Newsfeed_GetSuggestedSources_Request) fillIn(values url.Values) (err err
 //
 // https://dev.vk.com/method/newsfeed.getSuggestedSources
 func (vk *VK) Newsfeed_GetSuggestedSources(ctx context.Context, req Newsfeed_GetSuggestedSources_Request, options ...Option) (resp Newsfeed_GetSuggestedSources_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14802,13 +21080,29 @@ type Newsfeed_IgnoreItem_Request struct {
 	ItemId *int
 }
 
-func (r Newsfeed_IgnoreItem_Request) fillIn(values url.Values) (err error) {
-	setString(values, "type", string(r.Type))
-	if r.OwnerId != nil {
-		setInt(values, "owner_id", *r.OwnerId)
+func (r Newsfeed_IgnoreItem_Request) fillIn(values url.Values) (err error) {
+	setString(values, "type", string(r.Type))
+	if r.OwnerId != nil {
+		setInt(values, "owner_id", *r.OwnerId)
+	}
+	if r.ItemId != nil {
+		setInt(values, "item_id", *r.ItemId)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_IgnoreItem_Request) Validate() (err error) {
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "wall", "tag", "profilephoto", "video", "photo", "audio"); err != nil {
+		return
 	}
 	if r.ItemId != nil {
-		setInt(values, "item_id", *r.ItemId)
+		if err = checkMinimum("item_id", float64(*r.ItemId), 0); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -14820,6 +21114,9 @@ func (r Newsfeed_IgnoreItem_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.ignoreItem
 func (vk *VK) Newsfeed_IgnoreItem(ctx context.Context, req Newsfeed_IgnoreItem_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14855,6 +21152,19 @@ func (r Newsfeed_SaveList_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_SaveList_Request) Validate() (err error) {
+	if r.ListId != nil {
+		if err = checkMinimum("list_id", float64(*r.ListId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Newsfeed_SaveList Creates and edits user newsfeed lists
 // May execute with listed access token types:
 //    [ user ]
@@ -14863,6 +21173,9 @@ func (r Newsfeed_SaveList_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.saveList
 func (vk *VK) Newsfeed_SaveList(ctx context.Context, req Newsfeed_SaveList_Request, options ...Option) (resp Newsfeed_SaveList_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14886,10 +21199,10 @@ type Newsfeed_Search_Request struct {
 	Longitude *float64
 	// Earliest timestamp (in Unix time) of a news item to return. By default, 24 hours ago.
 	//  Minimum: 0
-	StartTime *int
+	StartTime *UnixTime
 	// Latest timestamp (in Unix time) of a news item to return. By default, the current time.
 	//  Minimum: 0
-	EndTime   *int
+	EndTime   *UnixTime
 	StartFrom *string
 	Fields    *[]Base_UserGroupFields
 }
@@ -14908,10 +21221,10 @@ func (r Newsfeed_Search_Request) fillIn(values url.Values) (err error) {
 		setFloat(values, "longitude", *r.Longitude)
 	}
 	if r.StartTime != nil {
-		setInt(values, "start_time", *r.StartTime)
+		setInt(values, "start_time", int(*r.StartTime))
 	}
 	if r.EndTime != nil {
-		setInt(values, "end_time", *r.EndTime)
+		setInt(values, "end_time", int(*r.EndTime))
 	}
 	if r.StartFrom != nil {
 		setString(values, "start_from", *r.StartFrom)
@@ -14926,6 +21239,36 @@ func (r Newsfeed_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_Search_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.StartTime != nil {
+		if err = checkMinimum("start_time", float64(*r.StartTime), 0); err != nil {
+			return
+		}
+	}
+	if r.EndTime != nil {
+		if err = checkMinimum("end_time", float64(*r.EndTime), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Newsfeed_Search Returns search results by statuses.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -14933,6 +21276,9 @@ func (r Newsfeed_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.search
 func (vk *VK) Newsfeed_Search(ctx context.Context, req Newsfeed_Search_Request, options ...Option) (resp Newsfeed_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14950,6 +21296,9 @@ func (vk *VK) Newsfeed_Search(ctx context.Context, req Newsfeed_Search_Request,
 //
 // https://dev.vk.com/method/newsfeed.search
 func (vk *VK) Newsfeed_SearchExtended(ctx context.Context, req Newsfeed_Search_Request, options ...Option) (resp Newsfeed_SearchExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -14983,6 +21332,20 @@ func (r Newsfeed_UnignoreItem_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_UnignoreItem_Request) Validate() (err error) {
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "wall", "tag", "profilephoto", "video", "photo", "audio"); err != nil {
+		return
+	}
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Newsfeed_UnignoreItem Returns a hidden item to the newsfeed.
 // May execute with listed access token types:
 //    [ user ]
@@ -14990,6 +21353,9 @@ func (r Newsfeed_UnignoreItem_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.unignoreItem
 func (vk *VK) Newsfeed_UnignoreItem(ctx context.Context, req Newsfeed_UnignoreItem_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15029,6 +21395,20 @@ func (r Newsfeed_Unsubscribe_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Newsfeed_Unsubscribe_Request) Validate() (err error) {
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "note", "photo", "post", "topic", "video"); err != nil {
+		return
+	}
+	if err = checkMinimum("item_id", float64(r.ItemId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Newsfeed_Unsubscribe Unsubscribes the current user from specified newsfeeds.
 // May execute with listed access token types:
 //    [ user ]
@@ -15036,6 +21416,9 @@ func (r Newsfeed_Unsubscribe_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/newsfeed.unsubscribe
 func (vk *VK) Newsfeed_Unsubscribe(ctx context.Context, req Newsfeed_Unsubscribe_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15068,6 +21451,17 @@ func (r Notes_Add_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_Add_Request) Validate() (err error) {
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkRequired("text", r.Text != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Notes_Add Creates a new note for the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -15075,6 +21469,9 @@ func (r Notes_Add_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.add
 func (vk *VK) Notes_Add(ctx context.Context, req Notes_Add_Request, options ...Option) (resp Notes_Add_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15115,6 +21512,27 @@ func (r Notes_CreateComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_CreateComment_Request) Validate() (err error) {
+	if err = checkMinimum("note_id", float64(r.NoteId), 0); err != nil {
+		return
+	}
+	if r.OwnerId != nil {
+		if err = checkMinimum("owner_id", float64(*r.OwnerId), 0); err != nil {
+			return
+		}
+	}
+	if r.ReplyTo != nil {
+		if err = checkMinimum("reply_to", float64(*r.ReplyTo), 0); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("message", r.Message != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Notes_CreateComment Adds a new comment on a note.
 // May execute with listed access token types:
 //    [ user ]
@@ -15123,6 +21541,9 @@ func (r Notes_CreateComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.createComment
 func (vk *VK) Notes_CreateComment(ctx context.Context, req Notes_CreateComment_Request, options ...Option) (resp Notes_CreateComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15143,6 +21564,14 @@ func (r Notes_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_Delete_Request) Validate() (err error) {
+	if err = checkMinimum("note_id", float64(r.NoteId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Notes_Delete Deletes a note of the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -15151,6 +21580,9 @@ func (r Notes_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.delete
 func (vk *VK) Notes_Delete(ctx context.Context, req Notes_Delete_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15178,6 +21610,19 @@ func (r Notes_DeleteComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_DeleteComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if r.OwnerId != nil {
+		if err = checkMinimum("owner_id", float64(*r.OwnerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Notes_DeleteComment Deletes a comment on a note.
 // May execute with listed access token types:
 //    [ user ]
@@ -15186,6 +21631,9 @@ func (r Notes_DeleteComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.deleteComment
 func (vk *VK) Notes_DeleteComment(ctx context.Context, req Notes_DeleteComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15222,6 +21670,20 @@ func (r Notes_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("note_id", float64(r.NoteId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkRequired("text", r.Text != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Notes_Edit Edits a note of the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -15230,6 +21692,9 @@ func (r Notes_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.edit
 func (vk *VK) Notes_Edit(ctx context.Context, req Notes_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15261,6 +21726,25 @@ func (r Notes_EditComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_EditComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if r.OwnerId != nil {
+		if err = checkMinimum("owner_id", float64(*r.OwnerId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("message", r.Message != ""); err != nil {
+		return
+	}
+	if err = checkMinLength("message", r.Message, 2); err != nil {
+		return
+	}
+	return
+}
+
 // Notes_EditComment Edits a comment on a note.
 // May execute with listed access token types:
 //    [ user ]
@@ -15269,6 +21753,9 @@ func (r Notes_EditComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.editComment
 func (vk *VK) Notes_EditComment(ctx context.Context, req Notes_EditComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15324,6 +21811,44 @@ func (r Notes_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_Get_Request) Validate() (err error) {
+	if r.NoteIds != nil {
+		for _, v := range *r.NoteIds {
+			if err = checkMinimum("note_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkMinimum("sort", float64(*r.Sort), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("sort", int(*r.Sort), 0, 1); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Notes_Get Returns a list of notes created by a user.
 // May execute with listed access token types:
 //    [ user ]
@@ -15332,6 +21857,9 @@ func (r Notes_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.get
 func (vk *VK) Notes_Get(ctx context.Context, req Notes_Get_Request, options ...Option) (resp Notes_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15364,6 +21892,19 @@ func (r Notes_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_GetById_Request) Validate() (err error) {
+	if err = checkMinimum("note_id", float64(r.NoteId), 0); err != nil {
+		return
+	}
+	if r.OwnerId != nil {
+		if err = checkMinimum("owner_id", float64(*r.OwnerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Notes_GetById Returns a note by its ID.
 // May execute with listed access token types:
 //    [ user ]
@@ -15372,6 +21913,9 @@ func (r Notes_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.getById
 func (vk *VK) Notes_GetById(ctx context.Context, req Notes_GetById_Request, options ...Option) (resp Notes_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15426,6 +21970,40 @@ func (r Notes_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_GetComments_Request) Validate() (err error) {
+	if err = checkMinimum("note_id", float64(r.NoteId), 0); err != nil {
+		return
+	}
+	if r.OwnerId != nil {
+		if err = checkMinimum("owner_id", float64(*r.OwnerId), 0); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkMinimum("sort", float64(*r.Sort), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("sort", int(*r.Sort), 0, 1); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Notes_GetComments Returns a list of comments on a note.
 // May execute with listed access token types:
 //    [ user ]
@@ -15434,6 +22012,9 @@ func (r Notes_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.getComments
 func (vk *VK) Notes_GetComments(ctx context.Context, req Notes_GetComments_Request, options ...Option) (resp Notes_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15461,6 +22042,19 @@ func (r Notes_RestoreComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Notes_RestoreComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if r.OwnerId != nil {
+		if err = checkMinimum("owner_id", float64(*r.OwnerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Notes_RestoreComment Restores a deleted comment on a note.
 // May execute with listed access token types:
 //    [ user ]
@@ -15469,6 +22063,9 @@ func (r Notes_RestoreComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notes.restoreComment
 func (vk *VK) Notes_RestoreComment(ctx context.Context, req Notes_RestoreComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15499,9 +22096,9 @@ type Notifications_Get_Request struct {
 	StartFrom *string
 	Filters   *[]Notifications_Get_Filters
 	// Earliest timestamp (in Unix time) of a notification to return. By default, 24 hours ago.
-	StartTime *int
+	StartTime *UnixTime
 	// Latest timestamp (in Unix time) of a notification to return. By default, the current time.
-	EndTime *int
+	EndTime *UnixTime
 }
 
 func (r Notifications_Get_Request) fillIn(values url.Values) (err error) {
@@ -15519,10 +22116,30 @@ func (r Notifications_Get_Request) fillIn(values url.Values) (err error) {
 		setStrings(values, "filters", vs)
 	}
 	if r.StartTime != nil {
-		setInt(values, "start_time", *r.StartTime)
+		setInt(values, "start_time", int(*r.StartTime))
 	}
 	if r.EndTime != nil {
-		setInt(values, "end_time", *r.EndTime)
+		setInt(values, "end_time", int(*r.EndTime))
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Notifications_Get_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Filters != nil {
+		for _, v := range *r.Filters {
+			if err = checkStringEnum("filters", string(v), "wall", "mentions", "comments", "likes", "reposted", "followers", "friends"); err != nil {
+				return
+			}
+		}
 	}
 	return
 }
@@ -15534,6 +22151,9 @@ func (r Notifications_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/notifications.get
 func (vk *VK) Notifications_Get(ctx context.Context, req Notifications_Get_Request, options ...Option) (resp Notifications_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15584,20 +22204,59 @@ type Notifications_SendMessage_Request struct {
 
 func (r Notifications_SendMessage_Request) fillIn(values url.Values) (err error) {
 	if r.UserIds != nil {
-		setInts(values, "user_ids", *r.UserIds)
+		setInts(values, "user_ids", *r.UserIds)
+	}
+	setString(values, "message", r.Message)
+	if r.Fragment != nil {
+		setString(values, "fragment", *r.Fragment)
+	}
+	if r.GroupId != nil {
+		setInt(values, "group_id", *r.GroupId)
+	}
+	if r.RandomId != nil {
+		setInt(values, "random_id", *r.RandomId)
+	}
+	if r.SendingMode != nil {
+		setString(values, "sending_mode", string(*r.SendingMode))
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Notifications_SendMessage_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		if err = checkMinItems("user_ids", len(*r.UserIds), 1); err != nil {
+			return
+		}
+		if err = checkMaxItems("user_ids", len(*r.UserIds), 100); err != nil {
+			return
+		}
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if err = checkRequired("message", r.Message != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("message", r.Message, 254); err != nil {
+		return
 	}
-	setString(values, "message", r.Message)
 	if r.Fragment != nil {
-		setString(values, "fragment", *r.Fragment)
+		if err = checkMaxLength("fragment", *r.Fragment, 2047); err != nil {
+			return
+		}
 	}
 	if r.GroupId != nil {
-		setInt(values, "group_id", *r.GroupId)
-	}
-	if r.RandomId != nil {
-		setInt(values, "random_id", *r.RandomId)
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
 	}
 	if r.SendingMode != nil {
-		setString(values, "sending_mode", string(*r.SendingMode))
+		if err = checkStringEnum("sending_mode", string(*r.SendingMode), "delayed", "delayed_push", "immediately"); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -15610,6 +22269,9 @@ func (r Notifications_SendMessage_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/notifications.sendMessage
 func (vk *VK) Notifications_SendMessage(ctx context.Context, req Notifications_SendMessage_Request, options ...Option) (resp Notifications_SendMessage_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15638,6 +22300,17 @@ func (r Orders_CancelSubscription_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_CancelSubscription_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("subscription_id", float64(r.SubscriptionId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Orders_CancelSubscription ...
 // May execute with listed access token types:
 //    [ service ]
@@ -15646,6 +22319,9 @@ func (r Orders_CancelSubscription_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/orders.cancelSubscription
 func (vk *VK) Orders_CancelSubscription(ctx context.Context, req Orders_CancelSubscription_Request, options ...Option) (resp Orders_CancelSubscription_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15688,6 +22364,25 @@ func (r Orders_ChangeState_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_ChangeState_Request) Validate() (err error) {
+	if err = checkMinimum("order_id", float64(r.OrderId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("action", r.Action != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("action", string(r.Action), "cancel", "charge", "refund"); err != nil {
+		return
+	}
+	if r.AppOrderId != nil {
+		if err = checkMinimum("app_order_id", float64(*r.AppOrderId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Orders_ChangeState Changes order status.
 // May execute with listed access token types:
 //    [ service ]
@@ -15696,6 +22391,9 @@ func (r Orders_ChangeState_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/orders.changeState
 func (vk *VK) Orders_ChangeState(ctx context.Context, req Orders_ChangeState_Request, options ...Option) (resp Orders_ChangeState_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15731,6 +22429,24 @@ func (r Orders_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_Get_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Orders_Get Returns a list of orders.
 // May execute with listed access token types:
 //    [ service ]
@@ -15738,6 +22454,9 @@ func (r Orders_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/orders.get
 func (vk *VK) Orders_Get(ctx context.Context, req Orders_Get_Request, options ...Option) (resp Orders_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15763,6 +22482,19 @@ func (r Orders_GetAmount_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_GetAmount_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if r.Votes != nil {
+		if err = checkMaxItems("votes", len(*r.Votes), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Orders_GetAmount ...
 // May execute with listed access token types:
 //    [ user ]
@@ -15770,6 +22502,9 @@ func (r Orders_GetAmount_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/orders.getAmount
 func (vk *VK) Orders_GetAmount(ctx context.Context, req Orders_GetAmount_Request, options ...Option) (resp Orders_GetAmount_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15803,6 +22538,26 @@ func (r Orders_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_GetById_Request) Validate() (err error) {
+	if r.OrderId != nil {
+		if err = checkMinimum("order_id", float64(*r.OrderId), 0); err != nil {
+			return
+		}
+	}
+	if r.OrderIds != nil {
+		if err = checkMaxItems("order_ids", len(*r.OrderIds), 500); err != nil {
+			return
+		}
+		for _, v := range *r.OrderIds {
+			if err = checkMinimum("order_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Orders_GetById Returns information about orders by their IDs.
 // May execute with listed access token types:
 //    [ service ]
@@ -15810,6 +22565,9 @@ func (r Orders_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/orders.getById
 func (vk *VK) Orders_GetById(ctx context.Context, req Orders_GetById_Request, options ...Option) (resp Orders_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15833,6 +22591,17 @@ func (r Orders_GetUserSubscriptionById_Request) fillIn(values url.Values) (err e
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_GetUserSubscriptionById_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("subscription_id", float64(r.SubscriptionId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Orders_GetUserSubscriptionById ...
 // May execute with listed access token types:
 //    [ service ]
@@ -15841,6 +22610,9 @@ func (r Orders_GetUserSubscriptionById_Request) fillIn(values url.Values) (err e
 //
 // https://dev.vk.com/method/orders.getUserSubscriptionById
 func (vk *VK) Orders_GetUserSubscriptionById(ctx context.Context, req Orders_GetUserSubscriptionById_Request, options ...Option) (resp Orders_GetUserSubscriptionById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15861,6 +22633,14 @@ func (r Orders_GetUserSubscriptions_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_GetUserSubscriptions_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Orders_GetUserSubscriptions ...
 // May execute with listed access token types:
 //    [ service ]
@@ -15868,6 +22648,9 @@ func (r Orders_GetUserSubscriptions_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/orders.getUserSubscriptions
 func (vk *VK) Orders_GetUserSubscriptions(ctx context.Context, req Orders_GetUserSubscriptions_Request, options ...Option) (resp Orders_GetUserSubscriptions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15894,6 +22677,20 @@ func (r Orders_UpdateSubscription_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Orders_UpdateSubscription_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("subscription_id", float64(r.SubscriptionId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("price", float64(r.Price), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Orders_UpdateSubscription ...
 // May execute with listed access token types:
 //    [ service ]
@@ -15902,6 +22699,9 @@ func (r Orders_UpdateSubscription_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/orders.updateSubscription
 func (vk *VK) Orders_UpdateSubscription(ctx context.Context, req Orders_UpdateSubscription_Request, options ...Option) (resp Orders_UpdateSubscription_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15921,6 +22721,14 @@ func (r Pages_ClearCache_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_ClearCache_Request) Validate() (err error) {
+	if err = checkRequired("url", r.Url != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Pages_ClearCache Allows to clear the cache of particular 'external' pages which may be attached to VK posts.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -15928,6 +22736,9 @@ func (r Pages_ClearCache_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.clearCache
 func (vk *VK) Pages_ClearCache(ctx context.Context, req Pages_ClearCache_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -15979,6 +22790,11 @@ func (r Pages_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_Get_Request) Validate() (err error) {
+	return
+}
+
 // Pages_Get Returns information about a wiki page.
 // May execute with listed access token types:
 //    [ user ]
@@ -15986,6 +22802,9 @@ func (r Pages_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.get
 func (vk *VK) Pages_Get(ctx context.Context, req Pages_Get_Request, options ...Option) (resp Pages_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16016,6 +22835,11 @@ func (r Pages_GetHistory_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_GetHistory_Request) Validate() (err error) {
+	return
+}
+
 // Pages_GetHistory Returns a list of all previous versions of a wiki page.
 // May execute with listed access token types:
 //    [ user ]
@@ -16024,6 +22848,9 @@ func (r Pages_GetHistory_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.getHistory
 func (vk *VK) Pages_GetHistory(ctx context.Context, req Pages_GetHistory_Request, options ...Option) (resp Pages_GetHistory_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16046,6 +22873,11 @@ func (r Pages_GetTitles_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_GetTitles_Request) Validate() (err error) {
+	return
+}
+
 // Pages_GetTitles Returns a list of wiki pages in a group.
 // May execute with listed access token types:
 //    [ user ]
@@ -16054,6 +22886,9 @@ func (r Pages_GetTitles_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.getTitles
 func (vk *VK) Pages_GetTitles(ctx context.Context, req Pages_GetTitles_Request, options ...Option) (resp Pages_GetTitles_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16088,6 +22923,11 @@ func (r Pages_GetVersion_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_GetVersion_Request) Validate() (err error) {
+	return
+}
+
 // Pages_GetVersion Returns the text of one of the previous versions of a wiki page.
 // May execute with listed access token types:
 //    [ user ]
@@ -16096,6 +22936,9 @@ func (r Pages_GetVersion_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.getVersion
 func (vk *VK) Pages_GetVersion(ctx context.Context, req Pages_GetVersion_Request, options ...Option) (resp Pages_GetVersion_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16122,6 +22965,19 @@ func (r Pages_ParseWiki_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_ParseWiki_Request) Validate() (err error) {
+	if err = checkRequired("text", r.Text != ""); err != nil {
+		return
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Pages_ParseWiki Returns HTML representation of the wiki markup.
 // May execute with listed access token types:
 //    [ user ]
@@ -16129,6 +22985,9 @@ func (r Pages_ParseWiki_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.parseWiki
 func (vk *VK) Pages_ParseWiki(ctx context.Context, req Pages_ParseWiki_Request, options ...Option) (resp Pages_ParseWiki_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16172,6 +23031,11 @@ func (r Pages_Save_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_Save_Request) Validate() (err error) {
+	return
+}
+
 // Pages_Save Saves the text of a wiki page.
 // May execute with listed access token types:
 //    [ user ]
@@ -16180,6 +23044,9 @@ func (r Pages_Save_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.save
 func (vk *VK) Pages_Save(ctx context.Context, req Pages_Save_Request, options ...Option) (resp Pages_Save_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16236,6 +23103,21 @@ func (r Pages_SaveAccess_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Pages_SaveAccess_Request) Validate() (err error) {
+	if r.View != nil {
+		if err = checkIntEnum("view", int(*r.View), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Edit != nil {
+		if err = checkIntEnum("edit", int(*r.Edit), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Pages_SaveAccess Saves modified read and edit access settings for a wiki page.
 // May execute with listed access token types:
 //    [ user ]
@@ -16244,6 +23126,9 @@ func (r Pages_SaveAccess_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/pages.saveAccess
 func (vk *VK) Pages_SaveAccess(ctx context.Context, req Pages_SaveAccess_Request, options ...Option) (resp Pages_SaveAccess_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16272,6 +23157,14 @@ func (r Photos_ConfirmTag_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_ConfirmTag_Request) Validate() (err error) {
+	if err = checkRequired("photo_id", r.PhotoId != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_ConfirmTag Confirms a tag on a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -16279,6 +23172,9 @@ func (r Photos_ConfirmTag_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.confirmTag
 func (vk *VK) Photos_ConfirmTag(ctx context.Context, req Photos_ConfirmTag_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16308,6 +23204,14 @@ func (r Photos_Copy_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_Copy_Request) Validate() (err error) {
+	if err = checkMinimum("photo_id", float64(r.PhotoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_Copy Allows to copy a photo to the "Saved photos" album
 // May execute with listed access token types:
 //    [ user ]
@@ -16315,6 +23219,9 @@ func (r Photos_Copy_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.copy
 func (vk *VK) Photos_Copy(ctx context.Context, req Photos_Copy_Request, options ...Option) (resp Photos_Copy_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16364,6 +23271,17 @@ func (r Photos_CreateAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_CreateAlbum_Request) Validate() (err error) {
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkMinLength("title", r.Title, 2); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_CreateAlbum Creates an empty photo album.
 // May execute with listed access token types:
 //    [ user ]
@@ -16372,6 +23290,9 @@ func (r Photos_CreateAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.createAlbum
 func (vk *VK) Photos_CreateAlbum(ctx context.Context, req Photos_CreateAlbum_Request, options ...Option) (resp Photos_CreateAlbum_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16428,6 +23349,16 @@ func (r Photos_CreateComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_CreateComment_Request) Validate() (err error) {
+	if r.StickerId != nil {
+		if err = checkMinimum("sticker_id", float64(*r.StickerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_CreateComment Adds a new comment on the photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -16435,6 +23366,9 @@ func (r Photos_CreateComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.createComment
 func (vk *VK) Photos_CreateComment(ctx context.Context, req Photos_CreateComment_Request, options ...Option) (resp Photos_CreateComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16461,6 +23395,14 @@ func (r Photos_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_Delete_Request) Validate() (err error) {
+	if err = checkMinimum("photo_id", float64(r.PhotoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_Delete Deletes a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -16468,6 +23410,9 @@ func (r Photos_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.delete
 func (vk *VK) Photos_Delete(ctx context.Context, req Photos_Delete_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16495,6 +23440,19 @@ func (r Photos_DeleteAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_DeleteAlbum_Request) Validate() (err error) {
+	if err = checkMinimum("album_id", float64(r.AlbumId), 0); err != nil {
+		return
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_DeleteAlbum Deletes a photo album belonging to the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -16503,6 +23461,9 @@ func (r Photos_DeleteAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.deleteAlbum
 func (vk *VK) Photos_DeleteAlbum(ctx context.Context, req Photos_DeleteAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16528,6 +23489,11 @@ func (r Photos_DeleteComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_DeleteComment_Request) Validate() (err error) {
+	return
+}
+
 // Photos_DeleteComment Deletes a comment on the photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -16535,6 +23501,9 @@ func (r Photos_DeleteComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.deleteComment
 func (vk *VK) Photos_DeleteComment(ctx context.Context, req Photos_DeleteComment_Request, options ...Option) (resp Photos_DeleteComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16586,6 +23555,14 @@ func (r Photos_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("photo_id", float64(r.PhotoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_Edit Edits the caption of a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -16593,6 +23570,9 @@ func (r Photos_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.edit
 func (vk *VK) Photos_Edit(ctx context.Context, req Photos_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16645,6 +23625,14 @@ func (r Photos_EditAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_EditAlbum_Request) Validate() (err error) {
+	if err = checkMinimum("album_id", float64(r.AlbumId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_EditAlbum Edits information about a photo album.
 // May execute with listed access token types:
 //    [ user ]
@@ -16653,6 +23641,9 @@ func (r Photos_EditAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.editAlbum
 func (vk *VK) Photos_EditAlbum(ctx context.Context, req Photos_EditAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16687,6 +23678,11 @@ func (r Photos_EditComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_EditComment_Request) Validate() (err error) {
+	return
+}
+
 // Photos_EditComment Edits a comment on a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -16694,6 +23690,9 @@ func (r Photos_EditComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.editComment
 func (vk *VK) Photos_EditComment(ctx context.Context, req Photos_EditComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16717,7 +23716,7 @@ type Photos_Get_Request struct {
 	// Type of feed obtained in 'feed' field of the method.
 	FeedType *string
 	// unixtime, that can be obtained with [vk.com/dev/newsfeed.get|newsfeed.get] method in date field to get all photos uploaded by the user on a specific day, or photos the user has been tagged on. Also, 'uid' parameter of the user the event happened with shall be specified.
-	Feed *int
+	Feed *UnixTime
 	// '1' — to return photo sizes in a [vk.com/dev/photo_sizes|special format]
 	PhotoSizes *bool
 	//  Minimum: 0
@@ -16748,7 +23747,7 @@ func (r Photos_Get_Request) fillIn(values url.Values) (err error) {
 		setString(values, "feed_type", *r.FeedType)
 	}
 	if r.Feed != nil {
-		setInt(values, "feed", *r.Feed)
+		setInt(values, "feed", int(*r.Feed))
 	}
 	if r.PhotoSizes != nil {
 		setBool(values, "photo_sizes", *r.PhotoSizes)
@@ -16757,7 +23756,25 @@ func (r Photos_Get_Request) fillIn(values url.Values) (err error) {
 		setInt(values, "offset", *r.Offset)
 	}
 	if r.Count != nil {
-		setInt(values, "count", *r.Count)
+		setInt(values, "count", *r.Count)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Photos_Get_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -16769,6 +23786,9 @@ func (r Photos_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.get
 func (vk *VK) Photos_Get(ctx context.Context, req Photos_Get_Request, options ...Option) (resp Photos_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16823,6 +23843,26 @@ func (r Photos_GetAlbums_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetAlbums_Request) Validate() (err error) {
+	if r.AlbumIds != nil {
+		if err = checkMaxItems("album_ids", len(*r.AlbumIds), 1000); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetAlbums Returns a list of a user's or community's photo albums.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -16830,6 +23870,9 @@ func (r Photos_GetAlbums_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getAlbums
 func (vk *VK) Photos_GetAlbums(ctx context.Context, req Photos_GetAlbums_Request, options ...Option) (resp Photos_GetAlbums_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16858,6 +23901,11 @@ func (r Photos_GetAlbumsCount_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetAlbumsCount_Request) Validate() (err error) {
+	return
+}
+
 // Photos_GetAlbumsCount Returns the number of photo albums belonging to a user or community.
 // May execute with listed access token types:
 //    [ user ]
@@ -16865,6 +23913,9 @@ func (r Photos_GetAlbumsCount_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getAlbumsCount
 func (vk *VK) Photos_GetAlbumsCount(ctx context.Context, req Photos_GetAlbumsCount_Request, options ...Option) (resp Photos_GetAlbumsCount_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16921,6 +23972,24 @@ func (r Photos_GetAll_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetAll_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetAll Returns a list of photos belonging to a user or community, in reverse chronological order.
 // May execute with listed access token types:
 //    [ user ]
@@ -16929,6 +23998,9 @@ func (r Photos_GetAll_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getAll
 func (vk *VK) Photos_GetAll(ctx context.Context, req Photos_GetAll_Request, options ...Option) (resp Photos_GetAll_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16947,6 +24019,9 @@ func (vk *VK) Photos_GetAll(ctx context.Context, req Photos_GetAll_Request, opti
 //
 // https://dev.vk.com/method/photos.getAll
 func (vk *VK) Photos_GetAllExtended(ctx context.Context, req Photos_GetAll_Request, options ...Option) (resp Photos_GetAllExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -16993,6 +24068,26 @@ func (r Photos_GetAllComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetAllComments_Request) Validate() (err error) {
+	if r.AlbumId != nil {
+		if err = checkMinimum("album_id", float64(*r.AlbumId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetAllComments Returns a list of comments on a specific photo album or all albums of the user sorted in reverse chronological order.
 // May execute with listed access token types:
 //    [ user ]
@@ -17001,6 +24096,9 @@ func (r Photos_GetAllComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getAllComments
 func (vk *VK) Photos_GetAllComments(ctx context.Context, req Photos_GetAllComments_Request, options ...Option) (resp Photos_GetAllComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17032,6 +24130,16 @@ func (r Photos_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetById_Request) Validate() (err error) {
+	if r.Photos != nil {
+		if err = checkMaxItems("photos", len(*r.Photos), 500); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetById Returns information about photos by their IDs.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -17039,6 +24147,9 @@ func (r Photos_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getById
 func (vk *VK) Photos_GetById(ctx context.Context, req Photos_GetById_Request, options ...Option) (resp Photos_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17075,6 +24186,29 @@ func (r Photos_GetChatUploadServer_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetChatUploadServer_Request) Validate() (err error) {
+	if err = checkMinimum("chat_id", float64(r.ChatId), 0); err != nil {
+		return
+	}
+	if r.CropX != nil {
+		if err = checkMinimum("crop_x", float64(*r.CropX), 0); err != nil {
+			return
+		}
+	}
+	if r.CropY != nil {
+		if err = checkMinimum("crop_y", float64(*r.CropY), 0); err != nil {
+			return
+		}
+	}
+	if r.CropWidth != nil {
+		if err = checkMinimum("crop_width", float64(*r.CropWidth), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetChatUploadServer Returns an upload link for chat cover pictures.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -17082,6 +24216,9 @@ func (r Photos_GetChatUploadServer_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/photos.getChatUploadServer
 func (vk *VK) Photos_GetChatUploadServer(ctx context.Context, req Photos_GetChatUploadServer_Request, options ...Option) (resp Base_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17154,6 +24291,36 @@ func (r Photos_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetComments_Request) Validate() (err error) {
+	if r.StartCommentId != nil {
+		if err = checkMinimum("start_comment_id", float64(*r.StartCommentId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkStringEnum("sort", string(*r.Sort), "asc", "desc"); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Photos_GetComments Returns a list of comments on a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17161,6 +24328,9 @@ func (r Photos_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getComments
 func (vk *VK) Photos_GetComments(ctx context.Context, req Photos_GetComments_Request, options ...Option) (resp Photos_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17178,6 +24348,9 @@ func (vk *VK) Photos_GetComments(ctx context.Context, req Photos_GetComments_Req
 //
 // https://dev.vk.com/method/photos.getComments
 func (vk *VK) Photos_GetCommentsExtended(ctx context.Context, req Photos_GetComments_Request, options ...Option) (resp Photos_GetCommentsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17200,6 +24373,14 @@ func (r Photos_GetMarketAlbumUploadServer_Request) fillIn(values url.Values) (er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetMarketAlbumUploadServer_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_GetMarketAlbumUploadServer Returns the server address for market album photo upload.
 // May execute with listed access token types:
 //    [ user ]
@@ -17208,6 +24389,9 @@ func (r Photos_GetMarketAlbumUploadServer_Request) fillIn(values url.Values) (er
 //
 // https://dev.vk.com/method/photos.getMarketAlbumUploadServer
 func (vk *VK) Photos_GetMarketAlbumUploadServer(ctx context.Context, req Photos_GetMarketAlbumUploadServer_Request, options ...Option) (resp Base_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17252,6 +24436,29 @@ func (r Photos_GetMarketUploadServer_Request) fillIn(values url.Values) (err err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetMarketUploadServer_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.CropX != nil {
+		if err = checkMinimum("crop_x", float64(*r.CropX), 0); err != nil {
+			return
+		}
+	}
+	if r.CropY != nil {
+		if err = checkMinimum("crop_y", float64(*r.CropY), 0); err != nil {
+			return
+		}
+	}
+	if r.CropWidth != nil {
+		if err = checkMinimum("crop_width", float64(*r.CropWidth), 400); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetMarketUploadServer Returns the server address for market photo upload.
 // May execute with listed access token types:
 //    [ user ]
@@ -17260,6 +24467,9 @@ func (r Photos_GetMarketUploadServer_Request) fillIn(values url.Values) (err err
 //
 // https://dev.vk.com/method/photos.getMarketUploadServer
 func (vk *VK) Photos_GetMarketUploadServer(ctx context.Context, req Photos_GetMarketUploadServer_Request, options ...Option) (resp Photos_GetMarketUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17281,6 +24491,11 @@ func (r Photos_GetMessagesUploadServer_Request) fillIn(values url.Values) (err e
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetMessagesUploadServer_Request) Validate() (err error) {
+	return
+}
+
 // Photos_GetMessagesUploadServer Returns the server address for photo upload in a private message for a user.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -17289,6 +24504,9 @@ func (r Photos_GetMessagesUploadServer_Request) fillIn(values url.Values) (err e
 //
 // https://dev.vk.com/method/photos.getMessagesUploadServer
 func (vk *VK) Photos_GetMessagesUploadServer(ctx context.Context, req Photos_GetMessagesUploadServer_Request, options ...Option) (resp Photos_GetMessagesUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17318,6 +24536,19 @@ func (r Photos_GetNewTags_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetNewTags_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetNewTags Returns a list of photos with tags that have not been viewed.
 // May execute with listed access token types:
 //    [ user ]
@@ -17325,6 +24556,9 @@ func (r Photos_GetNewTags_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getNewTags
 func (vk *VK) Photos_GetNewTags(ctx context.Context, req Photos_GetNewTags_Request, options ...Option) (resp Photos_GetNewTags_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17374,6 +24608,34 @@ func (r Photos_GetOwnerCoverPhotoUploadServer_Request) fillIn(values url.Values)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetOwnerCoverPhotoUploadServer_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if r.CropX != nil {
+		if err = checkMinimum("crop_x", float64(*r.CropX), 0); err != nil {
+			return
+		}
+	}
+	if r.CropY != nil {
+		if err = checkMinimum("crop_y", float64(*r.CropY), 0); err != nil {
+			return
+		}
+	}
+	if r.CropX2 != nil {
+		if err = checkMinimum("crop_x2", float64(*r.CropX2), 0); err != nil {
+			return
+		}
+	}
+	if r.CropY2 != nil {
+		if err = checkMinimum("crop_y2", float64(*r.CropY2), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetOwnerCoverPhotoUploadServer Returns the server address for owner cover upload.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -17381,6 +24643,9 @@ func (r Photos_GetOwnerCoverPhotoUploadServer_Request) fillIn(values url.Values)
 //
 // https://dev.vk.com/method/photos.getOwnerCoverPhotoUploadServer
 func (vk *VK) Photos_GetOwnerCoverPhotoUploadServer(ctx context.Context, req Photos_GetOwnerCoverPhotoUploadServer_Request, options ...Option) (resp Base_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17403,6 +24668,11 @@ func (r Photos_GetOwnerPhotoUploadServer_Request) fillIn(values url.Values) (err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetOwnerPhotoUploadServer_Request) Validate() (err error) {
+	return
+}
+
 // Photos_GetOwnerPhotoUploadServer Returns an upload server address for a profile or community photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17410,6 +24680,9 @@ func (r Photos_GetOwnerPhotoUploadServer_Request) fillIn(values url.Values) (err
 //
 // https://dev.vk.com/method/photos.getOwnerPhotoUploadServer
 func (vk *VK) Photos_GetOwnerPhotoUploadServer(ctx context.Context, req Photos_GetOwnerPhotoUploadServer_Request, options ...Option) (resp Base_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17439,6 +24712,11 @@ func (r Photos_GetTags_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetTags_Request) Validate() (err error) {
+	return
+}
+
 // Photos_GetTags Returns a list of tags on a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17446,6 +24724,9 @@ func (r Photos_GetTags_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getTags
 func (vk *VK) Photos_GetTags(ctx context.Context, req Photos_GetTags_Request, options ...Option) (resp Photos_GetTags_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17473,6 +24754,11 @@ func (r Photos_GetUploadServer_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetUploadServer_Request) Validate() (err error) {
+	return
+}
+
 // Photos_GetUploadServer Returns the server address for photo upload.
 // May execute with listed access token types:
 //    [ user ]
@@ -17480,6 +24766,9 @@ func (r Photos_GetUploadServer_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getUploadServer
 func (vk *VK) Photos_GetUploadServer(ctx context.Context, req Photos_GetUploadServer_Request, options ...Option) (resp Photos_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17527,6 +24816,29 @@ func (r Photos_GetUserPhotos_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetUserPhotos_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_GetUserPhotos Returns a list of photos in which a user is tagged.
 // May execute with listed access token types:
 //    [ user ]
@@ -17534,6 +24846,9 @@ func (r Photos_GetUserPhotos_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.getUserPhotos
 func (vk *VK) Photos_GetUserPhotos(ctx context.Context, req Photos_GetUserPhotos_Request, options ...Option) (resp Photos_GetUserPhotos_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17556,6 +24871,11 @@ func (r Photos_GetWallUploadServer_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_GetWallUploadServer_Request) Validate() (err error) {
+	return
+}
+
 // Photos_GetWallUploadServer Returns the server address for photo upload onto a user's wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -17563,6 +24883,9 @@ func (r Photos_GetWallUploadServer_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/photos.getWallUploadServer
 func (vk *VK) Photos_GetWallUploadServer(ctx context.Context, req Photos_GetWallUploadServer_Request, options ...Option) (resp Photos_GetWallUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17593,6 +24916,11 @@ func (r Photos_MakeCover_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_MakeCover_Request) Validate() (err error) {
+	return
+}
+
 // Photos_MakeCover Makes a photo into an album cover.
 // May execute with listed access token types:
 //    [ user ]
@@ -17600,6 +24928,9 @@ func (r Photos_MakeCover_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.makeCover
 func (vk *VK) Photos_MakeCover(ctx context.Context, req Photos_MakeCover_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17628,6 +24959,11 @@ func (r Photos_Move_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_Move_Request) Validate() (err error) {
+	return
+}
+
 // Photos_Move Moves a photo from one album to another.
 // May execute with listed access token types:
 //    [ user ]
@@ -17635,6 +24971,9 @@ func (r Photos_Move_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.move
 func (vk *VK) Photos_Move(ctx context.Context, req Photos_Move_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17685,6 +25024,14 @@ func (r Photos_PutTag_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_PutTag_Request) Validate() (err error) {
+	if err = checkMinimum("photo_id", float64(r.PhotoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_PutTag Adds a tag on the photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17692,6 +25039,9 @@ func (r Photos_PutTag_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.putTag
 func (vk *VK) Photos_PutTag(ctx context.Context, req Photos_PutTag_Request, options ...Option) (resp Photos_PutTag_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17720,6 +25070,11 @@ func (r Photos_RemoveTag_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_RemoveTag_Request) Validate() (err error) {
+	return
+}
+
 // Photos_RemoveTag Removes a tag from a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17727,6 +25082,9 @@ func (r Photos_RemoveTag_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.removeTag
 func (vk *VK) Photos_RemoveTag(ctx context.Context, req Photos_RemoveTag_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17762,6 +25120,11 @@ func (r Photos_ReorderAlbums_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_ReorderAlbums_Request) Validate() (err error) {
+	return
+}
+
 // Photos_ReorderAlbums Reorders the album in the list of user albums.
 // May execute with listed access token types:
 //    [ user ]
@@ -17769,6 +25132,9 @@ func (r Photos_ReorderAlbums_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.reorderAlbums
 func (vk *VK) Photos_ReorderAlbums(ctx context.Context, req Photos_ReorderAlbums_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17804,6 +25170,11 @@ func (r Photos_ReorderPhotos_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_ReorderPhotos_Request) Validate() (err error) {
+	return
+}
+
 // Photos_ReorderPhotos Reorders the photo in the list of photos of the user album.
 // May execute with listed access token types:
 //    [ user ]
@@ -17812,6 +25183,9 @@ func (r Photos_ReorderPhotos_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.reorderPhotos
 func (vk *VK) Photos_ReorderPhotos(ctx context.Context, req Photos_ReorderPhotos_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17849,7 +25223,23 @@ func (r Photos_Report_Request) fillIn(values url.Values) (err error) {
 	setInt(values, "owner_id", r.OwnerId)
 	setInt(values, "photo_id", r.PhotoId)
 	if r.Reason != nil {
-		setInt(values, "reason", int(*r.Reason))
+		setInt(values, "reason", int(*r.Reason))
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Photos_Report_Request) Validate() (err error) {
+	if err = checkMinimum("photo_id", float64(r.PhotoId), 0); err != nil {
+		return
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("reason", int(*r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -17861,6 +25251,9 @@ func (r Photos_Report_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.report
 func (vk *VK) Photos_Report(ctx context.Context, req Photos_Report_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17903,6 +25296,22 @@ func (r Photos_ReportComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_ReportComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("reason", int(*r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_ReportComment Reports (submits a complaint about) a comment on a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17910,6 +25319,9 @@ func (r Photos_ReportComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.reportComment
 func (vk *VK) Photos_ReportComment(ctx context.Context, req Photos_ReportComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17936,6 +25348,14 @@ func (r Photos_Restore_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_Restore_Request) Validate() (err error) {
+	if err = checkMinimum("photo_id", float64(r.PhotoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_Restore Restores a deleted photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17943,6 +25363,9 @@ func (r Photos_Restore_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.restore
 func (vk *VK) Photos_Restore(ctx context.Context, req Photos_Restore_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -17968,6 +25391,11 @@ func (r Photos_RestoreComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_RestoreComment_Request) Validate() (err error) {
+	return
+}
+
 // Photos_RestoreComment Restores a deleted comment on a photo.
 // May execute with listed access token types:
 //    [ user ]
@@ -17975,6 +25403,9 @@ func (r Photos_RestoreComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.restoreComment
 func (vk *VK) Photos_RestoreComment(ctx context.Context, req Photos_RestoreComment_Request, options ...Option) (resp Photos_RestoreComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18032,6 +25463,11 @@ func (r Photos_Save_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_Save_Request) Validate() (err error) {
+	return
+}
+
 // Photos_Save Saves photos after successful uploading.
 // May execute with listed access token types:
 //    [ user ]
@@ -18040,6 +25476,9 @@ func (r Photos_Save_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.save
 func (vk *VK) Photos_Save(ctx context.Context, req Photos_Save_Request, options ...Option) (resp Photos_Save_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18071,6 +25510,23 @@ func (r Photos_SaveMarketAlbumPhoto_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_SaveMarketAlbumPhoto_Request) Validate() (err error) {
+	if err = checkMinimum("group_id", float64(r.GroupId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("photo", r.Photo != ""); err != nil {
+		return
+	}
+	if err = checkMinimum("server", float64(r.Server), 0); err != nil {
+		return
+	}
+	if err = checkRequired("hash", r.Hash != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_SaveMarketAlbumPhoto Saves market album photos after successful uploading.
 // May execute with listed access token types:
 //    [ user ]
@@ -18079,6 +25535,9 @@ func (r Photos_SaveMarketAlbumPhoto_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/photos.saveMarketAlbumPhoto
 func (vk *VK) Photos_SaveMarketAlbumPhoto(ctx context.Context, req Photos_SaveMarketAlbumPhoto_Request, options ...Option) (resp Photos_SaveMarketAlbumPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18121,6 +25580,22 @@ func (r Photos_SaveMarketPhoto_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_SaveMarketPhoto_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("photo", r.Photo != ""); err != nil {
+		return
+	}
+	if err = checkRequired("hash", r.Hash != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_SaveMarketPhoto Saves market photos after successful uploading.
 // May execute with listed access token types:
 //    [ user ]
@@ -18129,6 +25604,9 @@ func (r Photos_SaveMarketPhoto_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.saveMarketPhoto
 func (vk *VK) Photos_SaveMarketPhoto(ctx context.Context, req Photos_SaveMarketPhoto_Request, options ...Option) (resp Photos_SaveMarketPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18156,6 +25634,14 @@ func (r Photos_SaveMessagesPhoto_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_SaveMessagesPhoto_Request) Validate() (err error) {
+	if err = checkRequired("photo", r.Photo != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_SaveMessagesPhoto Saves a photo after being successfully uploaded. URL obtained with [vk.com/dev/photos.getMessagesUploadServer|photos.getMessagesUploadServer] method.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -18164,6 +25650,9 @@ func (r Photos_SaveMessagesPhoto_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/photos.saveMessagesPhoto
 func (vk *VK) Photos_SaveMessagesPhoto(ctx context.Context, req Photos_SaveMessagesPhoto_Request, options ...Option) (resp Photos_SaveMessagesPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18186,6 +25675,17 @@ func (r Photos_SaveOwnerCoverPhoto_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_SaveOwnerCoverPhoto_Request) Validate() (err error) {
+	if err = checkRequired("hash", r.Hash != ""); err != nil {
+		return
+	}
+	if err = checkRequired("photo", r.Photo != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_SaveOwnerCoverPhoto Saves cover photo after successful uploading.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -18194,6 +25694,9 @@ func (r Photos_SaveOwnerCoverPhoto_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/photos.saveOwnerCoverPhoto
 func (vk *VK) Photos_SaveOwnerCoverPhoto(ctx context.Context, req Photos_SaveOwnerCoverPhoto_Request, options ...Option) (resp Photos_SaveOwnerCoverPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18225,6 +25728,11 @@ func (r Photos_SaveOwnerPhoto_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_SaveOwnerPhoto_Request) Validate() (err error) {
+	return
+}
+
 // Photos_SaveOwnerPhoto Saves a profile or community photo. Upload URL can be got with the [vk.com/dev/photos.getOwnerPhotoUploadServer|photos.getOwnerPhotoUploadServer] method.
 // May execute with listed access token types:
 //    [ user ]
@@ -18233,6 +25741,9 @@ func (r Photos_SaveOwnerPhoto_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.saveOwnerPhoto
 func (vk *VK) Photos_SaveOwnerPhoto(ctx context.Context, req Photos_SaveOwnerPhoto_Request, options ...Option) (resp Photos_SaveOwnerPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18289,6 +25800,24 @@ func (r Photos_SaveWallPhoto_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_SaveWallPhoto_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("photo", r.Photo != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Photos_SaveWallPhoto Saves a photo to a user's or community's wall after being uploaded.
 // May execute with listed access token types:
 //    [ user ]
@@ -18297,6 +25826,9 @@ func (r Photos_SaveWallPhoto_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.saveWallPhoto
 func (vk *VK) Photos_SaveWallPhoto(ctx context.Context, req Photos_SaveWallPhoto_Request, options ...Option) (resp Photos_SaveWallPhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18365,6 +25897,44 @@ func (r Photos_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Photos_Search_Request) Validate() (err error) {
+	if r.StartTime != nil {
+		if err = checkMinimum("start_time", float64(*r.StartTime), 0); err != nil {
+			return
+		}
+	}
+	if r.EndTime != nil {
+		if err = checkMinimum("end_time", float64(*r.EndTime), 0); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkMinimum("sort", float64(*r.Sort), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	if r.Radius != nil {
+		if err = checkMinimum("radius", float64(*r.Radius), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Photos_Search Returns a list of photos.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -18372,6 +25942,9 @@ func (r Photos_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/photos.search
 func (vk *VK) Photos_Search(ctx context.Context, req Photos_Search_Request, options ...Option) (resp Photos_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18403,6 +25976,27 @@ func (r Podcasts_SearchPodcast_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Podcasts_SearchPodcast_Request) Validate() (err error) {
+	if err = checkRequired("search_string", r.SearchString != ""); err != nil {
+		return
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Podcasts_SearchPodcast ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -18410,6 +26004,9 @@ func (r Podcasts_SearchPodcast_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/podcasts.searchPodcast
 func (vk *VK) Podcasts_SearchPodcast(ctx context.Context, req Podcasts_SearchPodcast_Request, options ...Option) (resp Podcasts_SearchPodcast_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18445,6 +26042,21 @@ func (r Polls_AddVote_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_AddVote_Request) Validate() (err error) {
+	if err = checkMinimum("poll_id", float64(r.PollId), 0); err != nil {
+		return
+	}
+	if r.AnswerIds != nil {
+		for _, v := range *r.AnswerIds {
+			if err = checkMinimum("answer_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Polls_AddVote Adds the current user's vote to the selected answer in the poll.
 // May execute with listed access token types:
 //    [ user ]
@@ -18453,6 +26065,9 @@ func (r Polls_AddVote_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/polls.addVote
 func (vk *VK) Polls_AddVote(ctx context.Context, req Polls_AddVote_Request, options ...Option) (resp Polls_AddVote_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18528,6 +26143,26 @@ func (r Polls_Create_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_Create_Request) Validate() (err error) {
+	if r.EndDate != nil {
+		if err = checkMinimum("end_date", float64(*r.EndDate), 1.5507e+09); err != nil {
+			return
+		}
+	}
+	if r.PhotoId != nil {
+		if err = checkMinimum("photo_id", float64(*r.PhotoId), 0); err != nil {
+			return
+		}
+	}
+	if r.BackgroundId != nil {
+		if err = checkIntEnum("background_id", int(*r.BackgroundId), 1, 2, 3, 4, 6, 8, 9); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Polls_Create Creates polls that can be attached to the users' or communities' posts.
 // May execute with listed access token types:
 //    [ user ]
@@ -18535,6 +26170,9 @@ func (r Polls_Create_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/polls.create
 func (vk *VK) Polls_Create(ctx context.Context, req Polls_Create_Request, options ...Option) (resp Polls_Create_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 12+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18569,6 +26207,17 @@ func (r Polls_DeleteVote_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_DeleteVote_Request) Validate() (err error) {
+	if err = checkMinimum("poll_id", float64(r.PollId), 0); err != nil {
+		return
+	}
+	if err = checkMinimum("answer_id", float64(r.AnswerId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Polls_DeleteVote Deletes the current user's vote from the selected answer in the poll.
 // May execute with listed access token types:
 //    [ user ]
@@ -18577,6 +26226,9 @@ func (r Polls_DeleteVote_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/polls.deleteVote
 func (vk *VK) Polls_DeleteVote(ctx context.Context, req Polls_DeleteVote_Request, options ...Option) (resp Polls_DeleteVote_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18650,6 +26302,29 @@ func (r Polls_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("poll_id", float64(r.PollId), 0); err != nil {
+		return
+	}
+	if r.EndDate != nil {
+		if err = checkMinimum("end_date", float64(*r.EndDate), 0); err != nil {
+			return
+		}
+	}
+	if r.PhotoId != nil {
+		if err = checkMinimum("photo_id", float64(*r.PhotoId), 0); err != nil {
+			return
+		}
+	}
+	if r.BackgroundId != nil {
+		if err = checkIntEnum("background_id", int(*r.BackgroundId), 0, 1, 2, 3, 4, 6, 8, 9); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Polls_Edit Edits created polls
 // May execute with listed access token types:
 //    [ user ]
@@ -18657,6 +26332,9 @@ func (r Polls_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/polls.edit
 func (vk *VK) Polls_Edit(ctx context.Context, req Polls_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18732,6 +26410,27 @@ func (r Polls_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_GetById_Request) Validate() (err error) {
+	if err = checkMinimum("poll_id", float64(r.PollId), 0); err != nil {
+		return
+	}
+	if r.FriendsCount != nil {
+		if err = checkMinimum("friends_count", float64(*r.FriendsCount), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("friends_count", float64(*r.FriendsCount), 100); err != nil {
+			return
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "abl", "acc", "dat", "gen", "ins", "nom"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Polls_GetById Returns detailed information about a poll by its ID.
 // May execute with listed access token types:
 //    [ user ]
@@ -18740,6 +26439,9 @@ func (r Polls_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/polls.getById
 func (vk *VK) Polls_GetById(ctx context.Context, req Polls_GetById_Request, options ...Option) (resp Polls_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18761,6 +26463,11 @@ func (r Polls_GetPhotoUploadServer_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_GetPhotoUploadServer_Request) Validate() (err error) {
+	return
+}
+
 // Polls_GetPhotoUploadServer ...
 // May execute with listed access token types:
 //    [ user ]
@@ -18768,6 +26475,9 @@ func (r Polls_GetPhotoUploadServer_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/polls.getPhotoUploadServer
 func (vk *VK) Polls_GetPhotoUploadServer(ctx context.Context, req Polls_GetPhotoUploadServer_Request, options ...Option) (resp Base_GetUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18844,6 +26554,43 @@ func (r Polls_GetVoters_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_GetVoters_Request) Validate() (err error) {
+	if err = checkMinimum("poll_id", float64(r.PollId), 0); err != nil {
+		return
+	}
+	if r.AnswerIds != nil {
+		for _, v := range *r.AnswerIds {
+			if err = checkMinimum("answer_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Polls_GetVoters Returns a list of IDs of users who selected specific answers in the poll.
 // May execute with listed access token types:
 //    [ user ]
@@ -18852,6 +26599,9 @@ func (r Polls_GetVoters_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/polls.getVoters
 func (vk *VK) Polls_GetVoters(ctx context.Context, req Polls_GetVoters_Request, options ...Option) (resp Polls_GetVoters_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18872,6 +26622,17 @@ func (r Polls_SavePhoto_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Polls_SavePhoto_Request) Validate() (err error) {
+	if err = checkRequired("photo", r.Photo != ""); err != nil {
+		return
+	}
+	if err = checkRequired("hash", r.Hash != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Polls_SavePhoto ...
 // May execute with listed access token types:
 //    [ user ]
@@ -18880,6 +26641,9 @@ func (r Polls_SavePhoto_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/polls.savePhoto
 func (vk *VK) Polls_SavePhoto(ctx context.Context, req Polls_SavePhoto_Request, options ...Option) (resp Polls_SavePhoto_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18920,6 +26684,38 @@ func (r PrettyCards_Create_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r PrettyCards_Create_Request) Validate() (err error) {
+	if err = checkRequired("photo", r.Photo != ""); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if err = checkRequired("link", r.Link != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("link", r.Link, 2000); err != nil {
+		return
+	}
+	if r.Price != nil {
+		if err = checkMaxLength("price", *r.Price, 20); err != nil {
+			return
+		}
+	}
+	if r.PriceOld != nil {
+		if err = checkMaxLength("price_old", *r.PriceOld, 20); err != nil {
+			return
+		}
+	}
+	if r.Button != nil {
+		if err = checkMaxLength("button", *r.Button, 255); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // PrettyCards_Create ...
 // May execute with listed access token types:
 //    [ user ]
@@ -18928,6 +26724,9 @@ func (r PrettyCards_Create_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/prettyCards.create
 func (vk *VK) PrettyCards_Create(ctx context.Context, req PrettyCards_Create_Request, options ...Option) (resp PrettyCards_Create_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -18948,6 +26747,11 @@ func (r PrettyCards_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r PrettyCards_Delete_Request) Validate() (err error) {
+	return
+}
+
 // PrettyCards_Delete ...
 // May execute with listed access token types:
 //    [ user ]
@@ -18956,6 +26760,9 @@ func (r PrettyCards_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/prettyCards.delete
 func (vk *VK) PrettyCards_Delete(ctx context.Context, req PrettyCards_Delete_Request, options ...Option) (resp PrettyCards_Delete_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19004,6 +26811,31 @@ func (r PrettyCards_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r PrettyCards_Edit_Request) Validate() (err error) {
+	if r.Link != nil {
+		if err = checkMaxLength("link", *r.Link, 2000); err != nil {
+			return
+		}
+	}
+	if r.Price != nil {
+		if err = checkMaxLength("price", *r.Price, 20); err != nil {
+			return
+		}
+	}
+	if r.PriceOld != nil {
+		if err = checkMaxLength("price_old", *r.PriceOld, 20); err != nil {
+			return
+		}
+	}
+	if r.Button != nil {
+		if err = checkMaxLength("button", *r.Button, 255); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // PrettyCards_Edit ...
 // May execute with listed access token types:
 //    [ user ]
@@ -19012,6 +26844,9 @@ func (r PrettyCards_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/prettyCards.edit
 func (vk *VK) PrettyCards_Edit(ctx context.Context, req PrettyCards_Edit_Request, options ...Option) (resp PrettyCards_Edit_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19043,6 +26878,24 @@ func (r PrettyCards_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r PrettyCards_Get_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // PrettyCards_Get ...
 // May execute with listed access token types:
 //    [ user ]
@@ -19050,6 +26903,9 @@ func (r PrettyCards_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/prettyCards.get
 func (vk *VK) PrettyCards_Get(ctx context.Context, req PrettyCards_Get_Request, options ...Option) (resp PrettyCards_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19073,6 +26929,16 @@ func (r PrettyCards_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r PrettyCards_GetById_Request) Validate() (err error) {
+	if r.CardIds != nil {
+		if err = checkMaxItems("card_ids", len(*r.CardIds), 10); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // PrettyCards_GetById ...
 // May execute with listed access token types:
 //    [ user ]
@@ -19080,6 +26946,9 @@ func (r PrettyCards_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/prettyCards.getById
 func (vk *VK) PrettyCards_GetById(ctx context.Context, req PrettyCards_GetById_Request, options ...Option) (resp PrettyCards_GetById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19143,6 +27012,32 @@ func (r Search_GetHints_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Search_GetHints_Request) Validate() (err error) {
+	if r.Q != nil {
+		if err = checkMaxLength("q", *r.Q, 9000); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("offset", float64(*r.Offset), 200); err != nil {
+			return
+		}
+	}
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("limit", float64(*r.Limit), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Search_GetHints Allows the programmer to do a quick search for any substring.
 // May execute with listed access token types:
 //    [ user ]
@@ -19150,6 +27045,9 @@ func (r Search_GetHints_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/search.getHints
 func (vk *VK) Search_GetHints(ctx context.Context, req Search_GetHints_Request, options ...Option) (resp Search_GetHints_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19181,6 +27079,22 @@ func (r Secure_AddAppEvent_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_AddAppEvent_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkMinimum("activity_id", float64(r.ActivityId), 0); err != nil {
+		return
+	}
+	if r.Value != nil {
+		if err = checkMinimum("value", float64(*r.Value), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Secure_AddAppEvent Adds user activity information to an application
 // May execute with listed access token types:
 //    [ service ]
@@ -19189,6 +27103,9 @@ func (r Secure_AddAppEvent_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/secure.addAppEvent
 func (vk *VK) Secure_AddAppEvent(ctx context.Context, req Secure_AddAppEvent_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19215,6 +27132,11 @@ func (r Secure_CheckToken_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_CheckToken_Request) Validate() (err error) {
+	return
+}
+
 // Secure_CheckToken Checks the user authentication in 'IFrame' and 'Flash' apps using the 'access_token' parameter.
 // May execute with listed access token types:
 //    [ service ]
@@ -19222,6 +27144,9 @@ func (r Secure_CheckToken_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/secure.checkToken
 func (vk *VK) Secure_CheckToken(ctx context.Context, req Secure_CheckToken_Request, options ...Option) (resp Secure_CheckToken_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19250,10 +27175,10 @@ type Secure_GetSMSHistory_Request struct {
 	UserId *int
 	// filter by start date. It is set as UNIX-time.
 	//  Minimum: 0
-	DateFrom *int
+	DateFrom *UnixTime
 	// filter by end date. It is set as UNIX-time.
 	//  Minimum: 0
-	DateTo *int
+	DateTo *UnixTime
 	// number of returned posts. By default — 1000.
 	//  Default: 1000
 	//  Minimum: 0
@@ -19266,10 +27191,10 @@ func (r Secure_GetSMSHistory_Request) fillIn(values url.Values) (err error) {
 		setInt(values, "user_id", *r.UserId)
 	}
 	if r.DateFrom != nil {
-		setInt(values, "date_from", *r.DateFrom)
+		setInt(values, "date_from", int(*r.DateFrom))
 	}
 	if r.DateTo != nil {
-		setInt(values, "date_to", *r.DateTo)
+		setInt(values, "date_to", int(*r.DateTo))
 	}
 	if r.Limit != nil {
 		setInt(values, "limit", *r.Limit)
@@ -19277,6 +27202,34 @@ func (r Secure_GetSMSHistory_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_GetSMSHistory_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.DateFrom != nil {
+		if err = checkMinimum("date_from", float64(*r.DateFrom), 0); err != nil {
+			return
+		}
+	}
+	if r.DateTo != nil {
+		if err = checkMinimum("date_to", float64(*r.DateTo), 0); err != nil {
+			return
+		}
+	}
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("limit", float64(*r.Limit), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Secure_GetSMSHistory Shows a list of SMS notifications sent by the application using [vk.com/dev/secure.sendSMSNotification|secure.sendSMSNotification] method.
 // May execute with listed access token types:
 //    [ service ]
@@ -19284,6 +27237,9 @@ func (r Secure_GetSMSHistory_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/secure.getSMSHistory
 func (vk *VK) Secure_GetSMSHistory(ctx context.Context, req Secure_GetSMSHistory_Request, options ...Option) (resp Secure_GetSMSHistory_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19333,6 +27289,39 @@ func (r Secure_GetTransactionsHistory_Request) fillIn(values url.Values) (err er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_GetTransactionsHistory_Request) Validate() (err error) {
+	if r.UidFrom != nil {
+		if err = checkMinimum("uid_from", float64(*r.UidFrom), 1); err != nil {
+			return
+		}
+	}
+	if r.UidTo != nil {
+		if err = checkMinimum("uid_to", float64(*r.UidTo), 1); err != nil {
+			return
+		}
+	}
+	if r.DateFrom != nil {
+		if err = checkMinimum("date_from", float64(*r.DateFrom), 0); err != nil {
+			return
+		}
+	}
+	if r.DateTo != nil {
+		if err = checkMinimum("date_to", float64(*r.DateTo), 0); err != nil {
+			return
+		}
+	}
+	if r.Limit != nil {
+		if err = checkMinimum("limit", float64(*r.Limit), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("limit", float64(*r.Limit), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Secure_GetTransactionsHistory Shows history of votes transaction between users and the application.
 // May execute with listed access token types:
 //    [ service ]
@@ -19340,6 +27329,9 @@ func (r Secure_GetTransactionsHistory_Request) fillIn(values url.Values) (err er
 //
 // https://dev.vk.com/method/secure.getTransactionsHistory
 func (vk *VK) Secure_GetTransactionsHistory(ctx context.Context, req Secure_GetTransactionsHistory_Request, options ...Option) (resp Secure_GetTransactionsHistory_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19362,6 +27354,18 @@ func (r Secure_GetUserLevel_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_GetUserLevel_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 1); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Secure_GetUserLevel Returns one of the previously set game levels of one or more users in the application.
 // May execute with listed access token types:
 //    [ service ]
@@ -19369,6 +27373,9 @@ func (r Secure_GetUserLevel_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/secure.getUserLevel
 func (vk *VK) Secure_GetUserLevel(ctx context.Context, req Secure_GetUserLevel_Request, options ...Option) (resp Secure_GetUserLevel_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19394,6 +27401,21 @@ func (r Secure_GiveEventSticker_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_GiveEventSticker_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 1); err != nil {
+				return
+			}
+		}
+	}
+	if err = checkMinimum("achievement_id", float64(r.AchievementId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Secure_GiveEventSticker Opens the game achievement and gives the user a sticker
 // May execute with listed access token types:
 //    [ service ]
@@ -19401,6 +27423,9 @@ func (r Secure_GiveEventSticker_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/secure.giveEventSticker
 func (vk *VK) Secure_GiveEventSticker(ctx context.Context, req Secure_GiveEventSticker_Request, options ...Option) (resp Secure_GiveEventSticker_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19432,6 +27457,26 @@ func (r Secure_SendNotification_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_SendNotification_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 1); err != nil {
+				return
+			}
+		}
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkRequired("message", r.Message != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Secure_SendNotification Sends notification to the user.
 // May execute with listed access token types:
 //    [ service ]
@@ -19439,6 +27484,9 @@ func (r Secure_SendNotification_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/secure.sendNotification
 func (vk *VK) Secure_SendNotification(ctx context.Context, req Secure_SendNotification_Request, options ...Option) (resp Secure_SendNotification_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19463,6 +27511,17 @@ func (r Secure_SendSMSNotification_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_SendSMSNotification_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("message", r.Message != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Secure_SendSMSNotification Sends 'SMS' notification to a user's mobile device.
 // May execute with listed access token types:
 //    [ service ]
@@ -19471,6 +27530,9 @@ func (r Secure_SendSMSNotification_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/secure.sendSMSNotification
 func (vk *VK) Secure_SendSMSNotification(ctx context.Context, req Secure_SendSMSNotification_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19502,6 +27564,16 @@ func (r Secure_SetCounter_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_SetCounter_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Secure_SetCounter Sets a counter which is shown to the user in bold in the left menu.
 // May execute with listed access token types:
 //    [ service ]
@@ -19509,6 +27581,9 @@ func (r Secure_SetCounter_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/secure.setCounter
 func (vk *VK) Secure_SetCounter(ctx context.Context, req Secure_SetCounter_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19529,6 +27604,11 @@ func (r Secure_SetCounterCounters_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_SetCounterCounters_Request) Validate() (err error) {
+	return
+}
+
 // Secure_SetCounterCounters Sets a counter which is shown to the user in bold in the left menu.
 // May execute with listed access token types:
 //    [ service ]
@@ -19536,6 +27616,9 @@ func (r Secure_SetCounterCounters_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/secure.setCounter
 func (vk *VK) Secure_SetCounterCounters(ctx context.Context, req Secure_SetCounterCounters_Request, options ...Option) (resp Secure_SetCounterArray_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19561,6 +27644,11 @@ func (r Secure_SetCounterNotSecure_Request) fillIn(values url.Values) (err error
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Secure_SetCounterNotSecure_Request) Validate() (err error) {
+	return
+}
+
 // Secure_SetCounterNotSecure Sets a counter which is shown to the user in bold in the left menu.
 // May execute with listed access token types:
 //    [ service ]
@@ -19568,6 +27656,9 @@ func (r Secure_SetCounterNotSecure_Request) fillIn(values url.Values) (err error
 //
 // https://dev.vk.com/method/secure.setCounter
 func (vk *VK) Secure_SetCounterNotSecure(ctx context.Context, req Secure_SetCounterNotSecure_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19640,6 +27731,41 @@ func (r Stats_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stats_Get_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.AppId != nil {
+		if err = checkMinimum("app_id", float64(*r.AppId), 0); err != nil {
+			return
+		}
+	}
+	if r.TimestampFrom != nil {
+		if err = checkMinimum("timestamp_from", float64(*r.TimestampFrom), 0); err != nil {
+			return
+		}
+	}
+	if r.TimestampTo != nil {
+		if err = checkMinimum("timestamp_to", float64(*r.TimestampTo), 0); err != nil {
+			return
+		}
+	}
+	if r.Interval != nil {
+		if err = checkStringEnum("interval", string(*r.Interval), "all", "day", "month", "week", "year"); err != nil {
+			return
+		}
+	}
+	if r.IntervalsCount != nil {
+		if err = checkMinimum("intervals_count", float64(*r.IntervalsCount), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stats_Get Returns statistics of a community or an application.
 // May execute with listed access token types:
 //    [ user ]
@@ -19647,6 +27773,9 @@ func (r Stats_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stats.get
 func (vk *VK) Stats_Get(ctx context.Context, req Stats_Get_Request, options ...Option) (resp Stats_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19672,6 +27801,24 @@ func (r Stats_GetPostReach_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stats_GetPostReach_Request) Validate() (err error) {
+	if err = checkRequired("owner_id", r.OwnerId != ""); err != nil {
+		return
+	}
+	if r.PostIds != nil {
+		if err = checkMaxItems("post_ids", len(*r.PostIds), 30); err != nil {
+			return
+		}
+		for _, v := range *r.PostIds {
+			if err = checkMinimum("post_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stats_GetPostReach Returns stats for a wall post.
 // May execute with listed access token types:
 //    [ user ]
@@ -19680,6 +27827,9 @@ func (r Stats_GetPostReach_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stats.getPostReach
 func (vk *VK) Stats_GetPostReach(ctx context.Context, req Stats_GetPostReach_Request, options ...Option) (resp Stats_GetPostReach_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19721,6 +27871,16 @@ func (r Status_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Status_Get_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Status_Get Returns data required to show the status of a user or community.
 // May execute with listed access token types:
 //    [ user ]
@@ -19728,6 +27888,9 @@ func (r Status_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/status.get
 func (vk *VK) Status_Get(ctx context.Context, req Status_Get_Request, options ...Option) (resp Status_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19756,6 +27919,16 @@ func (r Status_Set_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Status_Set_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Status_Set Sets a new status for the current user.
 // May execute with listed access token types:
 //    [ user ]
@@ -19764,6 +27937,9 @@ func (r Status_Set_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/status.set
 func (vk *VK) Status_Set(ctx context.Context, req Status_Set_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19796,6 +27972,26 @@ func (r Storage_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Storage_Get_Request) Validate() (err error) {
+	if r.Key != nil {
+		if err = checkMaxLength("key", *r.Key, 100); err != nil {
+			return
+		}
+	}
+	if r.Keys != nil {
+		if err = checkMaxItems("keys", len(*r.Keys), 1000); err != nil {
+			return
+		}
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Storage_Get Returns a value of variable with the name set by key parameter.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -19803,6 +27999,9 @@ func (r Storage_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/storage.get
 func (vk *VK) Storage_Get(ctx context.Context, req Storage_Get_Request, options ...Option) (resp Storage_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19840,6 +28039,29 @@ func (r Storage_GetKeys_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Storage_GetKeys_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Storage_GetKeys Returns the names of all variables.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -19847,6 +28069,9 @@ func (r Storage_GetKeys_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/storage.getKeys
 func (vk *VK) Storage_GetKeys(ctx context.Context, req Storage_GetKeys_Request, options ...Option) (resp Storage_GetKeys_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19876,6 +28101,22 @@ func (r Storage_Set_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Storage_Set_Request) Validate() (err error) {
+	if err = checkRequired("key", r.Key != ""); err != nil {
+		return
+	}
+	if err = checkMaxLength("key", r.Key, 100); err != nil {
+		return
+	}
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Storage_Set Saves a value of variable with the name set by 'key' parameter.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -19884,6 +28125,9 @@ func (r Storage_Set_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/storage.set
 func (vk *VK) Storage_Set(ctx context.Context, req Storage_Set_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19905,6 +28149,18 @@ func (r Store_AddStickersToFavorite_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Store_AddStickersToFavorite_Request) Validate() (err error) {
+	if r.StickerIds != nil {
+		for _, v := range *r.StickerIds {
+			if err = checkMinimum("sticker_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Store_AddStickersToFavorite Adds given sticker IDs to the list of user's favorite stickers
 // May execute with listed access token types:
 //    [ user ]
@@ -19913,6 +28169,9 @@ func (r Store_AddStickersToFavorite_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/store.addStickersToFavorite
 func (vk *VK) Store_AddStickersToFavorite(ctx context.Context, req Store_AddStickersToFavorite_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -19968,6 +28227,18 @@ func (r Store_GetProducts_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Store_GetProducts_Request) Validate() (err error) {
+	if r.ProductIds != nil {
+		for _, v := range *r.ProductIds {
+			if err = checkMinimum("product_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Store_GetProducts ...
 // May execute with listed access token types:
 //    [ user ]
@@ -19975,6 +28246,9 @@ func (r Store_GetProducts_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/store.getProducts
 func (vk *VK) Store_GetProducts(ctx context.Context, req Store_GetProducts_Request, options ...Option) (resp Store_GetProducts_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20015,6 +28289,25 @@ func (r Store_GetStickersKeywords_Request) fillIn(values url.Values) (err error)
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Store_GetStickersKeywords_Request) Validate() (err error) {
+	if r.StickersIds != nil {
+		for _, v := range *r.StickersIds {
+			if err = checkMinimum("stickers_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.ProductsIds != nil {
+		for _, v := range *r.ProductsIds {
+			if err = checkMinimum("products_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Store_GetStickersKeywords ...
 // May execute with listed access token types:
 //    [ user ]
@@ -20022,6 +28315,9 @@ func (r Store_GetStickersKeywords_Request) fillIn(values url.Values) (err error)
 //
 // https://dev.vk.com/method/store.getStickersKeywords
 func (vk *VK) Store_GetStickersKeywords(ctx context.Context, req Store_GetStickersKeywords_Request, options ...Option) (resp Store_GetStickersKeywords_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20043,6 +28339,18 @@ func (r Store_RemoveStickersFromFavorite_Request) fillIn(values url.Values) (err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Store_RemoveStickersFromFavorite_Request) Validate() (err error) {
+	if r.StickerIds != nil {
+		for _, v := range *r.StickerIds {
+			if err = checkMinimum("sticker_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Store_RemoveStickersFromFavorite Removes given sticker IDs from the list of user's favorite stickers
 // May execute with listed access token types:
 //    [ user ]
@@ -20051,6 +28359,9 @@ func (r Store_RemoveStickersFromFavorite_Request) fillIn(values url.Values) (err
 //
 // https://dev.vk.com/method/store.removeStickersFromFavorite
 func (vk *VK) Store_RemoveStickersFromFavorite(ctx context.Context, req Store_RemoveStickersFromFavorite_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20073,6 +28384,16 @@ func (r Stories_BanOwner_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_BanOwner_Request) Validate() (err error) {
+	if r.OwnersIds != nil {
+		if err = checkMaxItems("owners_ids", len(*r.OwnersIds), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stories_BanOwner Allows to hide stories from chosen sources from current user's feed.
 // May execute with listed access token types:
 //    [ user ]
@@ -20080,6 +28401,9 @@ func (r Stories_BanOwner_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.banOwner
 func (vk *VK) Stories_BanOwner(ctx context.Context, req Stories_BanOwner_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20113,6 +28437,21 @@ func (r Stories_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_Delete_Request) Validate() (err error) {
+	if r.StoryId != nil {
+		if err = checkMinimum("story_id", float64(*r.StoryId), 0); err != nil {
+			return
+		}
+	}
+	if r.Stories != nil {
+		if err = checkMaxItems("stories", len(*r.Stories), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stories_Delete Allows to delete story.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20120,6 +28459,9 @@ func (r Stories_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.delete
 func (vk *VK) Stories_Delete(ctx context.Context, req Stories_Delete_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20156,6 +28498,18 @@ func (r Stories_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_Get_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stories_Get Returns stories available for current user.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20163,6 +28517,9 @@ func (r Stories_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.get
 func (vk *VK) Stories_Get(ctx context.Context, req Stories_Get_Request, options ...Option) (resp Stories_GetV5113_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20187,6 +28544,18 @@ func (r Stories_GetBanned_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_GetBanned_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stories_GetBanned Returns list of sources hidden from current user's feed.
 // May execute with listed access token types:
 //    [ user ]
@@ -20194,6 +28563,9 @@ func (r Stories_GetBanned_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.getBanned
 func (vk *VK) Stories_GetBanned(ctx context.Context, req Stories_GetBanned_Request, options ...Option) (resp Stories_GetBanned_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20211,6 +28583,9 @@ func (vk *VK) Stories_GetBanned(ctx context.Context, req Stories_GetBanned_Reque
 //
 // https://dev.vk.com/method/stories.getBanned
 func (vk *VK) Stories_GetBannedExtended(ctx context.Context, req Stories_GetBanned_Request, options ...Option) (resp Stories_GetBannedExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20247,6 +28622,23 @@ func (r Stories_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_GetById_Request) Validate() (err error) {
+	if r.Stories != nil {
+		if err = checkMaxItems("stories", len(*r.Stories), 100); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stories_GetById Returns story by its ID.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20255,6 +28647,9 @@ func (r Stories_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.getById
 func (vk *VK) Stories_GetById(ctx context.Context, req Stories_GetById_Request, options ...Option) (resp Stories_GetByIdExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20308,6 +28703,33 @@ func (r Stories_GetPhotoUploadServer_Request) fillIn(values url.Values) (err err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_GetPhotoUploadServer_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.LinkText != nil {
+		if err = checkStringEnum("link_text", string(*r.LinkText), "to_store", "vote", "more", "book", "order", "enroll", "fill", "signup", "buy", "ticket", "write", "open", "learn_more", "view", "go_to", "contact", "watch", "play", "install", "read", "calendar"); err != nil {
+			return
+		}
+	}
+	if r.LinkUrl != nil {
+		if err = checkMaxLength("link_url", *r.LinkUrl, 2048); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stories_GetPhotoUploadServer Returns URL for uploading a story with photo.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20316,6 +28738,9 @@ func (r Stories_GetPhotoUploadServer_Request) fillIn(values url.Values) (err err
 //
 // https://dev.vk.com/method/stories.getPhotoUploadServer
 func (vk *VK) Stories_GetPhotoUploadServer(ctx context.Context, req Stories_GetPhotoUploadServer_Request, options ...Option) (resp Stories_GetPhotoUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20359,6 +28784,21 @@ func (r Stories_GetReplies_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_GetReplies_Request) Validate() (err error) {
+	if err = checkMinimum("story_id", float64(r.StoryId), 0); err != nil {
+		return
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stories_GetReplies Returns replies to the story.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20366,6 +28806,9 @@ func (r Stories_GetReplies_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.getReplies
 func (vk *VK) Stories_GetReplies(ctx context.Context, req Stories_GetReplies_Request, options ...Option) (resp Stories_GetV5113_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20390,6 +28833,14 @@ func (r Stories_GetStats_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_GetStats_Request) Validate() (err error) {
+	if err = checkMinimum("story_id", float64(r.StoryId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Stories_GetStats Returns stories available for current user.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20397,6 +28848,9 @@ func (r Stories_GetStats_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.getStats
 func (vk *VK) Stories_GetStats(ctx context.Context, req Stories_GetStats_Request, options ...Option) (resp Stories_GetStats_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20450,6 +28904,33 @@ func (r Stories_GetVideoUploadServer_Request) fillIn(values url.Values) (err err
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_GetVideoUploadServer_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		for _, v := range *r.UserIds {
+			if err = checkMinimum("user_ids", float64(v), 0); err != nil {
+				return
+			}
+		}
+	}
+	if r.LinkText != nil {
+		if err = checkStringEnum("link_text", string(*r.LinkText), "to_store", "vote", "more", "book", "order", "enroll", "fill", "signup", "buy", "ticket", "write", "open", "learn_more", "view", "go_to", "contact", "watch", "play", "install", "read", "calendar"); err != nil {
+			return
+		}
+	}
+	if r.LinkUrl != nil {
+		if err = checkMaxLength("link_url", *r.LinkUrl, 2048); err != nil {
+			return
+		}
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stories_GetVideoUploadServer Allows to receive URL for uploading story with video.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20458,6 +28939,9 @@ func (r Stories_GetVideoUploadServer_Request) fillIn(values url.Values) (err err
 //
 // https://dev.vk.com/method/stories.getVideoUploadServer
 func (vk *VK) Stories_GetVideoUploadServer(ctx context.Context, req Stories_GetVideoUploadServer_Request, options ...Option) (resp Stories_GetVideoUploadServer_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20504,6 +28988,31 @@ func (r Stories_GetViewers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_GetViewers_Request) Validate() (err error) {
+	if err = checkMinimum("story_id", float64(r.StoryId), 0); err != nil {
+		return
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stories_GetViewers Returns a list of story viewers.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20512,6 +29021,9 @@ func (r Stories_GetViewers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.getViewers
 func (vk *VK) Stories_GetViewers(ctx context.Context, req Stories_GetViewers_Request, options ...Option) (resp Stories_GetViewersExtendedV5115_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20530,6 +29042,9 @@ func (vk *VK) Stories_GetViewers(ctx context.Context, req Stories_GetViewers_Req
 //
 // https://dev.vk.com/method/stories.getViewers
 func (vk *VK) Stories_GetViewersExtended(ctx context.Context, req Stories_GetViewers_Request, options ...Option) (resp Stories_GetViewersExtendedV5115_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20557,6 +29072,16 @@ func (r Stories_HideAllReplies_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_HideAllReplies_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stories_HideAllReplies Hides all replies in the last 24 hours from the user to current user's stories.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20564,6 +29089,9 @@ func (r Stories_HideAllReplies_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.hideAllReplies
 func (vk *VK) Stories_HideAllReplies(ctx context.Context, req Stories_HideAllReplies_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20588,6 +29116,14 @@ func (r Stories_HideReply_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_HideReply_Request) Validate() (err error) {
+	if err = checkMinimum("story_id", float64(r.StoryId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Stories_HideReply Hides the reply to the current user's story.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20595,6 +29131,9 @@ func (r Stories_HideReply_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.hideReply
 func (vk *VK) Stories_HideReply(ctx context.Context, req Stories_HideReply_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20627,6 +29166,18 @@ func (r Stories_Save_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_Save_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stories_Save ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -20634,6 +29185,9 @@ func (r Stories_Save_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.save
 func (vk *VK) Stories_Save(ctx context.Context, req Stories_Save_Request, options ...Option) (resp Stories_Save_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20696,6 +29250,41 @@ func (r Stories_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_Search_Request) Validate() (err error) {
+	if r.Q != nil {
+		if err = checkMaxLength("q", *r.Q, 255); err != nil {
+			return
+		}
+	}
+	if r.PlaceId != nil {
+		if err = checkMinimum("place_id", float64(*r.PlaceId), 0); err != nil {
+			return
+		}
+	}
+	if r.Radius != nil {
+		if err = checkMinimum("radius", float64(*r.Radius), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 1); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Stories_Search ...
 // May execute with listed access token types:
 //    [ user ]
@@ -20703,6 +29292,9 @@ func (r Stories_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.search
 func (vk *VK) Stories_Search(ctx context.Context, req Stories_Search_Request, options ...Option) (resp Stories_GetV5113_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20741,6 +29333,19 @@ func (r Stories_SendInteraction_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_SendInteraction_Request) Validate() (err error) {
+	if err = checkRequired("access_key", r.AccessKey != ""); err != nil {
+		return
+	}
+	if r.Message != nil {
+		if err = checkMaxLength("message", *r.Message, 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stories_SendInteraction ...
 // May execute with listed access token types:
 //    [ service ]
@@ -20748,6 +29353,9 @@ func (r Stories_SendInteraction_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.sendInteraction
 func (vk *VK) Stories_SendInteraction(ctx context.Context, req Stories_SendInteraction_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20770,6 +29378,16 @@ func (r Stories_UnbanOwner_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Stories_UnbanOwner_Request) Validate() (err error) {
+	if r.OwnersIds != nil {
+		if err = checkMaxItems("owners_ids", len(*r.OwnersIds), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Stories_UnbanOwner Allows to show stories from hidden sources in current user's feed.
 // May execute with listed access token types:
 //    [ user ]
@@ -20777,6 +29395,9 @@ func (r Stories_UnbanOwner_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/stories.unbanOwner
 func (vk *VK) Stories_UnbanOwner(ctx context.Context, req Stories_UnbanOwner_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20822,6 +29443,16 @@ func (r Streaming_SetSettings_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Streaming_SetSettings_Request) Validate() (err error) {
+	if r.MonthlyTier != nil {
+		if err = checkStringEnum("monthly_tier", string(*r.MonthlyTier), "tier_1", "tier_2", "tier_3", "tier_4", "tier_5", "tier_6", "unlimited"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Streaming_SetSettings ...
 // May execute with listed access token types:
 //    [ service ]
@@ -20829,6 +29460,9 @@ func (r Streaming_SetSettings_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/streaming.setSettings
 func (vk *VK) Streaming_SetSettings(ctx context.Context, req Streaming_SetSettings_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20875,6 +29509,28 @@ func (r Users_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Users_Get_Request) Validate() (err error) {
+	if r.UserIds != nil {
+		if err = checkMaxItems("user_ids", len(*r.UserIds), 1000); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Users_Get Returns detailed information on users.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -20882,6 +29538,9 @@ func (r Users_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/users.get
 func (vk *VK) Users_Get(ctx context.Context, req Users_Get_Request, options ...Option) (resp Users_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20943,6 +29602,41 @@ func (r Users_GetFollowers_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Users_GetFollowers_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.NameCase != nil {
+		if err = checkStringEnum("name_case", string(*r.NameCase), "nom", "gen", "dat", "acc", "ins", "abl"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Users_GetFollowers Returns a list of IDs of followers of the user in question, sorted by date added, most recent first.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -20950,6 +29644,9 @@ func (r Users_GetFollowers_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/users.getFollowers
 func (vk *VK) Users_GetFollowers(ctx context.Context, req Users_GetFollowers_Request, options ...Option) (resp Users_GetFollowers_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -20995,6 +29692,36 @@ func (r Users_GetSubscriptions_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Users_GetSubscriptions_Request) Validate() (err error) {
+	if r.UserId != nil {
+		if err = checkMinimum("user_id", float64(*r.UserId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Users_GetSubscriptions Returns a list of IDs of users and communities followed by the user.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -21002,6 +29729,9 @@ func (r Users_GetSubscriptions_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/users.getSubscriptions
 func (vk *VK) Users_GetSubscriptions(ctx context.Context, req Users_GetSubscriptions_Request, options ...Option) (resp Users_GetSubscriptions_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21019,6 +29749,9 @@ func (vk *VK) Users_GetSubscriptions(ctx context.Context, req Users_GetSubscript
 //
 // https://dev.vk.com/method/users.getSubscriptions
 func (vk *VK) Users_GetSubscriptionsExtended(ctx context.Context, req Users_GetSubscriptions_Request, options ...Option) (resp Users_GetSubscriptionsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21058,6 +29791,20 @@ func (r Users_Report_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Users_Report_Request) Validate() (err error) {
+	if err = checkMinimum("user_id", float64(r.UserId), 1); err != nil {
+		return
+	}
+	if err = checkRequired("type", r.Type != ""); err != nil {
+		return
+	}
+	if err = checkStringEnum("type", string(r.Type), "porn", "spam", "insult", "advertisement"); err != nil {
+		return
+	}
+	return
+}
+
 // Users_Report Reports (submits a complain about) a user.
 // May execute with listed access token types:
 //    [ user ]
@@ -21065,6 +29812,9 @@ func (r Users_Report_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/users.report
 func (vk *VK) Users_Report(ctx context.Context, req Users_Report_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21243,55 +29993,194 @@ func (r Users_Search_Request) fillIn(values url.Values) (err error) {
 		setInt(values, "status", int(*r.Status))
 	}
 	if r.AgeFrom != nil {
-		setInt(values, "age_from", *r.AgeFrom)
+		setInt(values, "age_from", *r.AgeFrom)
+	}
+	if r.AgeTo != nil {
+		setInt(values, "age_to", *r.AgeTo)
+	}
+	if r.BirthDay != nil {
+		setInt(values, "birth_day", *r.BirthDay)
+	}
+	if r.BirthMonth != nil {
+		setInt(values, "birth_month", *r.BirthMonth)
+	}
+	if r.BirthYear != nil {
+		setInt(values, "birth_year", *r.BirthYear)
+	}
+	if r.Online != nil {
+		setBool(values, "online", *r.Online)
+	}
+	if r.HasPhoto != nil {
+		setBool(values, "has_photo", *r.HasPhoto)
+	}
+	if r.SchoolCountry != nil {
+		setInt(values, "school_country", *r.SchoolCountry)
+	}
+	if r.SchoolCity != nil {
+		setInt(values, "school_city", *r.SchoolCity)
+	}
+	if r.SchoolClass != nil {
+		setInt(values, "school_class", *r.SchoolClass)
+	}
+	if r.School != nil {
+		setInt(values, "school", *r.School)
+	}
+	if r.SchoolYear != nil {
+		setInt(values, "school_year", *r.SchoolYear)
+	}
+	if r.Religion != nil {
+		setString(values, "religion", *r.Religion)
+	}
+	if r.Company != nil {
+		setString(values, "company", *r.Company)
+	}
+	if r.Position != nil {
+		setString(values, "position", *r.Position)
+	}
+	if r.GroupId != nil {
+		setInt(values, "group_id", *r.GroupId)
+	}
+	if r.FromList != nil {
+		setStrings(values, "from_list", *r.FromList)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Users_Search_Request) Validate() (err error) {
+	if r.Sort != nil {
+		if err = checkIntEnum("sort", int(*r.Sort), 0, 1); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.City != nil {
+		if err = checkMinimum("city", float64(*r.City), 0); err != nil {
+			return
+		}
+	}
+	if r.Country != nil {
+		if err = checkMinimum("country", float64(*r.Country), 0); err != nil {
+			return
+		}
+	}
+	if r.UniversityCountry != nil {
+		if err = checkMinimum("university_country", float64(*r.UniversityCountry), 0); err != nil {
+			return
+		}
+	}
+	if r.University != nil {
+		if err = checkMinimum("university", float64(*r.University), 0); err != nil {
+			return
+		}
+	}
+	if r.UniversityYear != nil {
+		if err = checkMinimum("university_year", float64(*r.UniversityYear), 0); err != nil {
+			return
+		}
+	}
+	if r.UniversityFaculty != nil {
+		if err = checkMinimum("university_faculty", float64(*r.UniversityFaculty), 0); err != nil {
+			return
+		}
+	}
+	if r.UniversityChair != nil {
+		if err = checkMinimum("university_chair", float64(*r.UniversityChair), 0); err != nil {
+			return
+		}
+	}
+	if r.Sex != nil {
+		if err = checkMinimum("sex", float64(*r.Sex), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("sex", int(*r.Sex), 0, 1, 2); err != nil {
+			return
+		}
+	}
+	if r.Status != nil {
+		if err = checkMinimum("status", float64(*r.Status), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("status", int(*r.Status), 0, 1, 2, 3, 4, 5, 6, 7); err != nil {
+			return
+		}
+	}
+	if r.AgeFrom != nil {
+		if err = checkMinimum("age_from", float64(*r.AgeFrom), 0); err != nil {
+			return
+		}
 	}
 	if r.AgeTo != nil {
-		setInt(values, "age_to", *r.AgeTo)
+		if err = checkMinimum("age_to", float64(*r.AgeTo), 0); err != nil {
+			return
+		}
 	}
 	if r.BirthDay != nil {
-		setInt(values, "birth_day", *r.BirthDay)
+		if err = checkMinimum("birth_day", float64(*r.BirthDay), 0); err != nil {
+			return
+		}
 	}
 	if r.BirthMonth != nil {
-		setInt(values, "birth_month", *r.BirthMonth)
+		if err = checkMinimum("birth_month", float64(*r.BirthMonth), 0); err != nil {
+			return
+		}
 	}
 	if r.BirthYear != nil {
-		setInt(values, "birth_year", *r.BirthYear)
-	}
-	if r.Online != nil {
-		setBool(values, "online", *r.Online)
-	}
-	if r.HasPhoto != nil {
-		setBool(values, "has_photo", *r.HasPhoto)
+		if err = checkMinimum("birth_year", float64(*r.BirthYear), 1900); err != nil {
+			return
+		}
+		if err = checkMaximum("birth_year", float64(*r.BirthYear), 2100); err != nil {
+			return
+		}
 	}
 	if r.SchoolCountry != nil {
-		setInt(values, "school_country", *r.SchoolCountry)
+		if err = checkMinimum("school_country", float64(*r.SchoolCountry), 0); err != nil {
+			return
+		}
 	}
 	if r.SchoolCity != nil {
-		setInt(values, "school_city", *r.SchoolCity)
+		if err = checkMinimum("school_city", float64(*r.SchoolCity), 0); err != nil {
+			return
+		}
 	}
 	if r.SchoolClass != nil {
-		setInt(values, "school_class", *r.SchoolClass)
+		if err = checkMinimum("school_class", float64(*r.SchoolClass), 0); err != nil {
+			return
+		}
 	}
 	if r.School != nil {
-		setInt(values, "school", *r.School)
+		if err = checkMinimum("school", float64(*r.School), 0); err != nil {
+			return
+		}
 	}
 	if r.SchoolYear != nil {
-		setInt(values, "school_year", *r.SchoolYear)
-	}
-	if r.Religion != nil {
-		setString(values, "religion", *r.Religion)
-	}
-	if r.Company != nil {
-		setString(values, "company", *r.Company)
-	}
-	if r.Position != nil {
-		setString(values, "position", *r.Position)
+		if err = checkMinimum("school_year", float64(*r.SchoolYear), 0); err != nil {
+			return
+		}
 	}
 	if r.GroupId != nil {
-		setInt(values, "group_id", *r.GroupId)
-	}
-	if r.FromList != nil {
-		setStrings(values, "from_list", *r.FromList)
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -21303,6 +30192,9 @@ func (r Users_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/users.search
 func (vk *VK) Users_Search(ctx context.Context, req Users_Search_Request, options ...Option) (resp Users_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 34+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21322,6 +30214,14 @@ func (r Utils_CheckLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Utils_CheckLink_Request) Validate() (err error) {
+	if err = checkRequired("url", r.Url != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Utils_CheckLink Checks whether a link is blocked in VK.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -21329,6 +30229,9 @@ func (r Utils_CheckLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/utils.checkLink
 func (vk *VK) Utils_CheckLink(ctx context.Context, req Utils_CheckLink_Request, options ...Option) (resp Utils_CheckLink_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21348,6 +30251,14 @@ func (r Utils_DeleteFromLastShortened_Request) fillIn(values url.Values) (err er
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Utils_DeleteFromLastShortened_Request) Validate() (err error) {
+	if err = checkRequired("key", r.Key != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Utils_DeleteFromLastShortened Deletes shortened link from user's list.
 // May execute with listed access token types:
 //    [ user ]
@@ -21355,6 +30266,9 @@ func (r Utils_DeleteFromLastShortened_Request) fillIn(values url.Values) (err er
 //
 // https://dev.vk.com/method/utils.deleteFromLastShortened
 func (vk *VK) Utils_DeleteFromLastShortened(ctx context.Context, req Utils_DeleteFromLastShortened_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21385,6 +30299,21 @@ func (r Utils_GetLastShortenedLinks_Request) fillIn(values url.Values) (err erro
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Utils_GetLastShortenedLinks_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Utils_GetLastShortenedLinks Returns a list of user's shortened links.
 // May execute with listed access token types:
 //    [ user ]
@@ -21392,6 +30321,9 @@ func (r Utils_GetLastShortenedLinks_Request) fillIn(values url.Values) (err erro
 //
 // https://dev.vk.com/method/utils.getLastShortenedLinks
 func (vk *VK) Utils_GetLastShortenedLinks(ctx context.Context, req Utils_GetLastShortenedLinks_Request, options ...Option) (resp Utils_GetLastShortenedLinks_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21453,6 +30385,32 @@ func (r Utils_GetLinkStats_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Utils_GetLinkStats_Request) Validate() (err error) {
+	if err = checkRequired("key", r.Key != ""); err != nil {
+		return
+	}
+	if r.Source != nil {
+		if err = checkStringEnum("source", string(*r.Source), "vk_cc", "vk_link"); err != nil {
+			return
+		}
+	}
+	if r.Interval != nil {
+		if err = checkStringEnum("interval", string(*r.Interval), "day", "forever", "hour", "month", "week"); err != nil {
+			return
+		}
+	}
+	if r.IntervalsCount != nil {
+		if err = checkMinimum("intervals_count", float64(*r.IntervalsCount), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("intervals_count", float64(*r.IntervalsCount), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Utils_GetLinkStats Returns stats data for shortened link.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -21461,6 +30419,9 @@ func (r Utils_GetLinkStats_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/utils.getLinkStats
 func (vk *VK) Utils_GetLinkStats(ctx context.Context, req Utils_GetLinkStats_Request, options ...Option) (resp Utils_GetLinkStats_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21479,6 +30440,9 @@ func (vk *VK) Utils_GetLinkStats(ctx context.Context, req Utils_GetLinkStats_Req
 //
 // https://dev.vk.com/method/utils.getLinkStats
 func (vk *VK) Utils_GetLinkStatsExtended(ctx context.Context, req Utils_GetLinkStats_Request, options ...Option) (resp Utils_GetLinkStatsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 8+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21518,6 +30482,14 @@ func (r Utils_GetShortLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Utils_GetShortLink_Request) Validate() (err error) {
+	if err = checkRequired("url", r.Url != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Utils_GetShortLink Allows to receive a link shortened via vk.cc.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -21525,6 +30497,9 @@ func (r Utils_GetShortLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/utils.getShortLink
 func (vk *VK) Utils_GetShortLink(ctx context.Context, req Utils_GetShortLink_Request, options ...Option) (resp Utils_GetShortLink_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21544,6 +30519,14 @@ func (r Utils_ResolveScreenName_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Utils_ResolveScreenName_Request) Validate() (err error) {
+	if err = checkRequired("screen_name", r.ScreenName != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Utils_ResolveScreenName Detects a type of object (e.g., user, community, application) and its ID by screen name.
 // May execute with listed access token types:
 //    [ user, group, service ]
@@ -21551,6 +30534,9 @@ func (r Utils_ResolveScreenName_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/utils.resolveScreenName
 func (vk *VK) Utils_ResolveScreenName(ctx context.Context, req Utils_ResolveScreenName_Request, options ...Option) (resp Utils_ResolveScreenName_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21582,6 +30568,14 @@ func (r Video_Add_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Add_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_Add Adds a video to a user or community page.
 // May execute with listed access token types:
 //    [ user ]
@@ -21590,6 +30584,9 @@ func (r Video_Add_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.add
 func (vk *VK) Video_Add(ctx context.Context, req Video_Add_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21635,6 +30632,23 @@ func (r Video_AddAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_AddAlbum_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.Privacy != nil {
+		for _, v := range *r.Privacy {
+			if err = checkStringEnum("privacy", string(v), "0", "1", "2", "3"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Video_AddAlbum Creates an empty album for videos.
 // May execute with listed access token types:
 //    [ user ]
@@ -21643,6 +30657,9 @@ func (r Video_AddAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.addAlbum
 func (vk *VK) Video_AddAlbum(ctx context.Context, req Video_AddAlbum_Request, options ...Option) (resp Video_AddAlbum_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21679,6 +30696,14 @@ func (r Video_AddToAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_AddToAlbum_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_AddToAlbum ...
 // May execute with listed access token types:
 //    [ user ]
@@ -21687,6 +30712,9 @@ func (r Video_AddToAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.addToAlbum
 func (vk *VK) Video_AddToAlbum(ctx context.Context, req Video_AddToAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21742,6 +30770,24 @@ func (r Video_CreateComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_CreateComment_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	if r.ReplyToComment != nil {
+		if err = checkMinimum("reply_to_comment", float64(*r.ReplyToComment), 0); err != nil {
+			return
+		}
+	}
+	if r.StickerId != nil {
+		if err = checkMinimum("sticker_id", float64(*r.StickerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_CreateComment Adds a new comment on a video.
 // May execute with listed access token types:
 //    [ user ]
@@ -21750,6 +30796,9 @@ func (r Video_CreateComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.createComment
 func (vk *VK) Video_CreateComment(ctx context.Context, req Video_CreateComment_Request, options ...Option) (resp Video_CreateComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21782,6 +30831,14 @@ func (r Video_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Delete_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_Delete Deletes a video from a user or community page.
 // May execute with listed access token types:
 //    [ user ]
@@ -21789,6 +30846,9 @@ func (r Video_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.delete
 func (vk *VK) Video_Delete(ctx context.Context, req Video_Delete_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21816,6 +30876,19 @@ func (r Video_DeleteAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_DeleteAlbum_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkMinimum("album_id", float64(r.AlbumId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_DeleteAlbum Deletes a video album.
 // May execute with listed access token types:
 //    [ user ]
@@ -21824,6 +30897,9 @@ func (r Video_DeleteAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.deleteAlbum
 func (vk *VK) Video_DeleteAlbum(ctx context.Context, req Video_DeleteAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21849,6 +30925,11 @@ func (r Video_DeleteComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_DeleteComment_Request) Validate() (err error) {
+	return
+}
+
 // Video_DeleteComment Deletes a comment on a video.
 // May execute with listed access token types:
 //    [ user ]
@@ -21856,6 +30937,9 @@ func (r Video_DeleteComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.deleteComment
 func (vk *VK) Video_DeleteComment(ctx context.Context, req Video_DeleteComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21911,6 +30995,14 @@ func (r Video_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_Edit Edits information about a video on a user or community page.
 // May execute with listed access token types:
 //    [ user ]
@@ -21918,6 +31010,9 @@ func (r Video_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.edit
 func (vk *VK) Video_Edit(ctx context.Context, req Video_Edit_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -21965,6 +31060,29 @@ func (r Video_EditAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_EditAlbum_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if err = checkMinimum("album_id", float64(r.AlbumId), 0); err != nil {
+		return
+	}
+	if err = checkRequired("title", r.Title != ""); err != nil {
+		return
+	}
+	if r.Privacy != nil {
+		for _, v := range *r.Privacy {
+			if err = checkStringEnum("privacy", string(v), "0", "1", "2", "3"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Video_EditAlbum Edits the title of a video album.
 // May execute with listed access token types:
 //    [ user ]
@@ -21973,6 +31091,9 @@ func (r Video_EditAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.editAlbum
 func (vk *VK) Video_EditAlbum(ctx context.Context, req Video_EditAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22007,6 +31128,11 @@ func (r Video_EditComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_EditComment_Request) Validate() (err error) {
+	return
+}
+
 // Video_EditComment Edits the text of a comment on a video.
 // May execute with listed access token types:
 //    [ user ]
@@ -22014,6 +31140,9 @@ func (r Video_EditComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.editComment
 func (vk *VK) Video_EditComment(ctx context.Context, req Video_EditComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22068,6 +31197,24 @@ func (r Video_Get_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Get_Request) Validate() (err error) {
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_Get Returns detailed information about videos.
 // May execute with listed access token types:
 //    [ user ]
@@ -22076,6 +31223,9 @@ func (r Video_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.get
 func (vk *VK) Video_Get(ctx context.Context, req Video_Get_Request, options ...Option) (resp Video_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22101,6 +31251,11 @@ func (r Video_GetAlbumById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_GetAlbumById_Request) Validate() (err error) {
+	return
+}
+
 // Video_GetAlbumById Returns video album info
 // May execute with listed access token types:
 //    [ user ]
@@ -22109,6 +31264,9 @@ func (r Video_GetAlbumById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.getAlbumById
 func (vk *VK) Video_GetAlbumById(ctx context.Context, req Video_GetAlbumById_Request, options ...Option) (resp Video_GetAlbumById_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22150,6 +31308,24 @@ func (r Video_GetAlbums_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_GetAlbums_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_GetAlbums Returns a list of video albums owned by a user or community.
 // May execute with listed access token types:
 //    [ user ]
@@ -22158,6 +31334,9 @@ func (r Video_GetAlbums_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.getAlbums
 func (vk *VK) Video_GetAlbums(ctx context.Context, req Video_GetAlbums_Request, options ...Option) (resp Video_GetAlbums_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22176,6 +31355,9 @@ func (vk *VK) Video_GetAlbums(ctx context.Context, req Video_GetAlbums_Request,
 //
 // https://dev.vk.com/method/video.getAlbums
 func (vk *VK) Video_GetAlbumsExtended(ctx context.Context, req Video_GetAlbums_Request, options ...Option) (resp Video_GetAlbumsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22205,6 +31387,14 @@ func (r Video_GetAlbumsByVideo_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_GetAlbumsByVideo_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_GetAlbumsByVideo ...
 // May execute with listed access token types:
 //    [ user ]
@@ -22213,6 +31403,9 @@ func (r Video_GetAlbumsByVideo_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.getAlbumsByVideo
 func (vk *VK) Video_GetAlbumsByVideo(ctx context.Context, req Video_GetAlbumsByVideo_Request, options ...Option) (resp Video_GetAlbumsByVideo_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22231,6 +31424,9 @@ func (vk *VK) Video_GetAlbumsByVideo(ctx context.Context, req Video_GetAlbumsByV
 //
 // https://dev.vk.com/method/video.getAlbumsByVideo
 func (vk *VK) Video_GetAlbumsByVideoExtended(ctx context.Context, req Video_GetAlbumsByVideo_Request, options ...Option) (resp Video_GetAlbumsByVideoExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22298,6 +31494,32 @@ func (r Video_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_GetComments_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	if r.StartCommentId != nil {
+		if err = checkMinimum("start_comment_id", float64(*r.StartCommentId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkStringEnum("sort", string(*r.Sort), "asc", "desc"); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_GetComments Returns a list of comments on a video.
 // May execute with listed access token types:
 //    [ user ]
@@ -22306,6 +31528,9 @@ func (r Video_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.getComments
 func (vk *VK) Video_GetComments(ctx context.Context, req Video_GetComments_Request, options ...Option) (resp Video_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22324,6 +31549,9 @@ func (vk *VK) Video_GetComments(ctx context.Context, req Video_GetComments_Reque
 //
 // https://dev.vk.com/method/video.getComments
 func (vk *VK) Video_GetCommentsExtended(ctx context.Context, req Video_GetComments_Request, options ...Option) (resp Video_GetCommentsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 11+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22361,6 +31589,14 @@ func (r Video_RemoveFromAlbum_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_RemoveFromAlbum_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_RemoveFromAlbum ...
 // May execute with listed access token types:
 //    [ user ]
@@ -22369,6 +31605,9 @@ func (r Video_RemoveFromAlbum_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.removeFromAlbum
 func (vk *VK) Video_RemoveFromAlbum(ctx context.Context, req Video_RemoveFromAlbum_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22407,6 +31646,24 @@ func (r Video_ReorderAlbums_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_ReorderAlbums_Request) Validate() (err error) {
+	if err = checkMinimum("album_id", float64(r.AlbumId), 0); err != nil {
+		return
+	}
+	if r.Before != nil {
+		if err = checkMinimum("before", float64(*r.Before), 0); err != nil {
+			return
+		}
+	}
+	if r.After != nil {
+		if err = checkMinimum("after", float64(*r.After), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_ReorderAlbums Reorders the album in the list of user video albums.
 // May execute with listed access token types:
 //    [ user ]
@@ -22415,6 +31672,9 @@ func (r Video_ReorderAlbums_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.reorderAlbums
 func (vk *VK) Video_ReorderAlbums(ctx context.Context, req Video_ReorderAlbums_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22478,6 +31738,24 @@ func (r Video_ReorderVideos_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_ReorderVideos_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	if r.BeforeVideoId != nil {
+		if err = checkMinimum("before_video_id", float64(*r.BeforeVideoId), 0); err != nil {
+			return
+		}
+	}
+	if r.AfterVideoId != nil {
+		if err = checkMinimum("after_video_id", float64(*r.AfterVideoId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_ReorderVideos Reorders the video in the video album.
 // May execute with listed access token types:
 //    [ user ]
@@ -22486,6 +31764,9 @@ func (r Video_ReorderVideos_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.reorderVideos
 func (vk *VK) Video_ReorderVideos(ctx context.Context, req Video_ReorderVideos_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22539,6 +31820,22 @@ func (r Video_Report_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Report_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("reason", int(*r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_Report Reports (submits a complaint about) a video.
 // May execute with listed access token types:
 //    [ user ]
@@ -22546,6 +31843,9 @@ func (r Video_Report_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.report
 func (vk *VK) Video_Report(ctx context.Context, req Video_Report_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22588,6 +31888,22 @@ func (r Video_ReportComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_ReportComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("reason", int(*r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_ReportComment Reports (submits a complaint about) a comment on a video.
 // May execute with listed access token types:
 //    [ user ]
@@ -22595,6 +31911,9 @@ func (r Video_ReportComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.reportComment
 func (vk *VK) Video_ReportComment(ctx context.Context, req Video_ReportComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22622,6 +31941,14 @@ func (r Video_Restore_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Restore_Request) Validate() (err error) {
+	if err = checkMinimum("video_id", float64(r.VideoId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Video_Restore Restores a previously deleted video.
 // May execute with listed access token types:
 //    [ user ]
@@ -22629,6 +31956,9 @@ func (r Video_Restore_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.restore
 func (vk *VK) Video_Restore(ctx context.Context, req Video_Restore_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22654,6 +31984,11 @@ func (r Video_RestoreComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_RestoreComment_Request) Validate() (err error) {
+	return
+}
+
 // Video_RestoreComment Restores a previously deleted comment on a video.
 // May execute with listed access token types:
 //    [ user ]
@@ -22661,6 +31996,9 @@ func (r Video_RestoreComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.restoreComment
 func (vk *VK) Video_RestoreComment(ctx context.Context, req Video_RestoreComment_Request, options ...Option) (resp Video_RestoreComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22736,6 +32074,21 @@ func (r Video_Save_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Save_Request) Validate() (err error) {
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	if r.AlbumId != nil {
+		if err = checkMinimum("album_id", float64(*r.AlbumId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_Save Returns a server address (required for upload) and video data.
 // May execute with listed access token types:
 //    [ user ]
@@ -22744,6 +32097,9 @@ func (r Video_Save_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.save
 func (vk *VK) Video_Save(ctx context.Context, req Video_Save_Request, options ...Option) (resp Video_Save_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22837,6 +32193,46 @@ func (r Video_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Video_Search_Request) Validate() (err error) {
+	if r.Sort != nil {
+		if err = checkIntEnum("sort", int(*r.Sort), 1, 2, 0); err != nil {
+			return
+		}
+	}
+	if r.Filters != nil {
+		for _, v := range *r.Filters {
+			if err = checkStringEnum("filters", string(v), "youtube", "vimeo", "short", "long"); err != nil {
+				return
+			}
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Longer != nil {
+		if err = checkMinimum("longer", float64(*r.Longer), 0); err != nil {
+			return
+		}
+	}
+	if r.Shorter != nil {
+		if err = checkMinimum("shorter", float64(*r.Shorter), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Video_Search Returns a list of videos under the set search criterion.
 // May execute with listed access token types:
 //    [ user ]
@@ -22845,6 +32241,9 @@ func (r Video_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/video.search
 func (vk *VK) Video_Search(ctx context.Context, req Video_Search_Request, options ...Option) (resp Video_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22863,6 +32262,9 @@ func (vk *VK) Video_Search(ctx context.Context, req Video_Search_Request, option
 //
 // https://dev.vk.com/method/video.search
 func (vk *VK) Video_SearchExtended(ctx context.Context, req Video_Search_Request, options ...Option) (resp Video_SearchExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22882,6 +32284,14 @@ func (r Wall_CheckCopyrightLink_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_CheckCopyrightLink_Request) Validate() (err error) {
+	if err = checkRequired("link", r.Link != ""); err != nil {
+		return
+	}
+	return
+}
+
 // Wall_CheckCopyrightLink ...
 // May execute with listed access token types:
 //    [ user ]
@@ -22890,6 +32300,9 @@ func (r Wall_CheckCopyrightLink_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.checkCopyrightLink
 func (vk *VK) Wall_CheckCopyrightLink(ctx context.Context, req Wall_CheckCopyrightLink_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 3+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22912,6 +32325,14 @@ func (r Wall_CloseComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_CloseComments_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Wall_CloseComments ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -22919,6 +32340,9 @@ func (r Wall_CloseComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.closeComments
 func (vk *VK) Wall_CloseComments(ctx context.Context, req Wall_CloseComments_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -22977,6 +32401,24 @@ func (r Wall_CreateComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_CreateComment_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	if r.FromGroup != nil {
+		if err = checkMinimum("from_group", float64(*r.FromGroup), 0); err != nil {
+			return
+		}
+	}
+	if r.StickerId != nil {
+		if err = checkMinimum("sticker_id", float64(*r.StickerId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_CreateComment Adds a comment to a post on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user, group ]
@@ -22985,6 +32427,9 @@ func (r Wall_CreateComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.createComment
 func (vk *VK) Wall_CreateComment(ctx context.Context, req Wall_CreateComment_Request, options ...Option) (resp Wall_CreateComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23013,6 +32458,16 @@ func (r Wall_Delete_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Delete_Request) Validate() (err error) {
+	if r.PostId != nil {
+		if err = checkMinimum("post_id", float64(*r.PostId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_Delete Deletes a post from a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -23021,6 +32476,9 @@ func (r Wall_Delete_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.delete
 func (vk *VK) Wall_Delete(ctx context.Context, req Wall_Delete_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23047,6 +32505,14 @@ func (r Wall_DeleteComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_DeleteComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Wall_DeleteComment Deletes a comment on a post on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -23055,6 +32521,9 @@ func (r Wall_DeleteComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.deleteComment
 func (vk *VK) Wall_DeleteComment(ctx context.Context, req Wall_DeleteComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23171,6 +32640,37 @@ func (r Wall_Edit_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Edit_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	if r.PublishDate != nil {
+		if err = checkMinimum("publish_date", float64(*r.PublishDate), 0); err != nil {
+			return
+		}
+	}
+	if r.PlaceId != nil {
+		if err = checkMinimum("place_id", float64(*r.PlaceId), 0); err != nil {
+			return
+		}
+	}
+	if r.PosterBkgId != nil {
+		if err = checkMinimum("poster_bkg_id", float64(*r.PosterBkgId), 0); err != nil {
+			return
+		}
+	}
+	if r.TopicId != nil {
+		if err = checkMinimum("topic_id", float64(*r.TopicId), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("topic_id", int(*r.TopicId), 0, 1, 7, 12, 16, 19, 21, 23, 25, 26, 32, 43); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_Edit Edits a post on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -23179,6 +32679,9 @@ func (r Wall_Edit_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.edit
 func (vk *VK) Wall_Edit(ctx context.Context, req Wall_Edit_Request, options ...Option) (resp Wall_Edit_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 21+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23255,6 +32758,19 @@ func (r Wall_EditAdsStealth_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_EditAdsStealth_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	if r.PlaceId != nil {
+		if err = checkMinimum("place_id", float64(*r.PlaceId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_EditAdsStealth Allows to edit hidden post.
 // May execute with listed access token types:
 //    [ user ]
@@ -23263,6 +32779,9 @@ func (r Wall_EditAdsStealth_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.editAdsStealth
 func (vk *VK) Wall_EditAdsStealth(ctx context.Context, req Wall_EditAdsStealth_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23298,6 +32817,14 @@ func (r Wall_EditComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_EditComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Wall_EditComment Edits a comment on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -23305,6 +32832,9 @@ func (r Wall_EditComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.editComment
 func (vk *VK) Wall_EditComment(ctx context.Context, req Wall_EditComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23344,14 +32874,41 @@ func (r Wall_Get_Request) fillIn(values url.Values) (err error) {
 		setInt(values, "count", *r.Count)
 	}
 	if r.Filter != nil {
-		setString(values, "filter", string(*r.Filter))
+		setString(values, "filter", string(*r.Filter))
+	}
+	if r.Fields != nil {
+		vs := make([]string, len(*r.Fields))
+		for i, v := range *r.Fields {
+			vs[i] = string(v)
+		}
+		setStrings(values, "fields", vs)
+	}
+	return
+}
+
+// Validate checks that request fields match limits of the method.
+func (r Wall_Get_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Filter != nil {
+		if err = checkStringEnum("filter", string(*r.Filter), "owner", "others", "all", "postponed", "suggests", "archived", "donut"); err != nil {
+			return
+		}
 	}
 	if r.Fields != nil {
-		vs := make([]string, len(*r.Fields))
-		for i, v := range *r.Fields {
-			vs[i] = string(v)
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
 		}
-		setStrings(values, "fields", vs)
 	}
 	return
 }
@@ -23364,6 +32921,9 @@ func (r Wall_Get_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.get
 func (vk *VK) Wall_Get(ctx context.Context, req Wall_Get_Request, options ...Option) (resp Wall_Get_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23382,6 +32942,9 @@ func (vk *VK) Wall_Get(ctx context.Context, req Wall_Get_Request, options ...Opt
 //
 // https://dev.vk.com/method/wall.get
 func (vk *VK) Wall_GetExtended(ctx context.Context, req Wall_Get_Request, options ...Option) (resp Wall_GetExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23417,6 +32980,18 @@ func (r Wall_GetById_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_GetById_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Wall_GetById Returns a list of posts from user or community walls by their IDs.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -23424,6 +32999,9 @@ func (r Wall_GetById_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.getById
 func (vk *VK) Wall_GetById(ctx context.Context, req Wall_GetById_Request, options ...Option) (resp Wall_GetByIdLegacy_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23441,6 +33019,9 @@ func (vk *VK) Wall_GetById(ctx context.Context, req Wall_GetById_Request, option
 //
 // https://dev.vk.com/method/wall.getById
 func (vk *VK) Wall_GetByIdExtended(ctx context.Context, req Wall_GetById_Request, options ...Option) (resp Wall_GetByIdExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23476,6 +33057,21 @@ func (r Wall_GetComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_GetComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Wall_GetComment Returns a comment on a post on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -23484,6 +33080,9 @@ func (r Wall_GetComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.getComment
 func (vk *VK) Wall_GetComment(ctx context.Context, req Wall_GetComment_Request, options ...Option) (resp Wall_GetComment_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23502,6 +33101,9 @@ func (vk *VK) Wall_GetComment(ctx context.Context, req Wall_GetComment_Request,
 //
 // https://dev.vk.com/method/wall.getComment
 func (vk *VK) Wall_GetCommentExtended(ctx context.Context, req Wall_GetComment_Request, options ...Option) (resp Wall_GetCommentExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23592,6 +33194,56 @@ func (r Wall_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_GetComments_Request) Validate() (err error) {
+	if r.PostId != nil {
+		if err = checkMinimum("post_id", float64(*r.PostId), 0); err != nil {
+			return
+		}
+	}
+	if r.StartCommentId != nil {
+		if err = checkMinimum("start_comment_id", float64(*r.StartCommentId), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+	}
+	if r.Sort != nil {
+		if err = checkStringEnum("sort", string(*r.Sort), "asc", "desc"); err != nil {
+			return
+		}
+	}
+	if r.PreviewLength != nil {
+		if err = checkMinimum("preview_length", float64(*r.PreviewLength), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	if r.CommentId != nil {
+		if err = checkMinimum("comment_id", float64(*r.CommentId), 0); err != nil {
+			return
+		}
+	}
+	if r.ThreadItemsCount != nil {
+		if err = checkMinimum("thread_items_count", float64(*r.ThreadItemsCount), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("thread_items_count", float64(*r.ThreadItemsCount), 10); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_GetComments Returns a list of comments on a post on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -23600,6 +33252,9 @@ func (r Wall_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.getComments
 func (vk *VK) Wall_GetComments(ctx context.Context, req Wall_GetComments_Request, options ...Option) (resp Wall_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23618,6 +33273,9 @@ func (vk *VK) Wall_GetComments(ctx context.Context, req Wall_GetComments_Request
 //
 // https://dev.vk.com/method/wall.getComments
 func (vk *VK) Wall_GetCommentsExtended(ctx context.Context, req Wall_GetComments_Request, options ...Option) (resp Wall_GetCommentsExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23661,6 +33319,29 @@ func (r Wall_GetReposts_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_GetReposts_Request) Validate() (err error) {
+	if r.PostId != nil {
+		if err = checkMinimum("post_id", float64(*r.PostId), 0); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 1000); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_GetReposts Returns information about reposts of a post on user wall or community wall.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -23668,6 +33349,9 @@ func (r Wall_GetReposts_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.getReposts
 func (vk *VK) Wall_GetReposts(ctx context.Context, req Wall_GetReposts_Request, options ...Option) (resp Wall_GetReposts_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 6+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23690,6 +33374,14 @@ func (r Wall_OpenComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_OpenComments_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Wall_OpenComments ...
 // May execute with listed access token types:
 //    [ user, group ]
@@ -23697,6 +33389,9 @@ func (r Wall_OpenComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.openComments
 func (vk *VK) Wall_OpenComments(ctx context.Context, req Wall_OpenComments_Request, options ...Option) (resp Base_Bool_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23723,6 +33418,14 @@ func (r Wall_Pin_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Pin_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Wall_Pin Pins the post on wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -23730,6 +33433,9 @@ func (r Wall_Pin_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.pin
 func (vk *VK) Wall_Pin(ctx context.Context, req Wall_Pin_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23773,7 +33479,7 @@ type Wall_Post_Request struct {
 	Signed *bool
 	// Publication date (in Unix time). If used, posting will be delayed until the set time.
 	//  Minimum: 0
-	PublishDate *int
+	PublishDate *UnixTime
 	// Geographical latitude of a check-in, in degrees (from -90 to 90).
 	Lat *float64
 	// Geographical longitude of a check-in, in degrees (from -180 to 180).
@@ -23819,7 +33525,7 @@ func (r Wall_Post_Request) fillIn(values url.Values) (err error) {
 		setBool(values, "signed", *r.Signed)
 	}
 	if r.PublishDate != nil {
-		setInt(values, "publish_date", *r.PublishDate)
+		setInt(values, "publish_date", int(*r.PublishDate))
 	}
 	if r.Lat != nil {
 		setFloat(values, "lat", *r.Lat)
@@ -23857,6 +33563,34 @@ func (r Wall_Post_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Post_Request) Validate() (err error) {
+	if r.PublishDate != nil {
+		if err = checkMinimum("publish_date", float64(*r.PublishDate), 0); err != nil {
+			return
+		}
+	}
+	if r.PlaceId != nil {
+		if err = checkMinimum("place_id", float64(*r.PlaceId), 0); err != nil {
+			return
+		}
+	}
+	if r.PostId != nil {
+		if err = checkMinimum("post_id", float64(*r.PostId), 0); err != nil {
+			return
+		}
+	}
+	if r.TopicId != nil {
+		if err = checkMinimum("topic_id", float64(*r.TopicId), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("topic_id", int(*r.TopicId), 0, 1, 7, 12, 16, 19, 21, 23, 25, 26, 32, 43); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_Post Adds a new post on a user wall or community wall. Can also be used to publish suggested or scheduled posts.
 // May execute with listed access token types:
 //    [ user ]
@@ -23865,6 +33599,9 @@ func (r Wall_Post_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.post
 func (vk *VK) Wall_Post(ctx context.Context, req Wall_Post_Request, options ...Option) (resp Wall_Post_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 21+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23940,6 +33677,16 @@ func (r Wall_PostAdsStealth_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_PostAdsStealth_Request) Validate() (err error) {
+	if r.PlaceId != nil {
+		if err = checkMinimum("place_id", float64(*r.PlaceId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_PostAdsStealth Allows to create hidden post which will not be shown on the community's wall and can be used for creating an ad with type "Community post".
 // May execute with listed access token types:
 //    [ user ]
@@ -23948,6 +33695,9 @@ func (r Wall_PostAdsStealth_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.postAdsStealth
 func (vk *VK) Wall_PostAdsStealth(ctx context.Context, req Wall_PostAdsStealth_Request, options ...Option) (resp Wall_PostAdsStealth_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 14+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -23990,6 +33740,22 @@ func (r Wall_ReportComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_ReportComment_Request) Validate() (err error) {
+	if err = checkMinimum("comment_id", float64(r.CommentId), 0); err != nil {
+		return
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("reason", int(*r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_ReportComment Reports (submits a complaint about) a comment on a post on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -23997,6 +33763,9 @@ func (r Wall_ReportComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.reportComment
 func (vk *VK) Wall_ReportComment(ctx context.Context, req Wall_ReportComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24039,6 +33808,22 @@ func (r Wall_ReportPost_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_ReportPost_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	if r.Reason != nil {
+		if err = checkMinimum("reason", float64(*r.Reason), 0); err != nil {
+			return
+		}
+		if err = checkIntEnum("reason", int(*r.Reason), 0, 1, 2, 3, 4, 5, 6); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_ReportPost Reports (submits a complaint about) a post on a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -24046,6 +33831,9 @@ func (r Wall_ReportPost_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.reportPost
 func (vk *VK) Wall_ReportPost(ctx context.Context, req Wall_ReportPost_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 5+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24086,6 +33874,19 @@ func (r Wall_Repost_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Repost_Request) Validate() (err error) {
+	if err = checkRequired("object", r.Object != ""); err != nil {
+		return
+	}
+	if r.GroupId != nil {
+		if err = checkMinimum("group_id", float64(*r.GroupId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_Repost Reposts (copies) an object to a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -24094,6 +33895,9 @@ func (r Wall_Repost_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.repost
 func (vk *VK) Wall_Repost(ctx context.Context, req Wall_Repost_Request, options ...Option) (resp Wall_Repost_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24122,6 +33926,16 @@ func (r Wall_Restore_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Restore_Request) Validate() (err error) {
+	if r.PostId != nil {
+		if err = checkMinimum("post_id", float64(*r.PostId), 0); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Wall_Restore Restores a post deleted from a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -24130,6 +33944,9 @@ func (r Wall_Restore_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.restore
 func (vk *VK) Wall_Restore(ctx context.Context, req Wall_Restore_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24155,6 +33972,11 @@ func (r Wall_RestoreComment_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_RestoreComment_Request) Validate() (err error) {
+	return
+}
+
 // Wall_RestoreComment Restores a comment deleted from a user wall or community wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -24163,6 +33985,9 @@ func (r Wall_RestoreComment_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.restoreComment
 func (vk *VK) Wall_RestoreComment(ctx context.Context, req Wall_RestoreComment_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24224,6 +34049,36 @@ func (r Wall_Search_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Search_Request) Validate() (err error) {
+	if r.Query != nil {
+		if err = checkMaxLength("query", *r.Query, 9000); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 0); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 100); err != nil {
+			return
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "about", "action_button", "activities", "activity", "addresses", "admin_level", "age_limits", "author_id", "ban_info", "bdate", "blacklisted", "blacklisted_by_me", "books", "can_create_topic", "can_message", "can_post", "can_see_all_posts", "can_see_audio", "can_send_friend_request", "can_upload_video", "can_write_private_message", "career", "city", "common_count", "connections", "contacts", "counters", "country", "cover", "crop_photo", "deactivated", "description", "domain", "education", "exports", "finish_date", "fixed_post", "followers_count", "friend_status", "games", "has_market_app", "has_mobile", "has_photo", "home_town", "id", "interests", "is_admin", "is_closed", "is_favorite", "is_friend", "is_hidden_from_feed", "is_member", "is_messages_blocked", "can_send_notify", "is_subscribed", "last_seen", "links", "lists", "maiden_name", "main_album_id", "main_section", "market", "member_status", "members_count", "military", "movies", "music", "name", "nickname", "occupation", "online", "online_status", "personal", "phone", "photo_100", "photo_200", "photo_200_orig", "photo_400_orig", "photo_50", "photo_id", "photo_max", "photo_max_orig", "quotes", "relation", "relatives", "schools", "screen_name", "sex", "site", "start_date", "status", "timezone", "trending", "tv", "type", "universities", "verified", "wall_comments", "wiki_page", "first_name", "first_name_acc", "first_name_dat", "first_name_gen", "last_name", "last_name_acc", "last_name_dat", "last_name_gen", "can_subscribe_stories", "is_subscribed_stories", "vk_admin_status", "can_upload_story"); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
 // Wall_Search Allows to search posts on user or community walls.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -24232,6 +34087,9 @@ func (r Wall_Search_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.search
 func (vk *VK) Wall_Search(ctx context.Context, req Wall_Search_Request, options ...Option) (resp Wall_Search_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24250,6 +34108,9 @@ func (vk *VK) Wall_Search(ctx context.Context, req Wall_Search_Request, options
 //
 // https://dev.vk.com/method/wall.search
 func (vk *VK) Wall_SearchExtended(ctx context.Context, req Wall_Search_Request, options ...Option) (resp Wall_SearchExtended_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 10+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24277,6 +34138,14 @@ func (r Wall_Unpin_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Wall_Unpin_Request) Validate() (err error) {
+	if err = checkMinimum("post_id", float64(r.PostId), 0); err != nil {
+		return
+	}
+	return
+}
+
 // Wall_Unpin Unpins the post on wall.
 // May execute with listed access token types:
 //    [ user ]
@@ -24284,6 +34153,9 @@ func (r Wall_Unpin_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/wall.unpin
 func (vk *VK) Wall_Unpin(ctx context.Context, req Wall_Unpin_Request, options ...Option) (resp Base_Ok_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 4+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24338,6 +34210,31 @@ func (r Widgets_GetComments_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Widgets_GetComments_Request) Validate() (err error) {
+	if r.Fields != nil {
+		for _, v := range *r.Fields {
+			if err = checkStringEnum("fields", string(v), "first_name_nom", "first_name_gen", "first_name_dat", "first_name_acc", "first_name_ins", "first_name_abl", "last_name_nom", "last_name_gen", "last_name_dat", "last_name_acc", "last_name_ins", "last_name_abl", "photo_id", "verified", "sex", "bdate", "bdate_visibility", "city", "country", "home_town", "has_photo", "photo", "photo_rec", "photo_50", "photo_100", "photo_200_orig", "photo_200", "photo_400", "photo_400_orig", "photo_big", "photo_medium", "photo_medium_rec", "photo_max", "photo_max_orig", "photo_max_size", "third_party_buttons", "online", "lists", "domain", "has_mobile", "contacts", "language", "site", "education", "universities", "schools", "status", "last_seen", "followers_count", "counters", "common_count", "online_info", "occupation", "nickname", "relatives", "relation", "personal", "connections", "exports", "wall_comments", "wall_default", "activities", "activity", "interests", "music", "movies", "tv", "books", "is_no_index", "games", "about", "quotes", "can_post", "can_see_all_posts", "can_see_audio", "can_see_gifts", "work", "places", "can_write_private_message", "can_send_friend_request", "can_upload_doc", "is_favorite", "is_hidden_from_feed", "timezone", "screen_name", "maiden_name", "crop_photo", "is_friend", "friend_status", "career", "military", "blacklisted", "blacklisted_by_me", "can_subscribe_posts", "descriptions", "trending", "mutual", "friendship_weeks", "can_invite_to_chats", "stories_archive_count", "has_unseen_stories", "video_live", "video_live_level", "video_live_count", "clips_count", "service_description", "can_see_wishes", "is_subscribed_podcasts", "can_subscribe_podcasts"); err != nil {
+				return
+			}
+		}
+	}
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 10); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Widgets_GetComments Gets a list of comments for the page added through the [vk.com/dev/Comments|Comments widget].
 // May execute with listed access token types:
 //    [ user, service ]
@@ -24345,6 +34242,9 @@ func (r Widgets_GetComments_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/widgets.getComments
 func (vk *VK) Widgets_GetComments(ctx context.Context, req Widgets_GetComments_Request, options ...Option) (resp Widgets_GetComments_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 9+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
@@ -24388,6 +34288,24 @@ func (r Widgets_GetPages_Request) fillIn(values url.Values) (err error) {
 	return
 }
 
+// Validate checks that request fields match limits of the method.
+func (r Widgets_GetPages_Request) Validate() (err error) {
+	if r.Offset != nil {
+		if err = checkMinimum("offset", float64(*r.Offset), 0); err != nil {
+			return
+		}
+	}
+	if r.Count != nil {
+		if err = checkMinimum("count", float64(*r.Count), 10); err != nil {
+			return
+		}
+		if err = checkMaximum("count", float64(*r.Count), 200); err != nil {
+			return
+		}
+	}
+	return
+}
+
 // Widgets_GetPages Gets a list of application/site pages where the [vk.com/dev/Comments|Comments widget] or [vk.com/dev/Like|Like widget] is installed.
 // May execute with listed access token types:
 //    [ user, service ]
@@ -24395,6 +34313,9 @@ func (r Widgets_GetPages_Request) fillIn(values url.Values) (err error) {
 //
 // https://dev.vk.com/method/widgets.getPages
 func (vk *VK) Widgets_GetPages(ctx context.Context, req Widgets_GetPages_Request, options ...Option) (resp Widgets_GetPages_Response, apiErr ApiError, err error) {
+	if err = vk.checkRequest(req); err != nil {
+		return
+	}
 	values := make(url.Values, 7+len(options))
 	if err = req.fillIn(values); err != nil {
 		return
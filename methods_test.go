@@ -9310,10 +9310,10 @@ func fillRandomly_Groups_Edit_Request(r *Groups_Edit_Request) {
 	*(*r).Phone = randString()
 	(*r).Rss = new(string)
 	*(*r).Rss = randString()
-	(*r).EventStartDate = new(int)
-	*(*r).EventStartDate = randInt()
-	(*r).EventFinishDate = new(int)
-	*(*r).EventFinishDate = randInt()
+	(*r).EventStartDate = new(UnixTime)
+	*(*r).EventStartDate = randUnixTime()
+	(*r).EventFinishDate = new(UnixTime)
+	*(*r).EventFinishDate = randUnixTime()
 	(*r).EventGroupId = new(int)
 	*(*r).EventGroupId = randInt()
 	(*r).PublicCategory = new(int)
@@ -16213,8 +16213,8 @@ func fillRandomly_Messages_Search_Request(r *Messages_Search_Request) {
 	*(*r).Q = randString()
 	(*r).PeerId = new(int)
 	*(*r).PeerId = randInt()
-	(*r).Date = new(int)
-	*(*r).Date = randInt()
+	(*r).Date = new(UnixTime)
+	*(*r).Date = randUnixTime()
 	(*r).PreviewLength = new(int)
 	*(*r).PreviewLength = randInt()
 	(*r).Offset = new(int)
@@ -17003,10 +17003,10 @@ func fillRandomly_Newsfeed_Get_Request(r *Newsfeed_Get_Request) {
 	}
 	(*r).ReturnBanned = new(bool)
 	*(*r).ReturnBanned = randBool()
-	(*r).StartTime = new(int)
-	*(*r).StartTime = randInt()
-	(*r).EndTime = new(int)
-	*(*r).EndTime = randInt()
+	(*r).StartTime = new(UnixTime)
+	*(*r).StartTime = randUnixTime()
+	(*r).EndTime = new(UnixTime)
+	*(*r).EndTime = randUnixTime()
 	(*r).MaxPhotos = new(int)
 	*(*r).MaxPhotos = randInt()
 	(*r).SourceIds = new(string)
@@ -17180,10 +17180,10 @@ func fillRandomly_Newsfeed_GetComments_Request(r *Newsfeed_GetComments_Request)
 	}
 	(*r).Reposts = new(string)
 	*(*r).Reposts = randString()
-	(*r).StartTime = new(int)
-	*(*r).StartTime = randInt()
-	(*r).EndTime = new(int)
-	*(*r).EndTime = randInt()
+	(*r).StartTime = new(UnixTime)
+	*(*r).StartTime = randUnixTime()
+	(*r).EndTime = new(UnixTime)
+	*(*r).EndTime = randUnixTime()
 	(*r).LastCommentsCount = new(int)
 	*(*r).LastCommentsCount = randInt()
 	(*r).StartFrom = new(string)
@@ -17324,10 +17324,10 @@ func TestVK_Newsfeed_GetListsExtended_Error(t *testing.T) {
 func fillRandomly_Newsfeed_GetMentions_Request(r *Newsfeed_GetMentions_Request) {
 	(*r).OwnerId = new(int)
 	*(*r).OwnerId = randInt()
-	(*r).StartTime = new(int)
-	*(*r).StartTime = randInt()
-	(*r).EndTime = new(int)
-	*(*r).EndTime = randInt()
+	(*r).StartTime = new(UnixTime)
+	*(*r).StartTime = randUnixTime()
+	(*r).EndTime = new(UnixTime)
+	*(*r).EndTime = randUnixTime()
 	(*r).Offset = new(int)
 	*(*r).Offset = randInt()
 	(*r).Count = new(int)
@@ -17373,10 +17373,10 @@ func TestVK_Newsfeed_GetMentions_Error(t *testing.T) {
 }
 
 func fillRandomly_Newsfeed_GetRecommended_Request(r *Newsfeed_GetRecommended_Request) {
-	(*r).StartTime = new(int)
-	*(*r).StartTime = randInt()
-	(*r).EndTime = new(int)
-	*(*r).EndTime = randInt()
+	(*r).StartTime = new(UnixTime)
+	*(*r).StartTime = randUnixTime()
+	(*r).EndTime = new(UnixTime)
+	*(*r).EndTime = randUnixTime()
 	(*r).MaxPhotos = new(int)
 	*(*r).MaxPhotos = randInt()
 	(*r).StartFrom = new(string)
@@ -17589,10 +17589,10 @@ func fillRandomly_Newsfeed_Search_Request(r *Newsfeed_Search_Request) {
 	*(*r).Latitude = randFloat()
 	(*r).Longitude = new(float64)
 	*(*r).Longitude = randFloat()
-	(*r).StartTime = new(int)
-	*(*r).StartTime = randInt()
-	(*r).EndTime = new(int)
-	*(*r).EndTime = randInt()
+	(*r).StartTime = new(UnixTime)
+	*(*r).StartTime = randUnixTime()
+	(*r).EndTime = new(UnixTime)
+	*(*r).EndTime = randUnixTime()
 	(*r).StartFrom = new(string)
 	*(*r).StartFrom = randString()
 	(*r).Fields = new([]Base_UserGroupFields)
@@ -18321,10 +18321,10 @@ func fillRandomly_Notifications_Get_Request(r *Notifications_Get_Request) {
 	for i0 := 0; i0 < l0; i0++ {
 		fillRandomly_Notifications_Get_Filters(&(*(*r).Filters)[i0])
 	}
-	(*r).StartTime = new(int)
-	*(*r).StartTime = randInt()
-	(*r).EndTime = new(int)
-	*(*r).EndTime = randInt()
+	(*r).StartTime = new(UnixTime)
+	*(*r).StartTime = randUnixTime()
+	(*r).EndTime = new(UnixTime)
+	*(*r).EndTime = randUnixTime()
 }
 
 func TestVK_Notifications_Get_Success(t *testing.T) {
@@ -19781,8 +19781,8 @@ func fillRandomly_Photos_Get_Request(r *Photos_Get_Request) {
 	*(*r).Extended = randBool()
 	(*r).FeedType = new(string)
 	*(*r).FeedType = randString()
-	(*r).Feed = new(int)
-	*(*r).Feed = randInt()
+	(*r).Feed = new(UnixTime)
+	*(*r).Feed = randUnixTime()
 	(*r).PhotoSizes = new(bool)
 	*(*r).PhotoSizes = randBool()
 	(*r).Offset = new(int)
@@ -22701,10 +22701,10 @@ func TestVK_Secure_GetAppBalance_Error(t *testing.T) {
 func fillRandomly_Secure_GetSMSHistory_Request(r *Secure_GetSMSHistory_Request) {
 	(*r).UserId = new(int)
 	*(*r).UserId = randInt()
-	(*r).DateFrom = new(int)
-	*(*r).DateFrom = randInt()
-	(*r).DateTo = new(int)
-	*(*r).DateTo = randInt()
+	(*r).DateFrom = new(UnixTime)
+	*(*r).DateFrom = randUnixTime()
+	(*r).DateTo = new(UnixTime)
+	*(*r).DateTo = randUnixTime()
 	(*r).Limit = new(int)
 	*(*r).Limit = randInt()
 }
@@ -28138,8 +28138,8 @@ func fillRandomly_Wall_Post_Request(r *Wall_Post_Request) {
 	*(*r).Services = randString()
 	(*r).Signed = new(bool)
 	*(*r).Signed = randBool()
-	(*r).PublishDate = new(int)
-	*(*r).PublishDate = randInt()
+	(*r).PublishDate = new(UnixTime)
+	*(*r).PublishDate = randUnixTime()
 	(*r).Lat = new(float64)
 	*(*r).Lat = randFloat()
 	(*r).Long = new(float64)
@@ -0,0 +1,96 @@
+package vk_sdk
+
+import (
+	"context"
+	"net/url"
+)
+
+// Invocation is a single API method call passed through middlewares.
+type Invocation struct {
+	// Method is API method name, e.g. "users.get".
+	Method string
+	// Values are method parameters. Middleware may change them before calling next Invoker,
+	// for example set "access_token" to use another token.
+	Values url.Values
+	// Dst is pointer to generated *_Response struct the result is unmarshalled to.
+	// It is filled in after the next Invoker returns without errors.
+	Dst interface{}
+}
+
+// Invoker calls API method and returns ApiError or error of the call.
+type Invoker func(ctx context.Context, inv *Invocation) (ApiError, error)
+
+// Middleware wraps Invoker to add behavior to all method calls,
+// like logging, metrics, caching or token rotation.
+//
+//    logging := func(next vk_sdk.Invoker) vk_sdk.Invoker {
+//        return func(ctx context.Context, inv *vk_sdk.Invocation) (vk_sdk.ApiError, error) {
+//            apiErr, err := next(ctx, inv)
+//            log.Println(inv.Method, apiErr, err)
+//            return apiErr, err
+//        }
+//    }
+//
+//    vk.Use(logging)
+type Middleware func(next Invoker) Invoker
+
+// Use adds middlewares to the chain of method calls.
+// The first added middleware is the outermost one and sees the call first.
+// Middlewares wrap coalescing and retries set by VK.SetCoalescing and VK.SetRetryPolicy.
+func (vk *VK) Use(middlewares ...Middleware) {
+	vk.middlewares = append(vk.middlewares, middlewares...)
+
+	invoker := Invoker(vk.invoke)
+
+	for i := len(vk.middlewares) - 1; i >= 0; i-- {
+		invoker = vk.middlewares[i](invoker)
+	}
+
+	vk.invoker = invoker
+}
+
+// invoke is the last Invoker of the chain that sends the call to API
+// and repeats it after the ApiError is resolved by captcha solver, validation or confirmation handlers.
+func (vk *VK) invoke(ctx context.Context, inv *Invocation) (ApiError, error) {
+	var apiErr ApiError
+	var err error
+
+	if vk.coalescer != nil && canCoalesce(inv.Method, inv.Values) {
+		apiErr, err = vk.coalescer.do(ctx, inv.Method, inv.Values, inv.Dst)
+	} else {
+		apiErr, err = vk.send(inv.Method, ctx, inv.Values, inv.Dst)
+	}
+
+	captchas := 0
+	validated, confirmed := false, false
+
+	for apiErr != nil && err == nil {
+		switch {
+		case apiErr.Is(Error_Captcha) && vk.captchaSolver != nil && captchas < vk.captchaAttempts:
+			captchas++
+			err = vk.solveCaptcha(ctx, inv, apiErr)
+		case apiErr.Is(Error_AuthValidation) && vk.validationHandler != nil && !validated:
+			validated = true
+			err = vk.validate(ctx, inv, apiErr)
+		case apiErr.Is(Error_NeedConfirmation) && vk.confirmationHandler != nil && !confirmed:
+			confirmed = true
+
+			var ok bool
+
+			if ok, err = vk.confirm(ctx, inv, apiErr); !ok && err == nil {
+				return apiErr, nil
+			}
+		default:
+			return apiErr, nil
+		}
+
+		if err != nil {
+			return apiErr, err
+		}
+
+		// repeated calls are not coalesced, because they have their own parameters
+		apiErr, err = vk.send(inv.Method, ctx, inv.Values, inv.Dst)
+	}
+
+	return apiErr, err
+}
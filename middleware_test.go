@@ -0,0 +1,67 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestVK_Use(t *testing.T) {
+	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		reqBody, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		values, err := url.ParseQuery(string(reqBody))
+		require.NoError(t, err)
+
+		assert.Equal(t, "rotated", values.Get(tokenKey))
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"response":[{"id":1}]}`)),
+		}, nil
+	})
+
+	vk := NewVK(&http.Client{Transport: transport}, "token")
+
+	var calls []string
+
+	record := func(name string) Middleware {
+		return func(next Invoker) Invoker {
+			return func(ctx context.Context, inv *Invocation) (ApiError, error) {
+				calls = append(calls, name+" "+inv.Method)
+
+				apiErr, err := next(ctx, inv)
+
+				if resp, ok := inv.Dst.(*Users_Get_Response); ok && len(resp.Response) > 0 {
+					calls = append(calls, name+" done")
+				}
+
+				return apiErr, err
+			}
+		}
+	}
+
+	rotate := func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (ApiError, error) {
+			inv.Values.Set(tokenKey, "rotated")
+			return next(ctx, inv)
+		}
+	}
+
+	vk.Use(record("first"), record("second"))
+	vk.Use(rotate)
+
+	resp, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	require.Len(t, resp.Response, 1)
+
+	assert.Equal(t, []string{"first users.get", "second users.get", "second done", "first done"}, calls)
+}
@@ -222,7 +222,7 @@ type Account_PushSettings struct {
 	// Information whether notifications are disabled
 	Disabled *Base_BoolInt `json:"disabled,omitempty"`
 	// Time until that notifications are disabled in Unixtime
-	DisabledUntil *int                `json:"disabled_until,omitempty"`
+	DisabledUntil *UnixTime           `json:"disabled_until,omitempty"`
 	Settings      *Account_PushParams `json:"settings,omitempty"`
 }
 
@@ -425,7 +425,7 @@ type Ads_Campaign struct {
 	// Campaign's total limit, rubles
 	AllLimit string `json:"all_limit"`
 	// Campaign create time, as Unixtime
-	CreateTime *int `json:"create_time,omitempty"`
+	CreateTime *UnixTime `json:"create_time,omitempty"`
 	// Campaign's day limit, rubles
 	DayLimit string `json:"day_limit"`
 	// Campaign goal type
@@ -437,13 +437,13 @@ type Ads_Campaign struct {
 	// Campaign title
 	Name string `json:"name"`
 	// Campaign start time, as Unixtime
-	StartTime int                `json:"start_time"`
+	StartTime UnixTime           `json:"start_time"`
 	Status    Ads_CampaignStatus `json:"status"`
 	// Campaign stop time, as Unixtime
-	StopTime int              `json:"stop_time"`
+	StopTime UnixTime         `json:"stop_time"`
 	Type     Ads_CampaignType `json:"type"`
 	// Campaign update time, as Unixtime
-	UpdateTime *int `json:"update_time,omitempty"`
+	UpdateTime *UnixTime `json:"update_time,omitempty"`
 	// Campaign user goal type
 	UserGoalType *int `json:"user_goal_type,omitempty"`
 	// Limit of views per user per campaign
@@ -649,13 +649,13 @@ type Ads_LookalikeRequest struct {
 	//  Minimum: 0
 	AudienceCount *int `json:"audience_count,omitempty"`
 	// Lookalike request create time, as Unixtime
-	CreateTime int `json:"create_time"`
+	CreateTime UnixTime `json:"create_time"`
 	// Lookalike request ID
 	//  Minimum: 1
 	Id                 int                                      `json:"id"`
 	SaveAudienceLevels *[]Ads_LookalikeRequestSaveAudienceLevel `json:"save_audience_levels,omitempty"`
 	// Time by which lookalike request would be deleted, as Unixtime
-	ScheduledDeleteTime *int `json:"scheduled_delete_time,omitempty"`
+	ScheduledDeleteTime *UnixTime `json:"scheduled_delete_time,omitempty"`
 	// Lookalike request seed name (retargeting group name)
 	SourceName *string `json:"source_name,omitempty"`
 	// Retargeting group id, which was used as lookalike seed
@@ -666,7 +666,7 @@ type Ads_LookalikeRequest struct {
 	// Lookalike request status
 	Status Ads_LookalikeRequest_Status `json:"status"`
 	// Lookalike request update time, as Unixtime
-	UpdateTime int `json:"update_time"`
+	UpdateTime UnixTime `json:"update_time"`
 }
 
 type Ads_LookalikeRequestSaveAudienceLevel struct {
@@ -1059,7 +1059,7 @@ type Apps_App struct {
 	// Application ID in store
 	PlatformId *string `json:"platform_id,omitempty"`
 	// Date when the application has been published in Unixtime
-	PublishedDate *int `json:"published_date,omitempty"`
+	PublishedDate *UnixTime `json:"published_date,omitempty"`
 	// Is push enabled
 	PushEnabled *Base_BoolInt `json:"push_enabled,omitempty"`
 	// Screen name
@@ -1509,7 +1509,7 @@ type Base_Place struct {
 	// Country name
 	Country *string `json:"country,omitempty"`
 	// Date of the place creation in Unixtime
-	Created *int `json:"created,omitempty"`
+	Created *UnixTime `json:"created,omitempty"`
 	// URL of the place's icon
 	//  Format: uri
 	Icon *string `json:"icon,omitempty"`
@@ -1571,7 +1571,7 @@ func (o *Base_Sticker) MarshalJSON() ([]byte, error) {
 }
 
 func (o *Base_Sticker) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -1579,6 +1579,28 @@ func (o Base_Sticker) Raw() []byte {
 	return o.raw
 }
 
+// AsStickerOld returns Base_StickerOld variant, if present.
+func (o Base_Sticker) AsStickerOld() (v Base_StickerOld, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewBase_StickerFromStickerOld returns Base_Sticker with Base_StickerOld variant.
+func NewBase_StickerFromStickerOld(v Base_StickerOld) (o Base_Sticker, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsStickerNew returns Base_StickerNew variant, if present.
+func (o Base_Sticker) AsStickerNew() (v Base_StickerNew, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewBase_StickerFromStickerNew returns Base_Sticker with Base_StickerNew variant.
+func NewBase_StickerFromStickerNew(v Base_StickerNew) (o Base_Sticker, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 type Base_StickerAnimation_Type string
 
 const (
@@ -1782,7 +1804,7 @@ type Board_Topic struct {
 	// Comments number
 	Comments *int `json:"comments,omitempty"`
 	// Date when the topic has been created in Unixtime
-	Created *int `json:"created,omitempty"`
+	Created *UnixTime `json:"created,omitempty"`
 	// Creator ID
 	CreatedBy *int `json:"created_by,omitempty"`
 	// First comment text
@@ -1798,7 +1820,7 @@ type Board_Topic struct {
 	// Topic title
 	Title *string `json:"title,omitempty"`
 	// Date when the topic has been updated in Unixtime
-	Updated *int `json:"updated,omitempty"`
+	Updated *UnixTime `json:"updated,omitempty"`
 	// ID of user who updated the topic
 	UpdatedBy *int `json:"updated_by,omitempty"`
 }
@@ -1809,7 +1831,7 @@ type Board_TopicComment struct {
 	CanEdit *Base_BoolInt `json:"can_edit,omitempty"`
 	// Date when the comment has been added in Unixtime
 	//  Minimum: 0
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Author ID
 	FromId int `json:"from_id"`
 	// Comment ID
@@ -2347,7 +2369,7 @@ type Docs_Doc struct {
 	AccessKey *string `json:"access_key,omitempty"`
 	// Date when file has been uploaded in Unixtime
 	//  Minimum: 0
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// File extension
 	Ext string `json:"ext"`
 	// Document ID
@@ -2626,7 +2648,7 @@ type Friends_UserXtrPhone struct {
 
 type Gifts_Gift struct {
 	// Date when gist has been sent in Unixtime
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Gift sender ID
 	FromId *int          `json:"from_id,omitempty"`
 	Gift   *Gifts_Layout `json:"gift,omitempty"`
@@ -2767,10 +2789,10 @@ type Groups_BanInfo struct {
 	CommentVisible *bool `json:"comment_visible,omitempty"`
 	// Date when user has been added to blacklist in Unixtime
 	//  Minimum: 0
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Date when user will be removed from blacklist in Unixtime
 	//  Minimum: 0
-	EndDate  *int                  `json:"end_date,omitempty"`
+	EndDate  *UnixTime             `json:"end_date,omitempty"`
 	IsClosed *bool                 `json:"is_closed,omitempty"`
 	Reason   *Groups_BanInfoReason `json:"reason,omitempty"`
 }
@@ -2956,7 +2978,7 @@ type Groups_Group struct {
 	// Established date
 	EstDate *string `json:"est_date,omitempty"`
 	// Finish date in Unixtime format
-	FinishDate *int `json:"finish_date,omitempty"`
+	FinishDate *UnixTime `json:"finish_date,omitempty"`
 	// Community ID
 	//  Format: int64
 	Id int `json:"id"`
@@ -3000,7 +3022,7 @@ type Groups_Group struct {
 	// Domain of the community page
 	ScreenName *string `json:"screen_name,omitempty"`
 	// Start date in Unixtime format
-	StartDate *int              `json:"start_date,omitempty"`
+	StartDate *UnixTime         `json:"start_date,omitempty"`
 	Type      *Groups_GroupType `json:"type,omitempty"`
 	VideoLive *Video_LiveInfo   `json:"video_live,omitempty"`
 }
@@ -3056,7 +3078,7 @@ type Groups_GroupBanInfo struct {
 	// Ban comment
 	Comment *string `json:"comment,omitempty"`
 	// End date of ban in Unixtime
-	EndDate *int                  `json:"end_date,omitempty"`
+	EndDate *UnixTime             `json:"end_date,omitempty"`
 	Reason  *Groups_BanInfoReason `json:"reason,omitempty"`
 }
 
@@ -3734,7 +3756,7 @@ func (o *LeadForms_Answer_Answer) MarshalJSON() ([]byte, error) {
 }
 
 func (o *LeadForms_Answer_Answer) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -3742,6 +3764,28 @@ func (o LeadForms_Answer_Answer) Raw() []byte {
 	return o.raw
 }
 
+// AsAnswerItem returns LeadForms_AnswerItem variant, if present.
+func (o LeadForms_Answer_Answer) AsAnswerItem() (v LeadForms_AnswerItem, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewLeadForms_Answer_AnswerFromAnswerItem returns LeadForms_Answer_Answer with LeadForms_AnswerItem variant.
+func NewLeadForms_Answer_AnswerFromAnswerItem(v LeadForms_AnswerItem) (o LeadForms_Answer_Answer, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsAnswerItemArray returns []LeadForms_AnswerItem variant, if present.
+func (o LeadForms_Answer_Answer) AsAnswerItemArray() (v []LeadForms_AnswerItem, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewLeadForms_Answer_AnswerFromAnswerItemArray returns LeadForms_Answer_Answer with []LeadForms_AnswerItem variant.
+func NewLeadForms_Answer_AnswerFromAnswerItemArray(v []LeadForms_AnswerItem) (o LeadForms_Answer_Answer, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 type LeadForms_Answer struct {
 	Answer LeadForms_Answer_Answer `json:"answer"`
 	Key    string                  `json:"key"`
@@ -3865,7 +3909,7 @@ type Market_MarketAlbum struct {
 	Title string `json:"title"`
 	// Date when album has been updated last time in Unixtime
 	//  Minimum: 0
-	UpdatedTime int `json:"updated_time"`
+	UpdatedTime UnixTime `json:"updated_time"`
 }
 
 type Market_MarketCategory Market_MarketCategoryOld
@@ -3903,7 +3947,7 @@ type Market_MarketItem struct {
 	Category    Market_MarketCategory `json:"category"`
 	// Date when the item has been created in Unixtime
 	//  Minimum: 0
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Item description
 	Description string  `json:"description"`
 	ExternalId  *string `json:"external_id,omitempty"`
@@ -4413,7 +4457,7 @@ type Messages_ForeignMessage struct {
 	// Message text
 	Text string `json:"text"`
 	// Date when the message has been updated in Unixtime
-	UpdateTime *int `json:"update_time,omitempty"`
+	UpdateTime *UnixTime `json:"update_time,omitempty"`
 	// Was the audio message inside already listened by you
 	WasListened *bool `json:"was_listened,omitempty"`
 }
@@ -4657,7 +4701,7 @@ func (o *Messages_KeyboardButtonPropertyAction) MarshalJSON() ([]byte, error) {
 }
 
 func (o *Messages_KeyboardButtonPropertyAction) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -4665,11 +4709,132 @@ func (o Messages_KeyboardButtonPropertyAction) Raw() []byte {
 	return o.raw
 }
 
+// Kind returns value of the "type" field of the variant.
+func (o Messages_KeyboardButtonPropertyAction) Kind() string {
+	var v struct {
+		Type string `json:"type"`
+	}
+	_ = json.Unmarshal(o.raw, &v)
+	return v.Type
+}
+
+// AsKeyboardButtonActionLocation returns Messages_KeyboardButtonActionLocation variant, if present.
+func (o Messages_KeyboardButtonPropertyAction) AsKeyboardButtonActionLocation() (v Messages_KeyboardButtonActionLocation, ok bool) {
+	switch o.Kind() {
+	case "location":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionLocation returns Messages_KeyboardButtonPropertyAction with Messages_KeyboardButtonActionLocation variant.
+func NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionLocation(v Messages_KeyboardButtonActionLocation) (o Messages_KeyboardButtonPropertyAction, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsKeyboardButtonActionOpenApp returns Messages_KeyboardButtonActionOpenApp variant, if present.
+func (o Messages_KeyboardButtonPropertyAction) AsKeyboardButtonActionOpenApp() (v Messages_KeyboardButtonActionOpenApp, ok bool) {
+	switch o.Kind() {
+	case "open_app":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionOpenApp returns Messages_KeyboardButtonPropertyAction with Messages_KeyboardButtonActionOpenApp variant.
+func NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionOpenApp(v Messages_KeyboardButtonActionOpenApp) (o Messages_KeyboardButtonPropertyAction, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsKeyboardButtonActionOpenLink returns Messages_KeyboardButtonActionOpenLink variant, if present.
+func (o Messages_KeyboardButtonPropertyAction) AsKeyboardButtonActionOpenLink() (v Messages_KeyboardButtonActionOpenLink, ok bool) {
+	switch o.Kind() {
+	case "open_link":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionOpenLink returns Messages_KeyboardButtonPropertyAction with Messages_KeyboardButtonActionOpenLink variant.
+func NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionOpenLink(v Messages_KeyboardButtonActionOpenLink) (o Messages_KeyboardButtonPropertyAction, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsKeyboardButtonActionOpenPhoto returns Messages_KeyboardButtonActionOpenPhoto variant, if present.
+func (o Messages_KeyboardButtonPropertyAction) AsKeyboardButtonActionOpenPhoto() (v Messages_KeyboardButtonActionOpenPhoto, ok bool) {
+	switch o.Kind() {
+	case "open_photo":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionOpenPhoto returns Messages_KeyboardButtonPropertyAction with Messages_KeyboardButtonActionOpenPhoto variant.
+func NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionOpenPhoto(v Messages_KeyboardButtonActionOpenPhoto) (o Messages_KeyboardButtonPropertyAction, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsKeyboardButtonActionText returns Messages_KeyboardButtonActionText variant, if present.
+func (o Messages_KeyboardButtonPropertyAction) AsKeyboardButtonActionText() (v Messages_KeyboardButtonActionText, ok bool) {
+	switch o.Kind() {
+	case "text":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionText returns Messages_KeyboardButtonPropertyAction with Messages_KeyboardButtonActionText variant.
+func NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionText(v Messages_KeyboardButtonActionText) (o Messages_KeyboardButtonPropertyAction, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsKeyboardButtonActionCallback returns Messages_KeyboardButtonActionCallback variant, if present.
+func (o Messages_KeyboardButtonPropertyAction) AsKeyboardButtonActionCallback() (v Messages_KeyboardButtonActionCallback, ok bool) {
+	switch o.Kind() {
+	case "callback":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionCallback returns Messages_KeyboardButtonPropertyAction with Messages_KeyboardButtonActionCallback variant.
+func NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionCallback(v Messages_KeyboardButtonActionCallback) (o Messages_KeyboardButtonPropertyAction, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsKeyboardButtonActionVkpay returns Messages_KeyboardButtonActionVkpay variant, if present.
+func (o Messages_KeyboardButtonPropertyAction) AsKeyboardButtonActionVkpay() (v Messages_KeyboardButtonActionVkpay, ok bool) {
+	switch o.Kind() {
+	case "vkpay":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionVkpay returns Messages_KeyboardButtonPropertyAction with Messages_KeyboardButtonActionVkpay variant.
+func NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionVkpay(v Messages_KeyboardButtonActionVkpay) (o Messages_KeyboardButtonPropertyAction, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 type Messages_LastActivity struct {
 	// Information whether user is online
 	Online Base_BoolInt `json:"online"`
 	// Time when user was online in Unixtime
-	Time int `json:"time"`
+	Time UnixTime `json:"time"`
 }
 
 type Messages_LongpollMessages struct {
@@ -4699,7 +4864,7 @@ type Messages_Message struct {
 	// Unique auto-incremented number for all messages with this peer
 	ConversationMessageId *int `json:"conversation_message_id,omitempty"`
 	// Date when the message has been sent in Unixtime
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Is it an deleted message
 	Deleted *Base_BoolInt `json:"deleted,omitempty"`
 	// Message author's ID
@@ -4726,7 +4891,7 @@ type Messages_Message struct {
 	// Peer ID
 	PeerId int `json:"peer_id"`
 	// Date when the message has been pinned in Unixtime
-	PinnedAt *int `json:"pinned_at,omitempty"`
+	PinnedAt *UnixTime `json:"pinned_at,omitempty"`
 	// ID used for sending messages. It returned only for outgoing messages
 	RandomId     *int                     `json:"random_id,omitempty"`
 	Ref          *string                  `json:"ref,omitempty"`
@@ -4735,7 +4900,7 @@ type Messages_Message struct {
 	// Message text
 	Text string `json:"text"`
 	// Date when the message has been updated in Unixtime
-	UpdateTime *int `json:"update_time,omitempty"`
+	UpdateTime *UnixTime `json:"update_time,omitempty"`
 	// Was the audio message inside already listened by you
 	WasListened *bool `json:"was_listened,omitempty"`
 }
@@ -4853,7 +5018,7 @@ type Messages_PinnedMessage struct {
 	// Unique auto-incremented number for all messages with this peer
 	ConversationMessageId *int `json:"conversation_message_id,omitempty"`
 	// Date when the message has been sent in Unixtime
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Message author's ID
 	//  Format: int64
 	FromId int `json:"from_id"`
@@ -4952,7 +5117,7 @@ type Newsfeed_ItemAudioAudio struct {
 
 type Newsfeed_ItemBase struct {
 	// Date when item has been added in Unixtime
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Item source ID
 	//  Format: int64
 	SourceId int                       `json:"source_id"`
@@ -5135,12 +5300,13 @@ type Newsfeed_ItemVideoVideo struct {
 	Items *[]Video_Video `json:"items,omitempty"`
 }
 
-//easyjson:skip
 type Newsfeed_ItemWallpost struct {
 	Wall_CarouselBase
 	Newsfeed_ItemBase
 	Wall_WallpostFull
 	Feedback *Newsfeed_ItemWallpostFeedback `json:"feedback,omitempty"`
+	// Date when item has been added in Unixtime
+	Date UnixTime `json:"date"`
 }
 
 type Newsfeed_ItemWallpostFeedback struct {
@@ -5190,7 +5356,7 @@ func (o *Newsfeed_NewsfeedItem) MarshalJSON() ([]byte, error) {
 }
 
 func (o *Newsfeed_NewsfeedItem) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -5198,6 +5364,149 @@ func (o Newsfeed_NewsfeedItem) Raw() []byte {
 	return o.raw
 }
 
+// Kind returns value of the "type" field of the variant.
+func (o Newsfeed_NewsfeedItem) Kind() Newsfeed_NewsfeedItemType {
+	var v struct {
+		Type Newsfeed_NewsfeedItemType `json:"type"`
+	}
+	_ = json.Unmarshal(o.raw, &v)
+	return v.Type
+}
+
+// AsItemWallpost returns Newsfeed_ItemWallpost variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemWallpost() (v Newsfeed_ItemWallpost, ok bool) {
+	switch o.Kind() {
+	case "post":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemWallpost returns Newsfeed_NewsfeedItem with Newsfeed_ItemWallpost variant.
+func NewNewsfeed_NewsfeedItemFromItemWallpost(v Newsfeed_ItemWallpost) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsItemPhoto returns Newsfeed_ItemPhoto variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemPhoto() (v Newsfeed_ItemPhoto, ok bool) {
+	switch o.Kind() {
+	case "photo", "wall_photo":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemPhoto returns Newsfeed_NewsfeedItem with Newsfeed_ItemPhoto variant.
+func NewNewsfeed_NewsfeedItemFromItemPhoto(v Newsfeed_ItemPhoto) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsItemPhotoTag returns Newsfeed_ItemPhotoTag variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemPhotoTag() (v Newsfeed_ItemPhotoTag, ok bool) {
+	switch o.Kind() {
+	case "photo_tag":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemPhotoTag returns Newsfeed_NewsfeedItem with Newsfeed_ItemPhotoTag variant.
+func NewNewsfeed_NewsfeedItemFromItemPhotoTag(v Newsfeed_ItemPhotoTag) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsItemFriend returns Newsfeed_ItemFriend variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemFriend() (v Newsfeed_ItemFriend, ok bool) {
+	switch o.Kind() {
+	case "friend":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemFriend returns Newsfeed_NewsfeedItem with Newsfeed_ItemFriend variant.
+func NewNewsfeed_NewsfeedItemFromItemFriend(v Newsfeed_ItemFriend) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsItemAudio returns Newsfeed_ItemAudio variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemAudio() (v Newsfeed_ItemAudio, ok bool) {
+	switch o.Kind() {
+	case "audio":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemAudio returns Newsfeed_NewsfeedItem with Newsfeed_ItemAudio variant.
+func NewNewsfeed_NewsfeedItemFromItemAudio(v Newsfeed_ItemAudio) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsItemVideo returns Newsfeed_ItemVideo variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemVideo() (v Newsfeed_ItemVideo, ok bool) {
+	switch o.Kind() {
+	case "video":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemVideo returns Newsfeed_NewsfeedItem with Newsfeed_ItemVideo variant.
+func NewNewsfeed_NewsfeedItemFromItemVideo(v Newsfeed_ItemVideo) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsItemTopic returns Newsfeed_ItemTopic variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemTopic() (v Newsfeed_ItemTopic, ok bool) {
+	switch o.Kind() {
+	case "topic":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemTopic returns Newsfeed_NewsfeedItem with Newsfeed_ItemTopic variant.
+func NewNewsfeed_NewsfeedItemFromItemTopic(v Newsfeed_ItemTopic) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsItemDigest returns Newsfeed_ItemDigest variant, if present.
+func (o Newsfeed_NewsfeedItem) AsItemDigest() (v Newsfeed_ItemDigest, ok bool) {
+	switch o.Kind() {
+	case "digest":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewNewsfeed_NewsfeedItemFromItemDigest returns Newsfeed_NewsfeedItem with Newsfeed_ItemDigest variant.
+func NewNewsfeed_NewsfeedItemFromItemDigest(v Newsfeed_ItemDigest) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// NewNewsfeed_NewsfeedItemFromItemPromoButton returns Newsfeed_NewsfeedItem with Newsfeed_ItemPromoButton variant.
+func NewNewsfeed_NewsfeedItemFromItemPromoButton(v Newsfeed_ItemPromoButton) (o Newsfeed_NewsfeedItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 // Newsfeed_NewsfeedItemType Item type
 type Newsfeed_NewsfeedItemType string
 
@@ -5233,7 +5542,7 @@ type Notes_Note struct {
 	Comments int `json:"comments"`
 	// Date when the note has been created in Unixtime
 	//  Minimum: 0
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Note ID
 	//  Minimum: 1
 	Id int `json:"id"`
@@ -5258,7 +5567,7 @@ type Notes_Note struct {
 
 type Notes_NoteComment struct {
 	// Date when the comment has beed added in Unixtime
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Comment ID
 	Id int `json:"id"`
 	// Comment text
@@ -5299,19 +5608,48 @@ type Notifications_Notification struct {
 
 type Notifications_NotificationItem Notifications_Notification
 
-//easyjson:skip
 type Notifications_NotificationParent struct {
 	Wall_WallpostToId
 	Photos_Photo
 	Board_Topic
 	Video_Video
 	Notifications_NotificationsComment
+	// Access key
+	AccessKey *string `json:"access_key,omitempty"`
+	// Information whether current user can comment the parent
+	CanComment *Base_BoolInt `json:"can_comment,omitempty"`
+	// Comments info or number depending on the parent type
+	Comments *json.RawMessage `json:"comments,omitempty"`
+	// Date when the parent has been created in Unixtime
+	Date *UnixTime `json:"date,omitempty"`
+	// Height of the parent media
+	Height *int `json:"height,omitempty"`
+	// Parent ID
+	Id *int `json:"id,omitempty"`
+	// Information whether the parent is in favorites
+	IsFavorite *bool `json:"is_favorite,omitempty"`
+	// Likes info
+	Likes *Base_LikesInfo `json:"likes,omitempty"`
+	// Parent owner ID
+	OwnerId *int `json:"owner_id,omitempty"`
+	// Post ID
+	PostId *int `json:"post_id,omitempty"`
+	// Reposts info
+	Reposts *Base_RepostsInfo `json:"reposts,omitempty"`
+	// Parent text
+	Text *string `json:"text,omitempty"`
+	// Parent title
+	Title *string `json:"title,omitempty"`
+	// ID of the user who created the parent
+	UserId *int `json:"user_id,omitempty"`
+	// Width of the parent media
+	Width *int `json:"width,omitempty"`
 }
 
 type Notifications_NotificationsComment struct {
 	// Date when the comment has been added in Unixtime
 	//  Minimum: 0
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Comment ID
 	//  Minimum: 1
 	Id *int `json:"id,omitempty"`
@@ -5328,7 +5666,7 @@ type Notifications_NotificationsComment struct {
 
 type Notifications_Reply struct {
 	// Date when the reply has been created in Unixtime
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Reply ID
 	Id *int `json:"id,omitempty"`
 	// Reply text
@@ -5426,21 +5764,21 @@ type Orders_Subscription struct {
 	// Cancel reason
 	CancelReason *string `json:"cancel_reason,omitempty"`
 	// Date of creation in Unixtime
-	CreateTime int `json:"create_time"`
+	CreateTime UnixTime `json:"create_time"`
 	// Subscription expiration time in Unixtime
-	ExpireTime *int `json:"expire_time,omitempty"`
+	ExpireTime *UnixTime `json:"expire_time,omitempty"`
 	// Subscription ID
 	Id int `json:"id"`
 	// Subscription order item
 	ItemId string `json:"item_id"`
 	// Date of next bill in Unixtime
-	NextBillTime *int `json:"next_bill_time,omitempty"`
+	NextBillTime *UnixTime `json:"next_bill_time,omitempty"`
 	// Pending cancel state
 	PendingCancel *bool `json:"pending_cancel,omitempty"`
 	// Subscription period
 	Period int `json:"period"`
 	// Date of last period start in Unixtime
-	PeriodStartTime int `json:"period_start_time"`
+	PeriodStartTime UnixTime `json:"period_start_time"`
 	// Item photo image url
 	PhotoUrl *string `json:"photo_url,omitempty"`
 	// Subscription price
@@ -5452,9 +5790,9 @@ type Orders_Subscription struct {
 	// Subscription name
 	Title *string `json:"title,omitempty"`
 	// Date of trial expire in Unixtime
-	TrialExpireTime *int `json:"trial_expire_time,omitempty"`
+	TrialExpireTime *UnixTime `json:"trial_expire_time,omitempty"`
 	// Date of last change in Unixtime
-	UpdateTime int `json:"update_time"`
+	UpdateTime UnixTime `json:"update_time"`
 }
 
 type Owner_State_State int
@@ -5509,7 +5847,7 @@ type Pages_Wikipage struct {
 
 type Pages_WikipageFull struct {
 	// Date when the page has been created in Unixtime
-	Created int `json:"created"`
+	Created UnixTime `json:"created"`
 	// Page creator ID
 	CreatorId *int `json:"creator_id,omitempty"`
 	// Information whether current user can edit the page
@@ -5517,7 +5855,7 @@ type Pages_WikipageFull struct {
 	// Information whether current user can edit the page access settings
 	CurrentUserCanEditAccess *Base_BoolInt `json:"current_user_can_edit_access,omitempty"`
 	// Date when the page has been edited in Unixtime
-	Edited int `json:"edited"`
+	Edited UnixTime `json:"edited"`
 	// Last editor ID
 	EditorId *int `json:"editor_id,omitempty"`
 	// Community ID
@@ -5557,7 +5895,7 @@ type Pages_WikipageFull struct {
 type Pages_WikipageHistory struct {
 	// Date when the page has been edited in Unixtime
 	//  Minimum: 0
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Last editor ID
 	EditorId int `json:"editor_id"`
 	// Last editor name
@@ -5650,7 +5988,7 @@ type Photos_Photo struct {
 type Photos_PhotoAlbum struct {
 	// Date when the album has been created in Unixtime
 	//  Minimum: 0
-	Created int `json:"created"`
+	Created UnixTime `json:"created"`
 	// Photo album description
 	Description *string `json:"description,omitempty"`
 	// Photo album ID
@@ -5666,7 +6004,7 @@ type Photos_PhotoAlbum struct {
 	Title string `json:"title"`
 	// Date when the album has been updated last time in Unixtime
 	//  Minimum: 0
-	Updated int `json:"updated"`
+	Updated UnixTime `json:"updated"`
 }
 
 type Photos_PhotoAlbumFull struct {
@@ -5678,7 +6016,7 @@ type Photos_PhotoAlbumFull struct {
 	CommentsDisabled *Base_BoolInt `json:"comments_disabled,omitempty"`
 	// Date when the album has been created in Unixtime
 	//  Minimum: 0
-	Created int `json:"created"`
+	Created UnixTime `json:"created"`
 	// Photo album description
 	Description *string `json:"description,omitempty"`
 	// Photo album ID
@@ -5702,7 +6040,7 @@ type Photos_PhotoAlbumFull struct {
 	Title string `json:"title"`
 	// Date when the album has been updated last time in Unixtime
 	//  Minimum: 0
-	Updated int `json:"updated"`
+	Updated UnixTime `json:"updated"`
 	// Information whether only community administrators can upload photos
 	UploadByAdminsOnly *Base_BoolInt `json:"upload_by_admins_only,omitempty"`
 }
@@ -5717,7 +6055,7 @@ func (o *Photos_PhotoFalseable) MarshalJSON() ([]byte, error) {
 }
 
 func (o *Photos_PhotoFalseable) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -5725,6 +6063,28 @@ func (o Photos_PhotoFalseable) Raw() []byte {
 	return o.raw
 }
 
+// AsBool returns bool variant, if present.
+func (o Photos_PhotoFalseable) AsBool() (v bool, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewPhotos_PhotoFalseableFromBool returns Photos_PhotoFalseable with bool variant.
+func NewPhotos_PhotoFalseableFromBool(v bool) (o Photos_PhotoFalseable, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsString returns string variant, if present.
+func (o Photos_PhotoFalseable) AsString() (v string, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewPhotos_PhotoFalseableFromString returns Photos_PhotoFalseable with string variant.
+func NewPhotos_PhotoFalseableFromString(v string) (o Photos_PhotoFalseable, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 type Photos_PhotoFullXtrRealOffset struct {
 	// Access key for the photo
 	AccessKey *string `json:"access_key,omitempty"`
@@ -5838,7 +6198,7 @@ const (
 type Photos_PhotoTag struct {
 	// Date when tag has been added in Unixtime
 	//  Minimum: 0
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Tagged description.
 	Description *string `json:"description,omitempty"`
 	// Tag ID
@@ -5984,7 +6344,7 @@ type Photos_PhotoXtrTagInfo struct {
 	Sizes  *[]Photos_PhotoSizes `json:"sizes,omitempty"`
 	// Date when tag has been added in Unixtime
 	//  Minimum: 0
-	TagCreated *int `json:"tag_created,omitempty"`
+	TagCreated *UnixTime `json:"tag_created,omitempty"`
 	// Tag ID
 	TagId *int `json:"tag_id,omitempty"`
 	// Photo caption
@@ -6112,9 +6472,9 @@ type Polls_Poll struct {
 	Closed     bool              `json:"closed"`
 	// Date when poll has been created in Unixtime
 	//  Minimum: 0
-	Created       int     `json:"created"`
-	DisableUnvote bool    `json:"disable_unvote"`
-	EmbedHash     *string `json:"embed_hash,omitempty"`
+	Created       UnixTime `json:"created"`
+	DisableUnvote bool     `json:"disable_unvote"`
+	EmbedHash     *string  `json:"embed_hash,omitempty"`
 	//  Minimum: 0
 	EndDate int             `json:"end_date"`
 	Friends *[]Polls_Friend `json:"friends,omitempty"`
@@ -6162,7 +6522,7 @@ func (o *PrettyCards_PrettyCard_Button) MarshalJSON() ([]byte, error) {
 }
 
 func (o *PrettyCards_PrettyCard_Button) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -6170,6 +6530,28 @@ func (o PrettyCards_PrettyCard_Button) Raw() []byte {
 	return o.raw
 }
 
+// AsBase_LinkButton returns Base_LinkButton variant, if present.
+func (o PrettyCards_PrettyCard_Button) AsBase_LinkButton() (v Base_LinkButton, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewPrettyCards_PrettyCard_ButtonFromBase_LinkButton returns PrettyCards_PrettyCard_Button with Base_LinkButton variant.
+func NewPrettyCards_PrettyCard_ButtonFromBase_LinkButton(v Base_LinkButton) (o PrettyCards_PrettyCard_Button, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsString returns string variant, if present.
+func (o PrettyCards_PrettyCard_Button) AsString() (v string, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewPrettyCards_PrettyCard_ButtonFromString returns PrettyCards_PrettyCard_Button with string variant.
+func NewPrettyCards_PrettyCard_ButtonFromString(v string) (o PrettyCards_PrettyCard_Button, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 type PrettyCards_PrettyCard struct {
 	// Button key
 	Button *PrettyCards_PrettyCard_Button `json:"button,omitempty"`
@@ -6200,7 +6582,7 @@ func (o *PrettyCards_PrettyCardOrError) MarshalJSON() ([]byte, error) {
 }
 
 func (o *PrettyCards_PrettyCardOrError) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -6208,6 +6590,28 @@ func (o PrettyCards_PrettyCardOrError) Raw() []byte {
 	return o.raw
 }
 
+// AsPrettyCard returns PrettyCards_PrettyCard variant, if present.
+func (o PrettyCards_PrettyCardOrError) AsPrettyCard() (v PrettyCards_PrettyCard, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewPrettyCards_PrettyCardOrErrorFromPrettyCard returns PrettyCards_PrettyCardOrError with PrettyCards_PrettyCard variant.
+func NewPrettyCards_PrettyCardOrErrorFromPrettyCard(v PrettyCards_PrettyCard) (o PrettyCards_PrettyCardOrError, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsBase_Error returns Base_Error variant, if present.
+func (o PrettyCards_PrettyCardOrError) AsBase_Error() (v Base_Error, ok bool) {
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewPrettyCards_PrettyCardOrErrorFromBase_Error returns PrettyCards_PrettyCardOrError with Base_Error variant.
+func NewPrettyCards_PrettyCardOrErrorFromBase_Error(v Base_Error) (o PrettyCards_PrettyCardOrError, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 type Search_Hint struct {
 	App *Apps_App `json:"app,omitempty"`
 	// Object description
@@ -6282,9 +6686,9 @@ type Secure_SmsNotification struct {
 
 type Secure_TokenChecked struct {
 	// Date when access_token has been generated in Unixtime
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Date when access_token will expire in Unixtime
-	Expire *int `json:"expire,omitempty"`
+	Expire *UnixTime `json:"expire,omitempty"`
 	// Returns if successfully processed
 	//  Default: 1
 	Success *int `json:"success,omitempty"`
@@ -6295,7 +6699,7 @@ type Secure_TokenChecked struct {
 
 type Secure_Transaction struct {
 	// Transaction date in Unixtime
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Transaction ID
 	Id *int `json:"id,omitempty"`
 	// From ID
@@ -6353,9 +6757,9 @@ type Stats_Country struct {
 type Stats_Period struct {
 	Activity *Stats_Activity `json:"activity,omitempty"`
 	// Unix timestamp
-	PeriodFrom *int `json:"period_from,omitempty"`
+	PeriodFrom *UnixTime `json:"period_from,omitempty"`
 	// Unix timestamp
-	PeriodTo *int         `json:"period_to,omitempty"`
+	PeriodTo *UnixTime    `json:"period_to,omitempty"`
 	Reach    *Stats_Reach `json:"reach,omitempty"`
 	Visitors *Stats_Views `json:"visitors,omitempty"`
 }
@@ -6475,7 +6879,7 @@ type Store_Product struct {
 	Promoted *Base_BoolInt `json:"promoted,omitempty"`
 	// Date (Unix time) when the product was purchased
 	//  Minimum: 0
-	PurchaseDate *int `json:"purchase_date,omitempty"`
+	PurchaseDate *UnixTime `json:"purchase_date,omitempty"`
 	// Information whether the product is purchased (1 - yes, 0 - no)
 	Purchased *Base_BoolInt      `json:"purchased,omitempty"`
 	Stickers  *Base_StickersList `json:"stickers,omitempty"`
@@ -6685,11 +7089,11 @@ type Stories_Story struct {
 	ClickableStickers *Stories_ClickableStickers `json:"clickable_stickers,omitempty"`
 	// Date when story has been added in Unixtime.
 	//  Minimum: 0
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Story expiration time. Unixtime.
 	//  Minimum: 0
-	ExpiresAt           *int    `json:"expires_at,omitempty"`
-	FirstNarrativeTitle *string `json:"first_narrative_title,omitempty"`
+	ExpiresAt           *UnixTime `json:"expires_at,omitempty"`
+	FirstNarrativeTitle *string   `json:"first_narrative_title,omitempty"`
 	// Story ID.
 	Id int `json:"id"`
 	// Information whether the story is deleted (false - no, true - yes).
@@ -6949,7 +7353,7 @@ type Users_LastSeen struct {
 	// Type of the platform that used for the last authorization
 	Platform *int `json:"platform,omitempty"`
 	// Last visit date (in Unix time)
-	Time *int `json:"time,omitempty"`
+	Time *UnixTime `json:"time,omitempty"`
 }
 
 type Users_Military struct {
@@ -7080,7 +7484,7 @@ func (o *Users_SubscriptionsItem) MarshalJSON() ([]byte, error) {
 }
 
 func (o *Users_SubscriptionsItem) UnmarshalJSON(body []byte) (err error) {
-	o.raw = body
+	o.raw = append([]byte(nil), body...)
 	return nil
 }
 
@@ -7088,6 +7492,47 @@ func (o Users_SubscriptionsItem) Raw() []byte {
 	return o.raw
 }
 
+// Kind returns value of the "type" field of the variant.
+func (o Users_SubscriptionsItem) Kind() string {
+	var v struct {
+		Type string `json:"type"`
+	}
+	_ = json.Unmarshal(o.raw, &v)
+	return v.Type
+}
+
+// AsUserXtrType returns Users_UserXtrType variant, if present.
+func (o Users_SubscriptionsItem) AsUserXtrType() (v Users_UserXtrType, ok bool) {
+	switch o.Kind() {
+	case "profile":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewUsers_SubscriptionsItemFromUserXtrType returns Users_SubscriptionsItem with Users_UserXtrType variant.
+func NewUsers_SubscriptionsItemFromUserXtrType(v Users_UserXtrType) (o Users_SubscriptionsItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
+// AsGroups_GroupFull returns Groups_GroupFull variant, if present.
+func (o Users_SubscriptionsItem) AsGroups_GroupFull() (v Groups_GroupFull, ok bool) {
+	switch o.Kind() {
+	case "group", "page", "event":
+	default:
+		return v, false
+	}
+	return v, json.Unmarshal(o.raw, &v) == nil
+}
+
+// NewUsers_SubscriptionsItemFromGroups_GroupFull returns Users_SubscriptionsItem with Groups_GroupFull variant.
+func NewUsers_SubscriptionsItemFromGroups_GroupFull(v Groups_GroupFull) (o Users_SubscriptionsItem, err error) {
+	o.raw, err = json.Marshal(&v)
+	return
+}
+
 type Users_University struct {
 	// Chair ID
 	Chair *int `json:"chair,omitempty"`
@@ -7525,7 +7970,7 @@ type Utils_LastShortenedLink struct {
 	//  Format: uri
 	ShortUrl *string `json:"short_url,omitempty"`
 	// Creation time in Unixtime
-	Timestamp *int `json:"timestamp,omitempty"`
+	Timestamp *UnixTime `json:"timestamp,omitempty"`
 	// Full URL
 	//  Format: uri
 	Url *string `json:"url,omitempty"`
@@ -7671,7 +8116,7 @@ type Video_Video struct {
 	Added *Base_BoolInt `json:"added,omitempty"`
 	// Date when the video has been added in Unixtime
 	//  Minimum: 0
-	AddingDate *int `json:"adding_date,omitempty"`
+	AddingDate *UnixTime `json:"adding_date,omitempty"`
 	// Live donations balance
 	//  Minimum: 0
 	Balance *int `json:"balance,omitempty"`
@@ -7703,7 +8148,7 @@ type Video_Video struct {
 	Converting *Base_BoolInt `json:"converting,omitempty"`
 	// Date when video has been uploaded in Unixtime
 	//  Minimum: 0
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Video description
 	Description *string `json:"description,omitempty"`
 	// Video duration in seconds
@@ -7730,7 +8175,7 @@ type Video_Video struct {
 	LiveNotify *Base_BoolInt `json:"live_notify,omitempty"`
 	// Date in Unixtime when the live stream is scheduled to start by the author
 	//  Minimum: 0
-	LiveStartTime *int `json:"live_start_time,omitempty"`
+	LiveStartTime *UnixTime `json:"live_start_time,omitempty"`
 	// Live stream status
 	LiveStatus *Video_Video_LiveStatus `json:"live_status,omitempty"`
 	// If video is external, number of views on vk
@@ -7793,7 +8238,7 @@ type Video_VideoAlbumFull struct {
 	IsSystem *Base_PropertyExists `json:"is_system,omitempty"`
 	// Date when the album has been updated last time in Unixtime
 	//  Minimum: 0
-	UpdatedTime int `json:"updated_time"`
+	UpdatedTime UnixTime `json:"updated_time"`
 }
 
 type Video_VideoFiles struct {
@@ -7862,7 +8307,7 @@ type Wall_AttachedNote struct {
 	Comments int `json:"comments"`
 	// Date when the note has been created in Unixtime
 	//  Minimum: 0
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Note ID
 	//  Minimum: 1
 	Id int `json:"id"`
@@ -8048,7 +8493,7 @@ type Wall_WallComment struct {
 	CanEdit     *Base_BoolInt             `json:"can_edit,omitempty"`
 	// Date when the comment has been added in Unixtime
 	//  Minimum: 0
-	Date    int                    `json:"date"`
+	Date    UnixTime               `json:"date"`
 	Deleted *bool                  `json:"deleted,omitempty"`
 	Donut   *Wall_WallCommentDonut `json:"donut,omitempty"`
 	// Author ID
@@ -8096,10 +8541,10 @@ type Wall_Wallpost struct {
 	// Information about the source of the post
 	Copyright *Wall_PostCopyright `json:"copyright,omitempty"`
 	// Date of publishing in Unixtime
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Date of editing in Unixtime
 	//  Minimum: 0
-	Edited *int `json:"edited,omitempty"`
+	Edited *UnixTime `json:"edited,omitempty"`
 	// Post author ID
 	//  Format: int64
 	FromId *int      `json:"from_id,omitempty"`
@@ -8276,7 +8721,7 @@ type Wall_WallpostToId struct {
 	// ID of the source post
 	CopyPostId *int `json:"copy_post_id,omitempty"`
 	// Date of publishing in Unixtime
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Post author ID
 	//  Format: int64
 	FromId *int      `json:"from_id,omitempty"`
@@ -8335,7 +8780,7 @@ type Widgets_CommentRepliesItem struct {
 	// Comment ID
 	Cid *int `json:"cid,omitempty"`
 	// Date when the comment has been added in Unixtime
-	Date  *int                 `json:"date,omitempty"`
+	Date  *UnixTime            `json:"date,omitempty"`
 	Likes *Widgets_WidgetLikes `json:"likes,omitempty"`
 	// Comment text
 	Text *string `json:"text,omitempty"`
@@ -8350,7 +8795,7 @@ type Widgets_WidgetComment struct {
 	CanDelete *Base_BoolInt           `json:"can_delete,omitempty"`
 	Comments  *Widgets_CommentReplies `json:"comments,omitempty"`
 	// Date when the comment has been added in Unixtime
-	Date int `json:"date"`
+	Date UnixTime `json:"date"`
 	// Comment author ID
 	FromId int `json:"from_id"`
 	// Comment ID
@@ -8377,7 +8822,7 @@ type Widgets_WidgetLikes struct {
 type Widgets_WidgetPage struct {
 	Comments *Base_ObjectCount `json:"comments,omitempty"`
 	// Date when widgets on the page has been initialized firstly in Unixtime
-	Date *int `json:"date,omitempty"`
+	Date *UnixTime `json:"date,omitempty"`
 	// Page description
 	Description *string `json:"description,omitempty"`
 	// Page ID
@@ -329,8 +329,8 @@ func fillRandomly_Account_PushSettings(o *Account_PushSettings) {
 	fillRandomly_Account_PushConversations((*o).Conversations)
 	(*o).Disabled = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Disabled)
-	(*o).DisabledUntil = new(int)
-	*(*o).DisabledUntil = randInt()
+	(*o).DisabledUntil = new(UnixTime)
+	*(*o).DisabledUntil = randUnixTime()
 	(*o).Settings = new(Account_PushParams)
 	fillRandomly_Account_PushParams((*o).Settings)
 }
@@ -554,8 +554,8 @@ func fillRandomly_Ads_Campaign(o *Ads_Campaign) {
 	(*o).AdsCount = new(int)
 	*(*o).AdsCount = randInt()
 	(*o).AllLimit = randString()
-	(*o).CreateTime = new(int)
-	*(*o).CreateTime = randInt()
+	(*o).CreateTime = new(UnixTime)
+	*(*o).CreateTime = randUnixTime()
 	(*o).DayLimit = randString()
 	(*o).GoalType = new(int)
 	*(*o).GoalType = randInt()
@@ -563,12 +563,12 @@ func fillRandomly_Ads_Campaign(o *Ads_Campaign) {
 	(*o).IsCboEnabled = new(bool)
 	*(*o).IsCboEnabled = randBool()
 	(*o).Name = randString()
-	(*o).StartTime = randInt()
+	(*o).StartTime = randUnixTime()
 	fillRandomly_Ads_CampaignStatus(&(*o).Status)
-	(*o).StopTime = randInt()
+	(*o).StopTime = randUnixTime()
 	fillRandomly_Ads_CampaignType(&(*o).Type)
-	(*o).UpdateTime = new(int)
-	*(*o).UpdateTime = randInt()
+	(*o).UpdateTime = new(UnixTime)
+	*(*o).UpdateTime = randUnixTime()
 	(*o).UserGoalType = new(int)
 	*(*o).UserGoalType = randInt()
 	(*o).ViewsLimit = new(int)
@@ -793,7 +793,7 @@ func fillRandomly_Ads_LookalikeRequest_Status(o *Ads_LookalikeRequest_Status) {
 func fillRandomly_Ads_LookalikeRequest(o *Ads_LookalikeRequest) {
 	(*o).AudienceCount = new(int)
 	*(*o).AudienceCount = randInt()
-	(*o).CreateTime = randInt()
+	(*o).CreateTime = randUnixTime()
 	(*o).Id = randInt()
 	(*o).SaveAudienceLevels = new([]Ads_LookalikeRequestSaveAudienceLevel)
 	l0 := randIntn(maxArrayLength + 1)
@@ -801,15 +801,15 @@ func fillRandomly_Ads_LookalikeRequest(o *Ads_LookalikeRequest) {
 	for i0 := 0; i0 < l0; i0++ {
 		fillRandomly_Ads_LookalikeRequestSaveAudienceLevel(&(*(*o).SaveAudienceLevels)[i0])
 	}
-	(*o).ScheduledDeleteTime = new(int)
-	*(*o).ScheduledDeleteTime = randInt()
+	(*o).ScheduledDeleteTime = new(UnixTime)
+	*(*o).ScheduledDeleteTime = randUnixTime()
 	(*o).SourceName = new(string)
 	*(*o).SourceName = randString()
 	(*o).SourceRetargetingGroupId = new(int)
 	*(*o).SourceRetargetingGroupId = randInt()
 	fillRandomly_Ads_LookalikeRequest_SourceType(&(*o).SourceType)
 	fillRandomly_Ads_LookalikeRequest_Status(&(*o).Status)
-	(*o).UpdateTime = randInt()
+	(*o).UpdateTime = randUnixTime()
 }
 
 func fillRandomly_Ads_LookalikeRequestSaveAudienceLevel(o *Ads_LookalikeRequestSaveAudienceLevel) {
@@ -1246,8 +1246,8 @@ func fillRandomly_Apps_App(o *Apps_App) {
 	*(*o).MembersCount = randInt()
 	(*o).PlatformId = new(string)
 	*(*o).PlatformId = randString()
-	(*o).PublishedDate = new(int)
-	*(*o).PublishedDate = randInt()
+	(*o).PublishedDate = new(UnixTime)
+	*(*o).PublishedDate = randUnixTime()
 	(*o).PushEnabled = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).PushEnabled)
 	(*o).ScreenName = new(string)
@@ -1711,8 +1711,8 @@ func fillRandomly_Base_Place(o *Base_Place) {
 	*(*o).City = randString()
 	(*o).Country = new(string)
 	*(*o).Country = randString()
-	(*o).Created = new(int)
-	*(*o).Created = randInt()
+	(*o).Created = new(UnixTime)
+	*(*o).Created = randUnixTime()
 	(*o).Icon = new(string)
 	*(*o).Icon = randString()
 	(*o).Id = new(int)
@@ -2105,8 +2105,8 @@ func fillRandomly_Board_DefaultOrder(o *Board_DefaultOrder) {
 func fillRandomly_Board_Topic(o *Board_Topic) {
 	(*o).Comments = new(int)
 	*(*o).Comments = randInt()
-	(*o).Created = new(int)
-	*(*o).Created = randInt()
+	(*o).Created = new(UnixTime)
+	*(*o).Created = randUnixTime()
 	(*o).CreatedBy = new(int)
 	*(*o).CreatedBy = randInt()
 	(*o).FirstComment = new(string)
@@ -2121,8 +2121,8 @@ func fillRandomly_Board_Topic(o *Board_Topic) {
 	*(*o).LastComment = randString()
 	(*o).Title = new(string)
 	*(*o).Title = randString()
-	(*o).Updated = new(int)
-	*(*o).Updated = randInt()
+	(*o).Updated = new(UnixTime)
+	*(*o).Updated = randUnixTime()
 	(*o).UpdatedBy = new(int)
 	*(*o).UpdatedBy = randInt()
 }
@@ -2136,7 +2136,7 @@ func fillRandomly_Board_TopicComment(o *Board_TopicComment) {
 	}
 	(*o).CanEdit = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).CanEdit)
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).FromId = randInt()
 	(*o).Id = randInt()
 	(*o).Likes = new(Base_LikesInfo)
@@ -2685,7 +2685,7 @@ func fillRandomly_Database_University(o *Database_University) {
 func fillRandomly_Docs_Doc(o *Docs_Doc) {
 	(*o).AccessKey = new(string)
 	*(*o).AccessKey = randString()
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).Ext = randString()
 	(*o).Id = randInt()
 	(*o).IsLicensed = new(Base_BoolInt)
@@ -2958,8 +2958,8 @@ func fillRandomly_Friends_UserXtrPhone(o *Friends_UserXtrPhone) {
 }
 
 func fillRandomly_Gifts_Gift(o *Gifts_Gift) {
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).FromId = new(int)
 	*(*o).FromId = randInt()
 	(*o).Gift = new(Gifts_Layout)
@@ -3092,10 +3092,10 @@ func fillRandomly_Groups_BanInfo(o *Groups_BanInfo) {
 	*(*o).Comment = randString()
 	(*o).CommentVisible = new(bool)
 	*(*o).CommentVisible = randBool()
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
-	(*o).EndDate = new(int)
-	*(*o).EndDate = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
+	(*o).EndDate = new(UnixTime)
+	*(*o).EndDate = randUnixTime()
 	(*o).IsClosed = new(bool)
 	*(*o).IsClosed = randBool()
 	(*o).Reason = new(Groups_BanInfoReason)
@@ -3371,8 +3371,8 @@ func fillRandomly_Groups_Group(o *Groups_Group) {
 	*(*o).Deactivated = randString()
 	(*o).EstDate = new(string)
 	*(*o).EstDate = randString()
-	(*o).FinishDate = new(int)
-	*(*o).FinishDate = randInt()
+	(*o).FinishDate = new(UnixTime)
+	*(*o).FinishDate = randUnixTime()
 	(*o).Id = randInt()
 	(*o).IsAdmin = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).IsAdmin)
@@ -3408,8 +3408,8 @@ func fillRandomly_Groups_Group(o *Groups_Group) {
 	*(*o).PublicDateLabel = randString()
 	(*o).ScreenName = new(string)
 	*(*o).ScreenName = randString()
-	(*o).StartDate = new(int)
-	*(*o).StartDate = randInt()
+	(*o).StartDate = new(UnixTime)
+	*(*o).StartDate = randUnixTime()
 	(*o).Type = new(Groups_GroupType)
 	fillRandomly_Groups_GroupType((*o).Type)
 	(*o).VideoLive = new(Video_LiveInfo)
@@ -3471,8 +3471,8 @@ func fillRandomly_Groups_GroupAudio(o *Groups_GroupAudio) {
 func fillRandomly_Groups_GroupBanInfo(o *Groups_GroupBanInfo) {
 	(*o).Comment = new(string)
 	*(*o).Comment = randString()
-	(*o).EndDate = new(int)
-	*(*o).EndDate = randInt()
+	(*o).EndDate = new(UnixTime)
+	*(*o).EndDate = randUnixTime()
 	(*o).Reason = new(Groups_BanInfoReason)
 	fillRandomly_Groups_BanInfoReason((*o).Reason)
 }
@@ -4513,7 +4513,7 @@ func fillRandomly_Market_MarketAlbum(o *Market_MarketAlbum) {
 	(*o).Photo = new(Photos_Photo)
 	fillRandomly_Photos_Photo((*o).Photo)
 	(*o).Title = randString()
-	(*o).UpdatedTime = randInt()
+	(*o).UpdatedTime = randUnixTime()
 }
 
 func fillRandomly_Market_MarketCategory(o *Market_MarketCategory) {
@@ -4553,8 +4553,8 @@ func fillRandomly_Market_MarketItem(o *Market_MarketItem) {
 	(*o).ButtonTitle = new(string)
 	*(*o).ButtonTitle = randString()
 	fillRandomly_Market_MarketCategory(&(*o).Category)
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).Description = randString()
 	(*o).ExternalId = new(string)
 	*(*o).ExternalId = randString()
@@ -5116,8 +5116,8 @@ func fillRandomly_Messages_ForeignMessage(o *Messages_ForeignMessage) {
 	(*o).ReplyMessage = new(Messages_ForeignMessage)
 	//fillRandomly_Messages_ForeignMessage((*o).ReplyMessage)
 	(*o).Text = randString()
-	(*o).UpdateTime = new(int)
-	*(*o).UpdateTime = randInt()
+	(*o).UpdateTime = new(UnixTime)
+	*(*o).UpdateTime = randUnixTime()
 	(*o).WasListened = new(bool)
 	*(*o).WasListened = randBool()
 }
@@ -5430,7 +5430,7 @@ func fillRandomly_Messages_KeyboardButtonPropertyAction(o *Messages_KeyboardButt
 
 func fillRandomly_Messages_LastActivity(o *Messages_LastActivity) {
 	fillRandomly_Base_BoolInt(&(*o).Online)
-	(*o).Time = randInt()
+	(*o).Time = randUnixTime()
 }
 
 func fillRandomly_Messages_LongpollMessages(o *Messages_LongpollMessages) {
@@ -5465,7 +5465,7 @@ func fillRandomly_Messages_Message(o *Messages_Message) {
 	}
 	(*o).ConversationMessageId = new(int)
 	*(*o).ConversationMessageId = randInt()
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).Deleted = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Deleted)
 	(*o).FromId = randInt()
@@ -5494,8 +5494,8 @@ func fillRandomly_Messages_Message(o *Messages_Message) {
 	(*o).Payload = new(string)
 	*(*o).Payload = randString()
 	(*o).PeerId = randInt()
-	(*o).PinnedAt = new(int)
-	*(*o).PinnedAt = randInt()
+	(*o).PinnedAt = new(UnixTime)
+	*(*o).PinnedAt = randUnixTime()
 	(*o).RandomId = new(int)
 	*(*o).RandomId = randInt()
 	(*o).Ref = new(string)
@@ -5505,8 +5505,8 @@ func fillRandomly_Messages_Message(o *Messages_Message) {
 	(*o).ReplyMessage = new(Messages_ForeignMessage)
 	fillRandomly_Messages_ForeignMessage((*o).ReplyMessage)
 	(*o).Text = randString()
-	(*o).UpdateTime = new(int)
-	*(*o).UpdateTime = randInt()
+	(*o).UpdateTime = new(UnixTime)
+	*(*o).UpdateTime = randUnixTime()
 	(*o).WasListened = new(bool)
 	*(*o).WasListened = randBool()
 }
@@ -5669,7 +5669,7 @@ func fillRandomly_Messages_PinnedMessage(o *Messages_PinnedMessage) {
 	}
 	(*o).ConversationMessageId = new(int)
 	*(*o).ConversationMessageId = randInt()
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).FromId = randInt()
 	(*o).FwdMessages = new([]Messages_ForeignMessage)
 	l0 = randIntn(maxArrayLength + 1)
@@ -5791,7 +5791,7 @@ func fillRandomly_Newsfeed_ItemAudioAudio(o *Newsfeed_ItemAudioAudio) {
 }
 
 func fillRandomly_Newsfeed_ItemBase(o *Newsfeed_ItemBase) {
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).SourceId = randInt()
 	fillRandomly_Newsfeed_NewsfeedItemType(&(*o).Type)
 }
@@ -6053,6 +6053,7 @@ func fillRandomly_Newsfeed_ItemWallpost(o *Newsfeed_ItemWallpost) {
 	fillRandomly_Wall_WallpostFull(&(*o).Wall_WallpostFull)
 	(*o).Feedback = new(Newsfeed_ItemWallpostFeedback)
 	fillRandomly_Newsfeed_ItemWallpostFeedback((*o).Feedback)
+	(*o).Date = randUnixTime()
 }
 
 func fillRandomly_Newsfeed_ItemWallpostFeedback(o *Newsfeed_ItemWallpostFeedback) {
@@ -6189,7 +6190,7 @@ func fillRandomly_Notes_Note(o *Notes_Note) {
 	(*o).CanComment = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).CanComment)
 	(*o).Comments = randInt()
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).Id = randInt()
 	(*o).OwnerId = randInt()
 	(*o).PrivacyComment = new([]string)
@@ -6215,7 +6216,7 @@ func fillRandomly_Notes_Note(o *Notes_Note) {
 }
 
 func fillRandomly_Notes_NoteComment(o *Notes_NoteComment) {
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).Id = randInt()
 	(*o).Message = randString()
 	(*o).Nid = randInt()
@@ -6271,11 +6272,41 @@ func fillRandomly_Notifications_NotificationParent(o *Notifications_Notification
 	fillRandomly_Board_Topic(&(*o).Board_Topic)
 	fillRandomly_Video_Video(&(*o).Video_Video)
 	fillRandomly_Notifications_NotificationsComment(&(*o).Notifications_NotificationsComment)
+	(*o).AccessKey = new(string)
+	*(*o).AccessKey = randString()
+	(*o).CanComment = new(Base_BoolInt)
+	fillRandomly_Base_BoolInt((*o).CanComment)
+	(*o).Comments = new(json.RawMessage)
+	*(*o).Comments = randRaw()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
+	(*o).Height = new(int)
+	*(*o).Height = randInt()
+	(*o).Id = new(int)
+	*(*o).Id = randInt()
+	(*o).IsFavorite = new(bool)
+	*(*o).IsFavorite = randBool()
+	(*o).Likes = new(Base_LikesInfo)
+	fillRandomly_Base_LikesInfo((*o).Likes)
+	(*o).OwnerId = new(int)
+	*(*o).OwnerId = randInt()
+	(*o).PostId = new(int)
+	*(*o).PostId = randInt()
+	(*o).Reposts = new(Base_RepostsInfo)
+	fillRandomly_Base_RepostsInfo((*o).Reposts)
+	(*o).Text = new(string)
+	*(*o).Text = randString()
+	(*o).Title = new(string)
+	*(*o).Title = randString()
+	(*o).UserId = new(int)
+	*(*o).UserId = randInt()
+	(*o).Width = new(int)
+	*(*o).Width = randInt()
 }
 
 func fillRandomly_Notifications_NotificationsComment(o *Notifications_NotificationsComment) {
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).Id = new(int)
 	*(*o).Id = randInt()
 	(*o).OwnerId = new(int)
@@ -6293,8 +6324,8 @@ func fillRandomly_Notifications_NotificationsComment(o *Notifications_Notificati
 }
 
 func fillRandomly_Notifications_Reply(o *Notifications_Reply) {
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).Id = new(int)
 	*(*o).Id = randInt()
 	(*o).Text = new(int)
@@ -6396,17 +6427,17 @@ func fillRandomly_Orders_Subscription(o *Orders_Subscription) {
 	*(*o).ApplicationName = randString()
 	(*o).CancelReason = new(string)
 	*(*o).CancelReason = randString()
-	(*o).CreateTime = randInt()
-	(*o).ExpireTime = new(int)
-	*(*o).ExpireTime = randInt()
+	(*o).CreateTime = randUnixTime()
+	(*o).ExpireTime = new(UnixTime)
+	*(*o).ExpireTime = randUnixTime()
 	(*o).Id = randInt()
 	(*o).ItemId = randString()
-	(*o).NextBillTime = new(int)
-	*(*o).NextBillTime = randInt()
+	(*o).NextBillTime = new(UnixTime)
+	*(*o).NextBillTime = randUnixTime()
 	(*o).PendingCancel = new(bool)
 	*(*o).PendingCancel = randBool()
 	(*o).Period = randInt()
-	(*o).PeriodStartTime = randInt()
+	(*o).PeriodStartTime = randUnixTime()
 	(*o).PhotoUrl = new(string)
 	*(*o).PhotoUrl = randString()
 	(*o).Price = randInt()
@@ -6415,9 +6446,9 @@ func fillRandomly_Orders_Subscription(o *Orders_Subscription) {
 	*(*o).TestMode = randBool()
 	(*o).Title = new(string)
 	*(*o).Title = randString()
-	(*o).TrialExpireTime = new(int)
-	*(*o).TrialExpireTime = randInt()
-	(*o).UpdateTime = randInt()
+	(*o).TrialExpireTime = new(UnixTime)
+	*(*o).TrialExpireTime = randUnixTime()
+	(*o).UpdateTime = randUnixTime()
 }
 
 func fillRandomly_Owner_State_State(o *Owner_State_State) {
@@ -6471,14 +6502,14 @@ func fillRandomly_Pages_Wikipage(o *Pages_Wikipage) {
 }
 
 func fillRandomly_Pages_WikipageFull(o *Pages_WikipageFull) {
-	(*o).Created = randInt()
+	(*o).Created = randUnixTime()
 	(*o).CreatorId = new(int)
 	*(*o).CreatorId = randInt()
 	(*o).CurrentUserCanEdit = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).CurrentUserCanEdit)
 	(*o).CurrentUserCanEditAccess = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).CurrentUserCanEditAccess)
-	(*o).Edited = randInt()
+	(*o).Edited = randUnixTime()
 	(*o).EditorId = new(int)
 	*(*o).EditorId = randInt()
 	(*o).GroupId = randInt()
@@ -6503,7 +6534,7 @@ func fillRandomly_Pages_WikipageFull(o *Pages_WikipageFull) {
 }
 
 func fillRandomly_Pages_WikipageHistory(o *Pages_WikipageHistory) {
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).EditorId = randInt()
 	(*o).EditorName = randString()
 	(*o).Id = randInt()
@@ -6599,7 +6630,7 @@ func fillRandomly_Photos_Photo(o *Photos_Photo) {
 }
 
 func fillRandomly_Photos_PhotoAlbum(o *Photos_PhotoAlbum) {
-	(*o).Created = randInt()
+	(*o).Created = randUnixTime()
 	(*o).Description = new(string)
 	*(*o).Description = randString()
 	(*o).Id = randInt()
@@ -6608,7 +6639,7 @@ func fillRandomly_Photos_PhotoAlbum(o *Photos_PhotoAlbum) {
 	(*o).Thumb = new(Photos_Photo)
 	fillRandomly_Photos_Photo((*o).Thumb)
 	(*o).Title = randString()
-	(*o).Updated = randInt()
+	(*o).Updated = randUnixTime()
 }
 
 func fillRandomly_Photos_PhotoAlbumFull(o *Photos_PhotoAlbumFull) {
@@ -6618,7 +6649,7 @@ func fillRandomly_Photos_PhotoAlbumFull(o *Photos_PhotoAlbumFull) {
 	fillRandomly_Base_BoolInt((*o).CanUpload)
 	(*o).CommentsDisabled = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).CommentsDisabled)
-	(*o).Created = randInt()
+	(*o).Created = randUnixTime()
 	(*o).Description = new(string)
 	*(*o).Description = randString()
 	(*o).Id = randInt()
@@ -6637,7 +6668,7 @@ func fillRandomly_Photos_PhotoAlbumFull(o *Photos_PhotoAlbumFull) {
 	(*o).ThumbSrc = new(string)
 	*(*o).ThumbSrc = randString()
 	(*o).Title = randString()
-	(*o).Updated = randInt()
+	(*o).Updated = randUnixTime()
 	(*o).UploadByAdminsOnly = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).UploadByAdminsOnly)
 }
@@ -6775,7 +6806,7 @@ func fillRandomly_Photos_PhotoSizesType(o *Photos_PhotoSizesType) {
 }
 
 func fillRandomly_Photos_PhotoTag(o *Photos_PhotoTag) {
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).Description = new(string)
 	*(*o).Description = randString()
 	(*o).Id = randInt()
@@ -6879,8 +6910,8 @@ func fillRandomly_Photos_PhotoXtrTagInfo(o *Photos_PhotoXtrTagInfo) {
 	for i0 := 0; i0 < l0; i0++ {
 		fillRandomly_Photos_PhotoSizes(&(*(*o).Sizes)[i0])
 	}
-	(*o).TagCreated = new(int)
-	*(*o).TagCreated = randInt()
+	(*o).TagCreated = new(UnixTime)
+	*(*o).TagCreated = randUnixTime()
 	(*o).TagId = new(int)
 	*(*o).TagId = randInt()
 	(*o).Text = new(string)
@@ -7041,7 +7072,7 @@ func fillRandomly_Polls_Poll(o *Polls_Poll) {
 	(*o).CanShare = randBool()
 	(*o).CanVote = randBool()
 	(*o).Closed = randBool()
-	(*o).Created = randInt()
+	(*o).Created = randUnixTime()
 	(*o).DisableUnvote = randBool()
 	(*o).EmbedHash = new(string)
 	*(*o).EmbedHash = randString()
@@ -7222,10 +7253,10 @@ func fillRandomly_Secure_SmsNotification(o *Secure_SmsNotification) {
 }
 
 func fillRandomly_Secure_TokenChecked(o *Secure_TokenChecked) {
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
-	(*o).Expire = new(int)
-	*(*o).Expire = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
+	(*o).Expire = new(UnixTime)
+	*(*o).Expire = randUnixTime()
 	(*o).Success = new(int)
 	*(*o).Success = randInt()
 	(*o).UserId = new(int)
@@ -7233,8 +7264,8 @@ func fillRandomly_Secure_TokenChecked(o *Secure_TokenChecked) {
 }
 
 func fillRandomly_Secure_Transaction(o *Secure_Transaction) {
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).Id = new(int)
 	*(*o).Id = randInt()
 	(*o).UidFrom = new(int)
@@ -7283,10 +7314,10 @@ func fillRandomly_Stats_Country(o *Stats_Country) {
 func fillRandomly_Stats_Period(o *Stats_Period) {
 	(*o).Activity = new(Stats_Activity)
 	fillRandomly_Stats_Activity((*o).Activity)
-	(*o).PeriodFrom = new(int)
-	*(*o).PeriodFrom = randInt()
-	(*o).PeriodTo = new(int)
-	*(*o).PeriodTo = randInt()
+	(*o).PeriodFrom = new(UnixTime)
+	*(*o).PeriodFrom = randUnixTime()
+	(*o).PeriodTo = new(UnixTime)
+	*(*o).PeriodTo = randUnixTime()
 	(*o).Reach = new(Stats_Reach)
 	fillRandomly_Stats_Reach((*o).Reach)
 	(*o).Visitors = new(Stats_Views)
@@ -7462,8 +7493,8 @@ func fillRandomly_Store_Product(o *Store_Product) {
 	}
 	(*o).Promoted = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Promoted)
-	(*o).PurchaseDate = new(int)
-	*(*o).PurchaseDate = randInt()
+	(*o).PurchaseDate = new(UnixTime)
+	*(*o).PurchaseDate = randUnixTime()
 	(*o).Purchased = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Purchased)
 	(*o).Stickers = new(Base_StickersList)
@@ -7761,10 +7792,10 @@ func fillRandomly_Stories_Story(o *Stories_Story) {
 	*(*o).CanUseInNarrative = randBool()
 	(*o).ClickableStickers = new(Stories_ClickableStickers)
 	fillRandomly_Stories_ClickableStickers((*o).ClickableStickers)
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
-	(*o).ExpiresAt = new(int)
-	*(*o).ExpiresAt = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
+	(*o).ExpiresAt = new(UnixTime)
+	*(*o).ExpiresAt = randUnixTime()
 	(*o).FirstNarrativeTitle = new(string)
 	*(*o).FirstNarrativeTitle = randString()
 	(*o).Id = randInt()
@@ -8159,8 +8190,8 @@ func fillRandomly_Users_Fields(o *Users_Fields) {
 func fillRandomly_Users_LastSeen(o *Users_LastSeen) {
 	(*o).Platform = new(int)
 	*(*o).Platform = randInt()
-	(*o).Time = new(int)
-	*(*o).Time = randInt()
+	(*o).Time = new(UnixTime)
+	*(*o).Time = randUnixTime()
 }
 
 func fillRandomly_Users_Military(o *Users_Military) {
@@ -8852,8 +8883,8 @@ func fillRandomly_Utils_LastShortenedLink(o *Utils_LastShortenedLink) {
 	*(*o).Key = randString()
 	(*o).ShortUrl = new(string)
 	*(*o).ShortUrl = randString()
-	(*o).Timestamp = new(int)
-	*(*o).Timestamp = randInt()
+	(*o).Timestamp = new(UnixTime)
+	*(*o).Timestamp = randUnixTime()
 	(*o).Url = new(string)
 	*(*o).Url = randString()
 	(*o).Views = new(int)
@@ -9027,8 +9058,8 @@ func fillRandomly_Video_Video(o *Video_Video) {
 	*(*o).AccessKey = randString()
 	(*o).Added = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Added)
-	(*o).AddingDate = new(int)
-	*(*o).AddingDate = randInt()
+	(*o).AddingDate = new(UnixTime)
+	*(*o).AddingDate = randUnixTime()
 	(*o).Balance = new(int)
 	*(*o).Balance = randInt()
 	(*o).CanAdd = new(Base_BoolInt)
@@ -9055,8 +9086,8 @@ func fillRandomly_Video_Video(o *Video_Video) {
 	*(*o).ContentRestrictedMessage = randString()
 	(*o).Converting = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Converting)
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).Description = new(string)
 	*(*o).Description = randString()
 	(*o).Duration = new(int)
@@ -9089,8 +9120,8 @@ func fillRandomly_Video_Video(o *Video_Video) {
 	fillRandomly_Base_PropertyExists((*o).Live)
 	(*o).LiveNotify = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).LiveNotify)
-	(*o).LiveStartTime = new(int)
-	*(*o).LiveStartTime = randInt()
+	(*o).LiveStartTime = new(UnixTime)
+	*(*o).LiveStartTime = randUnixTime()
 	(*o).LiveStatus = new(Video_Video_LiveStatus)
 	fillRandomly_Video_Video_LiveStatus((*o).LiveStatus)
 	(*o).LocalViews = new(int)
@@ -9144,7 +9175,7 @@ func fillRandomly_Video_VideoAlbumFull(o *Video_VideoAlbumFull) {
 	fillRandomly_Base_PropertyExists((*o).ImageBlur)
 	(*o).IsSystem = new(Base_PropertyExists)
 	fillRandomly_Base_PropertyExists((*o).IsSystem)
-	(*o).UpdatedTime = randInt()
+	(*o).UpdatedTime = randUnixTime()
 }
 
 func fillRandomly_Video_VideoFiles(o *Video_VideoFiles) {
@@ -9201,7 +9232,7 @@ func fillRandomly_Wall_AttachedNote(o *Wall_AttachedNote) {
 	(*o).CanComment = new(int)
 	*(*o).CanComment = randInt()
 	(*o).Comments = randInt()
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).Id = randInt()
 	(*o).OwnerId = randInt()
 	(*o).PrivacyComment = new([]string)
@@ -9421,7 +9452,7 @@ func fillRandomly_Wall_WallComment(o *Wall_WallComment) {
 	}
 	(*o).CanEdit = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).CanEdit)
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).Deleted = new(bool)
 	*(*o).Deleted = randBool()
 	(*o).Donut = new(Wall_WallCommentDonut)
@@ -9479,10 +9510,10 @@ func fillRandomly_Wall_Wallpost(o *Wall_Wallpost) {
 	}
 	(*o).Copyright = new(Wall_PostCopyright)
 	fillRandomly_Wall_PostCopyright((*o).Copyright)
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
-	(*o).Edited = new(int)
-	*(*o).Edited = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
+	(*o).Edited = new(UnixTime)
+	*(*o).Edited = randUnixTime()
 	(*o).FromId = new(int)
 	*(*o).FromId = randInt()
 	(*o).Geo = new(Wall_Geo)
@@ -9725,8 +9756,8 @@ func fillRandomly_Wall_WallpostToId(o *Wall_WallpostToId) {
 	*(*o).CopyOwnerId = randInt()
 	(*o).CopyPostId = new(int)
 	*(*o).CopyPostId = randInt()
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).FromId = new(int)
 	*(*o).FromId = randInt()
 	(*o).Geo = new(Wall_Geo)
@@ -9791,8 +9822,8 @@ func fillRandomly_Widgets_CommentReplies(o *Widgets_CommentReplies) {
 func fillRandomly_Widgets_CommentRepliesItem(o *Widgets_CommentRepliesItem) {
 	(*o).Cid = new(int)
 	*(*o).Cid = randInt()
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).Likes = new(Widgets_WidgetLikes)
 	fillRandomly_Widgets_WidgetLikes((*o).Likes)
 	(*o).Text = new(string)
@@ -9814,7 +9845,7 @@ func fillRandomly_Widgets_WidgetComment(o *Widgets_WidgetComment) {
 	fillRandomly_Base_BoolInt((*o).CanDelete)
 	(*o).Comments = new(Widgets_CommentReplies)
 	fillRandomly_Widgets_CommentReplies((*o).Comments)
-	(*o).Date = randInt()
+	(*o).Date = randUnixTime()
 	(*o).FromId = randInt()
 	(*o).Id = randInt()
 	(*o).Likes = new(Base_LikesInfo)
@@ -9840,8 +9871,8 @@ func fillRandomly_Widgets_WidgetLikes(o *Widgets_WidgetLikes) {
 func fillRandomly_Widgets_WidgetPage(o *Widgets_WidgetPage) {
 	(*o).Comments = new(Base_ObjectCount)
 	fillRandomly_Base_ObjectCount((*o).Comments)
-	(*o).Date = new(int)
-	*(*o).Date = randInt()
+	(*o).Date = new(UnixTime)
+	*(*o).Date = randUnixTime()
 	(*o).Description = new(string)
 	*(*o).Description = randString()
 	(*o).Id = new(int)
@@ -0,0 +1,59 @@
+package vk_sdk
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestOneOf_Accessors(t *testing.T) {
+	action, err := NewMessages_KeyboardButtonPropertyActionFromKeyboardButtonActionText(Messages_KeyboardButtonActionText{
+		Label: "label",
+		Type:  Messages_KeyboardButtonActionText_Type_Text,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "text", action.Kind())
+
+	text, ok := action.AsKeyboardButtonActionText()
+	require.True(t, ok)
+	assert.Equal(t, "label", text.Label)
+
+	_, ok = action.AsKeyboardButtonActionCallback()
+	assert.False(t, ok)
+
+	var item Newsfeed_NewsfeedItem
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"friend","source_id":1,"date":2}`), &item))
+
+	assert.Equal(t, Newsfeed_NewsfeedItemType_Friend, item.Kind())
+
+	friend, ok := item.AsItemFriend()
+	require.True(t, ok)
+	assert.Equal(t, 1, friend.SourceId)
+
+	_, ok = item.AsItemWallpost()
+	assert.False(t, ok, "friend item is not a wallpost")
+
+	_, ok = item.AsItemPhoto()
+	assert.False(t, ok, "friend item is not a photo")
+
+	var wallPhoto Newsfeed_NewsfeedItem
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"wall_photo","source_id":1,"date":2}`), &wallPhoto))
+
+	_, ok = wallPhoto.AsItemPhoto()
+	assert.True(t, ok)
+
+	_, ok = wallPhoto.AsItemFriend()
+	assert.False(t, ok, "wall photo item is not a friend")
+
+	var falseable Photos_PhotoFalseable
+	require.NoError(t, json.Unmarshal([]byte(`false`), &falseable))
+
+	_, ok = falseable.AsString()
+	assert.False(t, ok)
+
+	b, ok := falseable.AsBool()
+	assert.True(t, ok)
+	assert.False(t, b)
+}
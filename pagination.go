@@ -0,0 +1,167 @@
+package vk_sdk
+
+import "context"
+
+// pager keeps state of generated iterator that fetches method results page by page.
+//
+//    it := vk.Wall_GetIter(ctx, vk_sdk.Wall_Get_Request{OwnerId: &ownerID})
+//
+//    for it.Next() {
+//        post := it.Item()
+//        ...
+//    }
+//
+//    if it.Err() != nil || it.ApiErr() != nil {
+//        ...
+//    }
+type pager struct {
+	ctx    context.Context
+	done   bool
+	apiErr ApiError
+	err    error
+}
+
+// start checks that the next page may be fetched.
+func (p *pager) start() bool {
+	if p.done {
+		return false
+	}
+
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	return true
+}
+
+// stop stops iteration if request is failed.
+func (p *pager) stop(apiErr ApiError, err error) bool {
+	if err != nil || apiErr != nil {
+		p.err, p.apiErr = err, apiErr
+		p.done = true
+		return true
+	}
+
+	return false
+}
+
+// Err returns error of the request that stopped iteration or context error.
+func (p *pager) Err() error {
+	return p.err
+}
+
+// ApiErr returns API error of the request that stopped iteration.
+func (p *pager) ApiErr() ApiError {
+	return p.apiErr
+}
+
+// offsetPager fetches pages of method with offset and count parameters
+// until total count of items is exhausted.
+type offsetPager struct {
+	pager
+	offset int
+	count  *int
+	fetch  func(offset int, count *int) (n, total int, apiErr ApiError, err error)
+}
+
+// newOffsetPager create and return new offsetPager starting from offset.
+// Count is page size limited by maxCount. Zero maxCount means unknown maximum,
+// nil count means default page size of method or maxCount if it is known.
+func newOffsetPager(ctx context.Context, offset, count *int, maxCount int,
+	fetch func(offset int, count *int) (n, total int, apiErr ApiError, err error)) offsetPager {
+	p := offsetPager{
+		pager: pager{ctx: ctx},
+		fetch: fetch,
+	}
+
+	if offset != nil {
+		p.offset = *offset
+	}
+
+	switch {
+	case count != nil && (maxCount == 0 || *count <= maxCount):
+		c := *count
+		p.count = &c
+	case maxCount > 0:
+		p.count = &maxCount
+	}
+
+	return p
+}
+
+// nextPage fetches the next page. It returns false when there are no more pages.
+func (p *offsetPager) nextPage() bool {
+	if !p.start() {
+		return false
+	}
+
+	n, total, apiErr, err := p.fetch(p.offset, p.count)
+
+	if p.stop(apiErr, err) {
+		return false
+	}
+
+	// pages may contain fewer items if deleted or blocked ones are filtered,
+	// so offset is moved by page size to not fetch the same items again
+	if p.count != nil {
+		p.offset += *p.count
+	} else {
+		p.offset += n
+	}
+
+	// unknown total is negative
+	if n == 0 || (total >= 0 && p.offset >= total) {
+		p.done = true
+	}
+
+	return n > 0
+}
+
+// cursorPager fetches pages of method with start_from parameter
+// until response contains next_from.
+type cursorPager struct {
+	pager
+	startFrom *string
+	fetch     func(startFrom *string) (nextFrom *string, apiErr ApiError, err error)
+}
+
+// newCursorPager create and return new cursorPager starting from startFrom.
+func newCursorPager(ctx context.Context, startFrom *string,
+	fetch func(startFrom *string) (nextFrom *string, apiErr ApiError, err error)) cursorPager {
+	p := cursorPager{
+		pager: pager{ctx: ctx},
+		fetch: fetch,
+	}
+
+	if startFrom != nil {
+		s := *startFrom
+		p.startFrom = &s
+	}
+
+	return p
+}
+
+// nextPage fetches the next page. It returns false when there are no more pages.
+// Fetched page may be empty, but not the last one, so the caller must check items.
+func (p *cursorPager) nextPage() bool {
+	if !p.start() {
+		return false
+	}
+
+	nextFrom, apiErr, err := p.fetch(p.startFrom)
+
+	if p.stop(apiErr, err) {
+		return false
+	}
+
+	// the same cursor would return the same page again
+	if nextFrom == nil || *nextFrom == "" || (p.startFrom != nil && *nextFrom == *p.startFrom) {
+		p.done = true
+	} else {
+		p.startFrom = nextFrom
+	}
+
+	return true
+}
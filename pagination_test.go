@@ -0,0 +1,161 @@
+package vk_sdk
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+func TestVK_Wall_GetIter(t *testing.T) {
+	responses := []string{
+		`{"response":{"count":5,"items":[{"id":1},{"id":2}]}}`,
+		`{"response":{"count":5,"items":[{"id":3},{"id":4}]}}`,
+		`{"response":{"count":5,"items":[{"id":5}]}}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {
+		assert.Equal(t, "2", values.Get("count"))
+		assert.Equal(t, []string{"0", "2", "4"}[attempt], values.Get("offset"))
+	})
+
+	count := 2
+	it := NewVK(client, "").Wall_GetIter(context.Background(), Wall_Get_Request{Count: &count})
+
+	var ids []int
+
+	for it.Next() {
+		ids = append(ids, *it.Item().Id)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	assert.NoError(t, it.Err())
+	assert.Nil(t, it.ApiErr())
+}
+
+func TestVK_Wall_GetIter_FilteredItems(t *testing.T) {
+	responses := []string{
+		`{"response":{"count":5,"items":[{"id":1}]}}`,
+		`{"response":{"count":5,"items":[{"id":3},{"id":4}]}}`,
+		`{"response":{"count":5,"items":[{"id":5}]}}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {
+		assert.Equal(t, []string{"0", "2", "4"}[attempt], values.Get("offset"))
+	})
+
+	count := 2
+	it := NewVK(client, "").Wall_GetIter(context.Background(), Wall_Get_Request{Count: &count})
+
+	var ids []int
+
+	for it.Next() {
+		ids = append(ids, *it.Item().Id)
+	}
+
+	assert.Equal(t, []int{1, 3, 4, 5}, ids)
+	assert.NoError(t, it.Err())
+}
+
+func TestVK_Wall_GetIter_MaxCount(t *testing.T) {
+	responses := []string{
+		`{"response":{"count":0,"items":[]}}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {
+		assert.Equal(t, "100", values.Get("count"))
+		assert.Equal(t, "10", values.Get("offset"))
+	})
+
+	offset, count := 10, 1000
+	it := NewVK(client, "").Wall_GetIter(context.Background(), Wall_Get_Request{Offset: &offset, Count: &count})
+
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+}
+
+func TestVK_Wall_GetIter_ApiError(t *testing.T) {
+	responses := []string{
+		`{"response":{"count":3,"items":[{"id":1}]}}`,
+		`{"error_code":6,"error_msg":"Too many requests per second"}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {})
+
+	vk := NewVK(client, "")
+	vk.SetRetryPolicy(nil)
+
+	count := 1
+	it := vk.Wall_GetIter(context.Background(), Wall_Get_Request{Count: &count})
+
+	require.True(t, it.Next())
+	assert.False(t, it.Next())
+	require.NotNil(t, it.ApiErr())
+	assert.True(t, it.ApiErr().Is(Error_TooMany))
+	assert.NoError(t, it.Err())
+	assert.False(t, it.Next())
+}
+
+func TestVK_Wall_GetIter_Context(t *testing.T) {
+	responses := []string{
+		`{"response":{"count":3,"items":[{"id":1}]}}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 1
+	it := NewVK(client, "").Wall_GetIter(ctx, Wall_Get_Request{Count: &count})
+
+	require.True(t, it.Next())
+	cancel()
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), context.Canceled)
+}
+
+func TestVK_Newsfeed_GetIter(t *testing.T) {
+	responses := []string{
+		`{"response":{"items":[{"type":"post","source_id":1,"date":1}],"next_from":"a"}}`,
+		`{"response":{"items":[],"next_from":"b"}}`,
+		`{"response":{"items":[{"type":"post","source_id":2,"date":2}]}}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {
+		assert.Equal(t, []string{"start", "a", "b"}[attempt], values.Get("start_from"))
+	})
+
+	startFrom := "start"
+	it := NewVK(client, "").Newsfeed_GetIter(context.Background(), Newsfeed_Get_Request{StartFrom: &startFrom})
+
+	var kinds []Newsfeed_NewsfeedItemType
+
+	for it.Next() {
+		kinds = append(kinds, it.Item().Kind())
+	}
+
+	assert.Equal(t, []Newsfeed_NewsfeedItemType{Newsfeed_NewsfeedItemType_Post, Newsfeed_NewsfeedItemType_Post}, kinds)
+	assert.NoError(t, it.Err())
+	assert.Nil(t, it.ApiErr())
+	assert.Equal(t, "start", startFrom)
+}
+
+func TestVK_Newsfeed_SearchIter_SameCursor(t *testing.T) {
+	responses := []string{
+		`{"response":{"items":[{"id":1}],"next_from":"a"}}`,
+		`{"response":{"items":[{"id":2}],"next_from":"a"}}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {})
+
+	it := NewVK(client, "").Newsfeed_SearchIter(context.Background(), Newsfeed_Search_Request{})
+
+	var ids []int
+
+	for it.Next() {
+		ids = append(ids, *it.Item().Id)
+	}
+
+	assert.Equal(t, []int{1, 2}, ids)
+	assert.NoError(t, it.Err())
+}
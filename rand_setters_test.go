@@ -1,7 +1,9 @@
 package vk_sdk
 
 import (
+	"encoding/json"
 	"math/rand"
+	"strconv"
 	"time"
 )
 
@@ -39,3 +41,11 @@ func randBool() bool {
 func randFloat() float64 {
 	return rand.NormFloat64()
 }
+
+func randRaw() json.RawMessage {
+	return json.RawMessage(strconv.Quote(randString()))
+}
+
+func randUnixTime() UnixTime {
+	return UnixTime(randInt())
+}
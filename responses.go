@@ -1092,7 +1092,7 @@ type Groups_GetSettings_Response struct {
 		EventGroupId *int          `json:"event_group_id,omitempty"`
 		Events       *Base_BoolInt `json:"events,omitempty"`
 		// Finish date in Unix-time format
-		FinishDate  *int                     `json:"finish_date,omitempty"`
+		FinishDate  *UnixTime                `json:"finish_date,omitempty"`
 		Links       *Base_BoolInt            `json:"links,omitempty"`
 		MainSection *Groups_GroupFullSection `json:"main_section,omitempty"`
 		// Information whether the obscene filter is enabled
@@ -1732,8 +1732,10 @@ type Newsfeed_Generic_Response struct {
 		Groups []Groups_GroupFull      `json:"groups"`
 		Items  []Newsfeed_NewsfeedItem `json:"items"`
 		//  Minimum: 0
-		NewReturnedNewsItemsCount *int             `json:"new_returned_news_items_count,omitempty"`
-		Profiles                  []Users_UserFull `json:"profiles"`
+		NewReturnedNewsItemsCount *int `json:"new_returned_news_items_count,omitempty"`
+		// Next from value
+		NextFrom *string          `json:"next_from,omitempty"`
+		Profiles []Users_UserFull `json:"profiles"`
 	} `json:"response"`
 }
 
@@ -2606,7 +2608,7 @@ type Utils_GetLinkStats_Response struct {
 
 type Utils_GetServerTime_Response struct {
 	// Time as Unixtime
-	Response int `json:"response"`
+	Response UnixTime `json:"response"`
 }
 
 type Utils_GetShortLink_Response struct {
@@ -1322,8 +1322,8 @@ func fillRandomly_Groups_GetSettings_Response(o *Groups_GetSettings_Response) {
 	*(*o).Response.EventGroupId = randInt()
 	(*o).Response.Events = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Response.Events)
-	(*o).Response.FinishDate = new(int)
-	*(*o).Response.FinishDate = randInt()
+	(*o).Response.FinishDate = new(UnixTime)
+	*(*o).Response.FinishDate = randUnixTime()
 	(*o).Response.Links = new(Base_BoolInt)
 	fillRandomly_Base_BoolInt((*o).Response.Links)
 	(*o).Response.MainSection = new(Groups_GroupFullSection)
@@ -2200,6 +2200,8 @@ func fillRandomly_Newsfeed_Generic_Response(o *Newsfeed_Generic_Response) {
 	}
 	(*o).Response.NewReturnedNewsItemsCount = new(int)
 	*(*o).Response.NewReturnedNewsItemsCount = randInt()
+	(*o).Response.NextFrom = new(string)
+	*(*o).Response.NextFrom = randString()
 	l0 = randIntn(maxArrayLength + 1)
 	(*o).Response.Profiles = make([]Users_UserFull, l0)
 	for i0 := 0; i0 < l0; i0++ {
@@ -3316,7 +3318,7 @@ func fillRandomly_Utils_GetLinkStats_Response(o *Utils_GetLinkStats_Response) {
 }
 
 func fillRandomly_Utils_GetServerTime_Response(o *Utils_GetServerTime_Response) {
-	(*o).Response = randInt()
+	(*o).Response = randUnixTime()
 }
 
 func fillRandomly_Utils_GetShortLink_Response(o *Utils_GetShortLink_Response) {
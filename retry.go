@@ -0,0 +1,156 @@
+package vk_sdk
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/url"
+	"time"
+)
+
+// DefaultRetryCodes are global errors that are transient and may disappear on the next attempt.
+var DefaultRetryCodes = []ErrorCode{
+	Error_Unknown,
+	Error_TooMany,
+	Error_Flood,
+	Error_Server,
+}
+
+// RetryPolicy describes how failed method calls are repeated.
+//
+// A call is repeated if API returns global error with code from Codes
+// or if the request fails because of network error.
+// Delay before the next attempt grows exponentially from MinDelay to MaxDelay with random jitter.
+// Retries stop when MaxAttempts or MaxElapsed is reached, or when ctx is done
+// or its deadline is earlier than the next attempt.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one.
+	MaxAttempts int
+	// MaxElapsed limits the total time spent for all attempts. Zero means no limit.
+	MaxElapsed time.Duration
+	// MinDelay is delay before the second attempt.
+	MinDelay time.Duration
+	// MaxDelay limits delay between attempts.
+	MaxDelay time.Duration
+	// Codes are API error codes to retry. DefaultRetryCodes are used if it is nil.
+	// Non-global codes are ignored because they are a result of the method call itself.
+	Codes []ErrorCode
+}
+
+// DefaultRetryPolicy returns RetryPolicy with DefaultRetryCodes and up to 4 attempts.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MaxElapsed:  30 * time.Second,
+		MinDelay:    350 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+	}
+}
+
+// SetRetryPolicy set retry policy for all method calls. Pass nil to make a single attempt.
+func (vk *VK) SetRetryPolicy(policy *RetryPolicy) {
+	vk.retry = policy
+}
+
+// RetryMiddleware returns Middleware that repeats calls by the policy.
+// Unlike VK.SetRetryPolicy, it allows to place retries at any position of the middlewares chain.
+func RetryMiddleware(policy RetryPolicy) Middleware {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (ApiError, error) {
+			return policy.do(ctx, func() (ApiError, error) {
+				return next(ctx, inv)
+			})
+		}
+	}
+}
+
+// retryable checks if the attempt result is a transient error.
+func (p *RetryPolicy) retryable(apiErr ApiError, err error) bool {
+	if err != nil {
+		return isNetworkError(err)
+	}
+
+	if apiErr == nil {
+		return false
+	}
+
+	codes := p.Codes
+
+	if codes == nil {
+		codes = DefaultRetryCodes
+	}
+
+	for _, code := range codes {
+		if code.IsGlobal() && apiErr.Is(code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNetworkError checks if err is an error of request sending, not of response parsing.
+func isNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var urlErr *url.Error
+	var netErr net.Error
+
+	return errors.As(err, &urlErr) || errors.As(err, &netErr)
+}
+
+// delay returns backoff with jitter before the attempt following the given one.
+func (p *RetryPolicy) delay(attempt int) time.Duration {
+	d := p.MinDelay
+
+	for i := 1; i < attempt && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	// wait at least half of the delay to not retry immediately
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// do calls send until it succeeds with non-transient result or retry budget is over.
+func (p *RetryPolicy) do(ctx context.Context, send func() (ApiError, error)) (ApiError, error) {
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		apiErr, err := send()
+
+		if attempt >= p.MaxAttempts || !p.retryable(apiErr, err) {
+			return apiErr, err
+		}
+
+		delay := p.delay(attempt)
+		next := time.Now().Add(delay)
+
+		if p.MaxElapsed > 0 && next.Sub(start) > p.MaxElapsed {
+			return apiErr, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && next.After(deadline) {
+			return apiErr, err
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return apiErr, err
+		}
+	}
+}
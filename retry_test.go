@@ -0,0 +1,84 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestVK_SetRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	policy.MinDelay = time.Millisecond
+	policy.MaxDelay = 2 * time.Millisecond
+
+	tests := []struct {
+		name      string
+		responses []string
+		netErrors int
+		attempts  int
+		code      ErrorCode
+	}{
+		{
+			name:      "TooMany then success",
+			responses: []string{`{"error_code":6}`, `{"error_code":6}`, `{"response":[]}`},
+			attempts:  3,
+		},
+		{
+			name:      "Server error until max attempts",
+			responses: []string{`{"error_code":10}`, `{"error_code":10}`, `{"error_code":10}`, `{"error_code":10}`},
+			attempts:  4,
+			code:      Error_Server,
+		},
+		{
+			name:      "Not retryable",
+			responses: []string{`{"error_code":15}`},
+			attempts:  1,
+			code:      Error_Access,
+		},
+		{
+			name:      "Network error then success",
+			responses: []string{`{"response":[]}`},
+			netErrors: 1,
+			attempts:  2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts int
+
+			transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+				attempts++
+
+				if attempts <= test.netErrors {
+					return nil, errors.New("connection reset")
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     make(http.Header),
+					Body:       ioutil.NopCloser(bytes.NewBufferString(test.responses[attempts-test.netErrors-1])),
+				}, nil
+			})
+
+			vk := NewVK(&http.Client{Transport: transport})
+			vk.SetRetryPolicy(&policy)
+
+			_, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+			require.NoError(t, err)
+			assert.Equal(t, test.attempts, attempts)
+
+			if test.code == 0 {
+				assert.Nil(t, apiErr)
+			} else if assert.NotNil(t, apiErr) {
+				assert.True(t, apiErr.Is(test.code))
+			}
+		})
+	}
+}
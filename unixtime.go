@@ -0,0 +1,59 @@
+package vk_sdk
+
+import "time"
+
+// UnixTime is time in Unixtime as API sends dates, e.g. Messages_Message.Date.
+// It is encoded to JSON and decoded from JSON as number of seconds.
+type UnixTime int64
+
+// NewUnixTime returns UnixTime of t truncated to seconds. Zero t returns zero UnixTime.
+func NewUnixTime(t time.Time) UnixTime {
+	if t.IsZero() {
+		return 0
+	}
+
+	return UnixTime(t.Unix())
+}
+
+// Time returns local time of t. Zero t returns zero time.Time.
+func (t UnixTime) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(t), 0)
+}
+
+// IsZero reports whether t is not set.
+func (t UnixTime) IsZero() bool {
+	return t == 0
+}
+
+const (
+	// AdsDayLayout is layout of day dates of Ads statistics, e.g. "2011-09-27".
+	AdsDayLayout = "2006-01-02"
+	// AdsMonthLayout is layout of month dates of Ads statistics, e.g. "2011-09".
+	AdsMonthLayout = "2006-01"
+)
+
+// AdsDay formats t as date of Ads statistics with "day" period,
+// e.g. for Ads_GetStatistics_Request.DateFrom.
+func AdsDay(t time.Time) string {
+	return t.Format(AdsDayLayout)
+}
+
+// AdsMonth formats t as date of Ads statistics with "month" period,
+// e.g. for Ads_GetStatistics_Request.DateFrom.
+func AdsMonth(t time.Time) string {
+	return t.Format(AdsMonthLayout)
+}
+
+// ParseAdsDay parses date of Ads statistics with "day" period in UTC, e.g. Ads_StatsFormat.Day.
+func ParseAdsDay(s string) (time.Time, error) {
+	return time.Parse(AdsDayLayout, s)
+}
+
+// ParseAdsMonth parses date of Ads statistics with "month" period in UTC, e.g. Ads_StatsFormat.Month.
+func ParseAdsMonth(s string) (time.Time, error) {
+	return time.Parse(AdsMonthLayout, s)
+}
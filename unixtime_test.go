@@ -0,0 +1,79 @@
+package vk_sdk
+
+import (
+	"encoding/json"
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestUnixTime(t *testing.T) {
+	now := time.Date(2021, 9, 27, 12, 30, 15, 500, time.UTC)
+
+	ut := NewUnixTime(now)
+	assert.Equal(t, UnixTime(1632745815), ut)
+	assert.True(t, ut.Time().Equal(now.Truncate(time.Second)))
+	assert.False(t, ut.IsZero())
+
+	assert.Equal(t, UnixTime(0), NewUnixTime(time.Time{}))
+	assert.True(t, UnixTime(0).Time().IsZero())
+	assert.True(t, UnixTime(0).IsZero())
+}
+
+func TestUnixTime_JSON(t *testing.T) {
+	data := []byte(`{"online":1,"time":1632745815}`)
+
+	var easy Messages_LastActivity
+	require.NoError(t, easyjson.Unmarshal(data, &easy))
+
+	var std Messages_LastActivity
+	require.NoError(t, json.Unmarshal(data, &std))
+
+	assert.Equal(t, UnixTime(1632745815), easy.Time)
+	assert.Equal(t, easy, std)
+	assert.Equal(t, time.Date(2021, 9, 27, 12, 30, 15, 0, time.UTC), easy.Time.Time().UTC())
+
+	easyData, err := easyjson.Marshal(easy)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(easyData))
+
+	stdData, err := json.Marshal(std)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(stdData))
+}
+
+func TestUnixTime_Param(t *testing.T) {
+	publishDate := UnixTime(1632745815)
+
+	values := make(url.Values)
+	require.NoError(t, Wall_Post_Request{PublishDate: &publishDate}.fillIn(values))
+	assert.Equal(t, "1632745815", values.Get("publish_date"))
+
+	values = make(url.Values)
+	require.NoError(t, Newsfeed_Get_Request{}.fillIn(values))
+	assert.NotContains(t, values, "start_time")
+}
+
+func TestAdsDates(t *testing.T) {
+	date := time.Date(2011, 9, 27, 15, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2011-09-27", AdsDay(date))
+	assert.Equal(t, "2011-09", AdsMonth(date))
+
+	day, err := ParseAdsDay("2011-09-27")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2011, 9, 27, 0, 0, 0, 0, time.UTC), day)
+
+	month, err := ParseAdsMonth("2011-09")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2011, 9, 1, 0, 0, 0, 0, time.UTC), month)
+
+	_, err = ParseAdsDay("2011-09")
+	assert.Error(t, err)
+
+	_, err = ParseAdsMonth("0")
+	assert.Error(t, err)
+}
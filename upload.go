@@ -0,0 +1,544 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+)
+
+// UploadError is error returned by upload server instead of uploaded file.
+//
+// https://dev.vk.com/api/upload
+type UploadError struct {
+	Msg string
+}
+
+func (e UploadError) Error() string {
+	return "upload: " + e.Msg
+}
+
+// uploadErrorResponse is used to detect error of upload server.
+type uploadErrorResponse struct {
+	Error json.RawMessage `json:"error"`
+}
+
+// photoUploadResult is response of photos upload servers.
+type photoUploadResult struct {
+	Server     int    `json:"server"`
+	Photo      string `json:"photo"`
+	PhotosList string `json:"photos_list"`
+	Hash       string `json:"hash"`
+}
+
+// docUploadResult is response of docs upload server.
+type docUploadResult struct {
+	File string `json:"file"`
+}
+
+// storyUploadResult is response of stories upload server.
+type storyUploadResult struct {
+	Response struct {
+		UploadResult string `json:"upload_result"`
+	} `json:"response"`
+}
+
+// chatPhotoUploadResult is response of chat photo upload server.
+type chatPhotoUploadResult struct {
+	Response string `json:"response"`
+}
+
+// uploadFile streams file of src as multipart form field to uploadURL and unmarshal response to dst.
+func (vk *VK) uploadFile(ctx context.Context, uploadURL, field string, src *uploadSource, dst interface{}) (err error) {
+	// multipart head and tail are built in advance to stream file without buffering and know content length
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	if _, err = w.CreateFormFile(field, src.filename); err != nil {
+		return err
+	}
+
+	head := append([]byte(nil), buf.Bytes()...)
+	buf.Reset()
+
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	tail := buf.Bytes()
+
+	body := io.MultiReader(bytes.NewReader(head), src.reader(ctx), bytes.NewReader(tail))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, body)
+
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	req.ContentLength = -1
+
+	if src.size >= 0 {
+		req.ContentLength = int64(len(head)) + src.size + int64(len(tail))
+	}
+
+	resp, err := vk.client.Do(req)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return UploadError{Msg: fmt.Sprintf("unexpected status %s", resp.Status)}
+	}
+
+	return parseUploadResponse(respBody, dst)
+}
+
+// parseUploadResponse parse response of upload server and returns UploadError if present.
+func parseUploadResponse(respBody []byte, dst interface{}) error {
+	var errResp uploadErrorResponse
+
+	if err := json.Unmarshal(respBody, &errResp); err != nil {
+		return err
+	}
+
+	if len(errResp.Error) > 0 && string(errResp.Error) != "null" {
+		var msg string
+
+		if err := json.Unmarshal(errResp.Error, &msg); err != nil {
+			msg = string(errResp.Error)
+		}
+
+		return UploadError{Msg: msg}
+	}
+
+	return json.Unmarshal(respBody, dst)
+}
+
+// checkPhoto checks that upload server has accepted the photo.
+func (r photoUploadResult) checkPhoto() error {
+	if r.Photo == "" || r.Photo == "[]" {
+		return UploadError{Msg: "photo is not uploaded"}
+	}
+
+	return nil
+}
+
+// UploadPhoto uploads photo to album by upload server of VK.Photos_GetUploadServer and saves it by VK.Photos_Save.
+// AlbumId and GroupId of save request are taken from upload server if not set.
+//
+// https://dev.vk.com/api/upload/album-photo
+func (vk *VK) UploadPhoto(ctx context.Context, req Photos_GetUploadServer_Request, save Photos_Save_Request, file io.Reader, filename string, opts ...UploadOption) (resp Photos_Save_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, PhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Photos_GetUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var uploaded photoUploadResult
+
+	if err = vk.uploadFile(ctx, server.Response.UploadUrl, "file1", src, &uploaded); err != nil {
+		return
+	}
+
+	if uploaded.PhotosList == "" || uploaded.PhotosList == "[]" {
+		err = UploadError{Msg: "photo is not uploaded"}
+		return
+	}
+
+	if save.AlbumId == nil {
+		save.AlbumId = &server.Response.AlbumId
+	}
+
+	if save.GroupId == nil {
+		save.GroupId = server.Response.GroupId
+	}
+
+	save.Server = &uploaded.Server
+	save.PhotosList = &uploaded.PhotosList
+	save.Hash = &uploaded.Hash
+
+	return vk.Photos_Save(ctx, save)
+}
+
+// UploadWallPhoto uploads photo for wall post by upload server of VK.Photos_GetWallUploadServer
+// and saves it by VK.Photos_SaveWallPhoto. GroupId of save request is taken from req if not set.
+//
+// https://dev.vk.com/api/upload/wall-photo
+func (vk *VK) UploadWallPhoto(ctx context.Context, req Photos_GetWallUploadServer_Request, save Photos_SaveWallPhoto_Request, file io.Reader, filename string, opts ...UploadOption) (resp Photos_SaveWallPhoto_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, PhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Photos_GetWallUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var uploaded photoUploadResult
+
+	if err = vk.uploadFile(ctx, server.Response.UploadUrl, "photo", src, &uploaded); err != nil {
+		return
+	}
+
+	if err = uploaded.checkPhoto(); err != nil {
+		return
+	}
+
+	if save.GroupId == nil {
+		save.GroupId = req.GroupId
+	}
+
+	save.Server = &uploaded.Server
+	save.Photo = uploaded.Photo
+	save.Hash = &uploaded.Hash
+
+	return vk.Photos_SaveWallPhoto(ctx, save)
+}
+
+// UploadMessagesPhoto uploads photo for private message by upload server of VK.Photos_GetMessagesUploadServer
+// and saves it by VK.Photos_SaveMessagesPhoto.
+//
+// https://dev.vk.com/api/upload/photo-in-message
+func (vk *VK) UploadMessagesPhoto(ctx context.Context, req Photos_GetMessagesUploadServer_Request, file io.Reader, filename string, opts ...UploadOption) (resp Photos_SaveMessagesPhoto_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, PhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Photos_GetMessagesUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var uploaded photoUploadResult
+
+	if err = vk.uploadFile(ctx, server.Response.UploadUrl, "photo", src, &uploaded); err != nil {
+		return
+	}
+
+	if err = uploaded.checkPhoto(); err != nil {
+		return
+	}
+
+	return vk.Photos_SaveMessagesPhoto(ctx, Photos_SaveMessagesPhoto_Request{
+		Photo:  uploaded.Photo,
+		Server: &uploaded.Server,
+		Hash:   &uploaded.Hash,
+	})
+}
+
+// UploadOwnerPhoto uploads user or community main photo by upload server of VK.Photos_GetOwnerPhotoUploadServer
+// and saves it by VK.Photos_SaveOwnerPhoto.
+//
+// https://dev.vk.com/api/upload/main-photo
+func (vk *VK) UploadOwnerPhoto(ctx context.Context, req Photos_GetOwnerPhotoUploadServer_Request, file io.Reader, filename string, opts ...UploadOption) (resp Photos_SaveOwnerPhoto_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, PhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Photos_GetOwnerPhotoUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var uploaded photoUploadResult
+
+	if err = vk.uploadFile(ctx, server.Response.UploadUrl, "photo", src, &uploaded); err != nil {
+		return
+	}
+
+	if err = uploaded.checkPhoto(); err != nil {
+		return
+	}
+
+	uploadServer := strconv.Itoa(uploaded.Server)
+
+	return vk.Photos_SaveOwnerPhoto(ctx, Photos_SaveOwnerPhoto_Request{
+		Server: &uploadServer,
+		Hash:   &uploaded.Hash,
+		Photo:  &uploaded.Photo,
+	})
+}
+
+// UploadOwnerCoverPhoto uploads community cover by upload server of VK.Photos_GetOwnerCoverPhotoUploadServer
+// and saves it by VK.Photos_SaveOwnerCoverPhoto.
+//
+// https://dev.vk.com/api/upload/cover-photo
+func (vk *VK) UploadOwnerCoverPhoto(ctx context.Context, req Photos_GetOwnerCoverPhotoUploadServer_Request, file io.Reader, filename string, opts ...UploadOption) (resp Photos_SaveOwnerCoverPhoto_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, PhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Photos_GetOwnerCoverPhotoUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var uploaded photoUploadResult
+
+	if err = vk.uploadFile(ctx, server.Response.UploadUrl, "photo", src, &uploaded); err != nil {
+		return
+	}
+
+	if err = uploaded.checkPhoto(); err != nil {
+		return
+	}
+
+	return vk.Photos_SaveOwnerCoverPhoto(ctx, Photos_SaveOwnerCoverPhoto_Request{
+		Hash:  uploaded.Hash,
+		Photo: uploaded.Photo,
+	})
+}
+
+// UploadChatPhoto uploads chat cover by upload server of VK.Photos_GetChatUploadServer
+// and sets it by VK.Messages_SetChatPhoto.
+//
+// https://dev.vk.com/api/upload/chat-photo
+func (vk *VK) UploadChatPhoto(ctx context.Context, req Photos_GetChatUploadServer_Request, file io.Reader, filename string, opts ...UploadOption) (resp Messages_SetChatPhoto_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, PhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Photos_GetChatUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var uploaded chatPhotoUploadResult
+
+	if err = vk.uploadFile(ctx, server.Response.UploadUrl, "file", src, &uploaded); err != nil {
+		return
+	}
+
+	return vk.Messages_SetChatPhoto(ctx, Messages_SetChatPhoto_Request{
+		File: uploaded.Response,
+	})
+}
+
+// UploadPollPhoto uploads poll background by upload server of VK.Polls_GetPhotoUploadServer
+// and saves it by VK.Polls_SavePhoto.
+func (vk *VK) UploadPollPhoto(ctx context.Context, req Polls_GetPhotoUploadServer_Request, file io.Reader, filename string, opts ...UploadOption) (resp Polls_SavePhoto_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, PhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Polls_GetPhotoUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	var uploaded photoUploadResult
+
+	if err = vk.uploadFile(ctx, server.Response.UploadUrl, "photo", src, &uploaded); err != nil {
+		return
+	}
+
+	if err = uploaded.checkPhoto(); err != nil {
+		return
+	}
+
+	return vk.Polls_SavePhoto(ctx, Polls_SavePhoto_Request{
+		Photo: uploaded.Photo,
+		Hash:  uploaded.Hash,
+	})
+}
+
+// UploadDoc uploads document by upload server of VK.Docs_GetUploadServer and saves it by VK.Docs_Save.
+// File of save request is set by the uploader.
+//
+// https://dev.vk.com/api/upload/document
+func (vk *VK) UploadDoc(ctx context.Context, req Docs_GetUploadServer_Request, save Docs_Save_Request, file io.Reader, filename string, opts ...UploadOption) (resp Docs_Save_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, DocUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Docs_GetUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	return vk.uploadDoc(ctx, server.Response.UploadUrl, save, src)
+}
+
+// UploadWallDoc uploads document for wall post by upload server of VK.Docs_GetWallUploadServer
+// and saves it by VK.Docs_Save. File of save request is set by the uploader.
+//
+// https://dev.vk.com/api/upload/document
+func (vk *VK) UploadWallDoc(ctx context.Context, req Docs_GetWallUploadServer_Request, save Docs_Save_Request, file io.Reader, filename string, opts ...UploadOption) (resp Docs_Save_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, DocUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Docs_GetWallUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	return vk.uploadDoc(ctx, server.Response.UploadUrl, save, src)
+}
+
+// UploadMessagesDoc uploads document, audio message or graffiti for private message
+// by upload server of VK.Docs_GetMessagesUploadServer and saves it by VK.Docs_Save.
+// File of save request is set by the uploader.
+//
+// https://dev.vk.com/api/upload/document-in-message
+func (vk *VK) UploadMessagesDoc(ctx context.Context, req Docs_GetMessagesUploadServer_Request, save Docs_Save_Request, file io.Reader, filename string, opts ...UploadOption) (resp Docs_Save_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, DocUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Docs_GetMessagesUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	return vk.uploadDoc(ctx, server.Response.UploadUrl, save, src)
+}
+
+// uploadDoc uploads document to uploadURL and saves it.
+func (vk *VK) uploadDoc(ctx context.Context, uploadURL string, save Docs_Save_Request, src *uploadSource) (resp Docs_Save_Response, apiErr ApiError, err error) {
+	var uploaded docUploadResult
+
+	if err = vk.uploadFile(ctx, uploadURL, "file", src, &uploaded); err != nil {
+		return
+	}
+
+	save.File = uploaded.File
+
+	return vk.Docs_Save(ctx, save)
+}
+
+// UploadVideo creates video by VK.Video_Save and uploads the file to its upload URL.
+//
+// https://dev.vk.com/api/upload/video
+func (vk *VK) UploadVideo(ctx context.Context, req Video_Save_Request, file io.Reader, filename string, opts ...UploadOption) (resp Video_Save_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, VideoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	resp, apiErr, err = vk.Video_Save(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	if resp.Response.UploadUrl == nil {
+		err = errors.New("video.save returned no upload_url")
+		return
+	}
+
+	var uploaded json.RawMessage
+
+	err = vk.uploadFile(ctx, *resp.Response.UploadUrl, "video_file", src, &uploaded)
+
+	return
+}
+
+// UploadStoryPhoto uploads photo story by upload server of VK.Stories_GetPhotoUploadServer
+// and publishes it by VK.Stories_Save.
+//
+// https://dev.vk.com/api/upload/stories
+func (vk *VK) UploadStoryPhoto(ctx context.Context, req Stories_GetPhotoUploadServer_Request, file io.Reader, filename string, opts ...UploadOption) (resp Stories_Save_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, StoryPhotoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Stories_GetPhotoUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	return vk.uploadStory(ctx, server.Response.UploadUrl, "file", src)
+}
+
+// UploadStoryVideo uploads video story by upload server of VK.Stories_GetVideoUploadServer
+// and publishes it by VK.Stories_Save.
+//
+// https://dev.vk.com/api/upload/stories
+func (vk *VK) UploadStoryVideo(ctx context.Context, req Stories_GetVideoUploadServer_Request, file io.Reader, filename string, opts ...UploadOption) (resp Stories_Save_Response, apiErr ApiError, err error) {
+	src, err := newUploadSource(file, filename, StoryVideoUploadLimits, opts)
+
+	if err != nil {
+		return
+	}
+
+	server, apiErr, err := vk.Stories_GetVideoUploadServer(ctx, req)
+
+	if apiErr != nil || err != nil {
+		return
+	}
+
+	return vk.uploadStory(ctx, server.Response.UploadUrl, "video_file", src)
+}
+
+// uploadStory uploads story to uploadURL and saves it.
+func (vk *VK) uploadStory(ctx context.Context, uploadURL, field string, src *uploadSource) (resp Stories_Save_Response, apiErr ApiError, err error) {
+	var uploaded storyUploadResult
+
+	if err = vk.uploadFile(ctx, uploadURL, field, src, &uploaded); err != nil {
+		return
+	}
+
+	uploadResults := []string{uploaded.Response.UploadResult}
+
+	return vk.Stories_Save(ctx, Stories_Save_Request{
+		UploadResults: &uploadResults,
+	})
+}
@@ -0,0 +1,202 @@
+package vk_sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	// ErrFileTooLarge is returned when uploaded file exceeds UploadLimits.MaxSize.
+	ErrFileTooLarge = errors.New("file is too large")
+	// ErrFileExtension is returned when uploaded file extension is not in UploadLimits.Extensions.
+	ErrFileExtension = errors.New("file extension is not allowed")
+)
+
+// UploadLimits restricts files of an upload kind. They are checked before any request.
+type UploadLimits struct {
+	// MaxSize is the maximum file size in bytes. Zero means no limit.
+	MaxSize int64
+	// Extensions are allowed lowercase file extensions without dot. Nil means any extension.
+	Extensions []string
+}
+
+// Limits of uploaded files by kind.
+//
+// https://dev.vk.com/api/upload
+var (
+	PhotoUploadLimits = UploadLimits{
+		MaxSize:    50 << 20,
+		Extensions: []string{"jpg", "jpeg", "png", "gif"},
+	}
+	DocUploadLimits = UploadLimits{
+		MaxSize: 200 << 20,
+	}
+	VideoUploadLimits = UploadLimits{
+		MaxSize:    256 << 30,
+		Extensions: []string{"avi", "mp4", "3gp", "mpeg", "mpg", "mov", "flv", "wmv", "mkv", "webm"},
+	}
+	StoryPhotoUploadLimits = UploadLimits{
+		MaxSize:    10 << 20,
+		Extensions: []string{"jpg", "jpeg", "png", "gif"},
+	}
+	StoryVideoUploadLimits = UploadLimits{
+		MaxSize:    10 << 20,
+		Extensions: []string{"mp4"},
+	}
+)
+
+// UploadProgressFunc is called after every read of uploaded file
+// with number of sent bytes and total file size, total is -1 if the size is unknown.
+type UploadProgressFunc func(sent, total int64)
+
+// UploadOption configures a single upload.
+type UploadOption func(src *uploadSource)
+
+// UploadProgress set callback to report upload progress.
+func UploadProgress(f UploadProgressFunc) UploadOption {
+	return func(src *uploadSource) {
+		src.progress = f
+	}
+}
+
+// UploadSize set file size when it can not be got from the reader,
+// so the file is checked before upload and sent with Content-Length.
+func UploadSize(size int64) UploadOption {
+	return func(src *uploadSource) {
+		src.size = size
+	}
+}
+
+// WithUploadLimits replaces default limits of the upload kind.
+func WithUploadLimits(limits UploadLimits) UploadOption {
+	return func(src *uploadSource) {
+		src.limits = limits
+	}
+}
+
+// uploadSource is uploaded file with its checks and progress reporting.
+type uploadSource struct {
+	file     io.Reader
+	filename string
+	size     int64
+	limits   UploadLimits
+	progress UploadProgressFunc
+}
+
+// newUploadSource create and return new uploadSource and checks file by limits.
+func newUploadSource(file io.Reader, filename string, limits UploadLimits, opts []UploadOption) (*uploadSource, error) {
+	src := &uploadSource{
+		file:     file,
+		filename: filename,
+		size:     -1,
+		limits:   limits,
+	}
+
+	for _, opt := range opts {
+		opt(src)
+	}
+
+	if src.size < 0 {
+		size, err := readerSize(file)
+
+		if err != nil {
+			return nil, err
+		}
+
+		src.size = size
+	}
+
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+
+	return src, nil
+}
+
+// readerSize returns number of bytes left in reader or -1 if it is unknown.
+func readerSize(r io.Reader) (int64, error) {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), nil
+	case io.Seeker:
+		cur, err := r.Seek(0, io.SeekCurrent)
+
+		if err != nil {
+			return -1, nil
+		}
+
+		end, err := r.Seek(0, io.SeekEnd)
+
+		if err != nil {
+			return -1, err
+		}
+
+		if _, err = r.Seek(cur, io.SeekStart); err != nil {
+			return -1, err
+		}
+
+		return end - cur, nil
+	default:
+		return -1, nil
+	}
+}
+
+// check checks file size and extension by limits.
+func (src *uploadSource) check() error {
+	if src.limits.MaxSize > 0 && src.size > src.limits.MaxSize {
+		return fmt.Errorf("%w: %s is %d bytes, limit is %d", ErrFileTooLarge, src.filename, src.size, src.limits.MaxSize)
+	}
+
+	if src.limits.Extensions == nil {
+		return nil
+	}
+
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(src.filename), "."))
+
+	for _, allowed := range src.limits.Extensions {
+		if ext == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s, allowed: %s", ErrFileExtension, src.filename, strings.Join(src.limits.Extensions, ", "))
+}
+
+// reader returns reader of the file that reports progress,
+// stops on ctx cancellation and checks size of the file with unknown size.
+func (src *uploadSource) reader(ctx context.Context) io.Reader {
+	return &uploadReader{
+		ctx: ctx,
+		src: src,
+	}
+}
+
+type uploadReader struct {
+	ctx  context.Context
+	src  *uploadSource
+	sent int64
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err := r.src.file.Read(p)
+
+	r.sent += int64(n)
+
+	if max := r.src.limits.MaxSize; max > 0 && r.sent > max {
+		return n, fmt.Errorf("%w: %s exceeds %d bytes", ErrFileTooLarge, r.src.filename, max)
+	}
+
+	if r.src.progress != nil && n > 0 {
+		r.src.progress(r.sent, r.src.size)
+	}
+
+	return n, err
+}
@@ -0,0 +1,167 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const testUploadURL = "https://pu.vk.com/c1/upload.php"
+
+// newUploadTestClient returns client that replies to API methods with methodResponses
+// and to upload server with uploadResponse after checking multipart form field.
+func newUploadTestClient(t *testing.T, field string, content string, uploadResponse string, methodResponses map[string]string, check func(method string, values url.Values)) *http.Client {
+	return &http.Client{
+		Transport: TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+			var body string
+
+			if req.URL.String() == testUploadURL {
+				require.NoError(t, req.ParseMultipartForm(1<<20))
+				file, header, err := req.FormFile(field)
+				require.NoError(t, err)
+				uploaded, err := io.ReadAll(file)
+				require.NoError(t, err)
+
+				assert.Equal(t, "file.jpg", header.Filename)
+				assert.Equal(t, content, string(uploaded))
+
+				body = uploadResponse
+			} else {
+				method := strings.TrimPrefix(req.URL.Path, "/"+apiPath+"/")
+
+				reqBody, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				values, err := url.ParseQuery(string(reqBody))
+				require.NoError(t, err)
+
+				check(method, values)
+
+				var ok bool
+				body, ok = methodResponses[method]
+				require.True(t, ok, method)
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		}),
+	}
+}
+
+func TestVK_UploadMessagesPhoto(t *testing.T) {
+	methodResponses := map[string]string{
+		"photos.getMessagesUploadServer": `{"response":{"album_id":1,"upload_url":"` + testUploadURL + `","user_id":1}}`,
+		"photos.saveMessagesPhoto":       `{"response":[{"id":2,"album_id":1,"owner_id":1,"date":0}]}`,
+	}
+
+	client := newUploadTestClient(t, "photo", "image", `{"server":10,"photo":"[{}]","hash":"abc"}`, methodResponses, func(method string, values url.Values) {
+		if method == "photos.saveMessagesPhoto" {
+			assert.Equal(t, "10", values.Get("server"))
+			assert.Equal(t, "[{}]", values.Get("photo"))
+			assert.Equal(t, "abc", values.Get("hash"))
+		}
+	})
+
+	vk := NewVK(client)
+
+	peerID := 1
+	resp, apiErr, err := vk.UploadMessagesPhoto(context.Background(), Photos_GetMessagesUploadServer_Request{PeerId: &peerID}, strings.NewReader("image"), "file.jpg")
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	require.Len(t, resp.Response, 1)
+	assert.Equal(t, 2, resp.Response[0].Id)
+}
+
+func TestVK_UploadDoc_Error(t *testing.T) {
+	methodResponses := map[string]string{
+		"docs.getUploadServer": `{"response":{"upload_url":"` + testUploadURL + `"}}`,
+	}
+
+	client := newUploadTestClient(t, "file", "doc", `{"error":"unknown error"}`, methodResponses, func(string, url.Values) {})
+
+	vk := NewVK(client)
+
+	_, apiErr, err := vk.UploadDoc(context.Background(), Docs_GetUploadServer_Request{}, Docs_Save_Request{}, strings.NewReader("doc"), "file.jpg")
+	require.Nil(t, apiErr)
+	assert.Equal(t, UploadError{Msg: "unknown error"}, err)
+}
+
+func TestVK_UploadMessagesPhoto_Limits(t *testing.T) {
+	vk := NewVK(NewErrorTestClient(errors.New("unexpected request")))
+
+	tests := []struct {
+		name     string
+		file     io.Reader
+		filename string
+		opts     []UploadOption
+		err      error
+	}{
+		{
+			name:     "Extension",
+			file:     strings.NewReader("image"),
+			filename: "file.exe",
+			err:      ErrFileExtension,
+		},
+		{
+			name:     "Size",
+			file:     bytes.NewReader(make([]byte, 11)),
+			filename: "file.jpg",
+			opts:     []UploadOption{WithUploadLimits(UploadLimits{MaxSize: 10})},
+			err:      ErrFileTooLarge,
+		},
+		{
+			name:     "Declared size",
+			file:     io.MultiReader(strings.NewReader("image")),
+			filename: "file.png",
+			opts:     []UploadOption{UploadSize(PhotoUploadLimits.MaxSize + 1)},
+			err:      ErrFileTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, apiErr, err := vk.UploadMessagesPhoto(context.Background(), Photos_GetMessagesUploadServer_Request{}, test.file, test.filename, test.opts...)
+			assert.Nil(t, apiErr)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestVK_UploadDoc_Progress(t *testing.T) {
+	methodResponses := map[string]string{
+		"docs.getUploadServer": `{"response":{"upload_url":"` + testUploadURL + `"}}`,
+		"docs.save":            `{"response":{"type":"doc","doc":{"id":1,"owner_id":1,"title":"file.jpg","size":3,"ext":"jpg","date":0,"type":1}}}`,
+	}
+
+	client := newUploadTestClient(t, "file", "doc", `{"file":"uploaded"}`, methodResponses, func(method string, values url.Values) {
+		if method == "docs.save" {
+			assert.Equal(t, "uploaded", values.Get("file"))
+		}
+	})
+
+	vk := NewVK(client)
+
+	var sent, total int64
+
+	progress := UploadProgress(func(s, n int64) {
+		sent, total = s, n
+	})
+
+	resp, apiErr, err := vk.UploadDoc(context.Background(), Docs_GetUploadServer_Request{}, Docs_Save_Request{}, strings.NewReader("doc"), "file.jpg", progress)
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	require.NotNil(t, resp.Response.Doc)
+	assert.Equal(t, 1, resp.Response.Doc.Id)
+	assert.Equal(t, int64(3), sent)
+	assert.Equal(t, int64(3), total)
+}
@@ -0,0 +1,611 @@
+package vk_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// UserLongPollVersion is User Long Poll API version supported by UserLongPoll.
+const UserLongPollVersion = 3
+
+// UserLongPollMode is additional answer options mode. Modes are summed.
+//
+// https://dev.vk.com/api/user-long-poll/getting-started
+type UserLongPollMode int
+
+const (
+	// UserLongPollModeAttachments receive attachments.
+	UserLongPollModeAttachments UserLongPollMode = 1 << 1
+	// UserLongPollModeExtended return expanded set of events.
+	UserLongPollModeExtended UserLongPollMode = 1 << 3
+	// UserLongPollModePts return pts (it is required for Messages_GetLongPollHistory method).
+	UserLongPollModePts UserLongPollMode = 1 << 5
+	// UserLongPollModeExtra with friend online event return additional data in the $extra field.
+	UserLongPollModeExtra UserLongPollMode = 1 << 6
+	// UserLongPollModeRandomID return random_id field.
+	UserLongPollModeRandomID UserLongPollMode = 1 << 7
+)
+
+// UserEventCode is code of User Long Poll event.
+//
+// https://dev.vk.com/api/user-long-poll/getting-started
+type UserEventCode int
+
+const (
+	// UserEventMessageFlagsReplace message flags replacement.
+	UserEventMessageFlagsReplace UserEventCode = 1
+	// UserEventMessageFlagsSet message flags setting.
+	UserEventMessageFlagsSet UserEventCode = 2
+	// UserEventMessageFlagsReset message flags resetting.
+	UserEventMessageFlagsReset UserEventCode = 3
+	// UserEventMessageNew new message.
+	UserEventMessageNew UserEventCode = 4
+	// UserEventMessageEdit message editing.
+	UserEventMessageEdit UserEventCode = 5
+	// UserEventReadIncoming read all incoming messages received before the message with local_id.
+	UserEventReadIncoming UserEventCode = 6
+	// UserEventReadOutgoing read all outgoing messages sent before the message with local_id.
+	UserEventReadOutgoing UserEventCode = 7
+	// UserEventFriendOnline a friend became online.
+	UserEventFriendOnline UserEventCode = 8
+	// UserEventFriendOffline a friend became offline.
+	UserEventFriendOffline UserEventCode = 9
+	// UserEventConversationFlagsReset conversation flags resetting.
+	UserEventConversationFlagsReset UserEventCode = 10
+	// UserEventConversationFlagsReplace conversation flags replacement.
+	UserEventConversationFlagsReplace UserEventCode = 11
+	// UserEventConversationFlagsSet conversation flags setting.
+	UserEventConversationFlagsSet UserEventCode = 12
+	// UserEventMessagesDelete deletion of all messages in the conversation with local_id less than or equal to local_id.
+	UserEventMessagesDelete UserEventCode = 13
+	// UserEventMessagesRestore restore recently deleted messages in the conversation with local_id less than or equal to local_id.
+	UserEventMessagesRestore UserEventCode = 14
+	// UserEventChatParamsChange one of chat parameters (title, photo) was changed.
+	UserEventChatParamsChange UserEventCode = 51
+	// UserEventChatInfoChange chat info was changed.
+	UserEventChatInfoChange UserEventCode = 52
+	// UserEventTyping user is typing a message in conversation.
+	UserEventTyping UserEventCode = 61
+	// UserEventChatTyping user is typing a message in chat.
+	UserEventChatTyping UserEventCode = 62
+	// UserEventUsersTyping users are typing a message in conversation.
+	UserEventUsersTyping UserEventCode = 63
+	// UserEventUsersRecording users are recording an audio message in conversation.
+	UserEventUsersRecording UserEventCode = 64
+	// UserEventCall user made a call.
+	UserEventCall UserEventCode = 70
+	// UserEventUnreadCounter unread messages counter has been changed.
+	UserEventUnreadCounter UserEventCode = 80
+	// UserEventNotificationSettings notification settings have been changed.
+	UserEventNotificationSettings UserEventCode = 114
+)
+
+// UserEvent is an event received from User Long Poll API.
+// Use type switch to get typed event, e.g. UserMessageEvent.
+type UserEvent interface {
+	// Code returns event code.
+	Code() UserEventCode
+}
+
+type userEventCode UserEventCode
+
+// Code returns event code.
+func (c userEventCode) Code() UserEventCode {
+	return UserEventCode(c)
+}
+
+// UserMessageFlagsEvent is event with UserEventMessageFlagsReplace,
+// UserEventMessageFlagsSet or UserEventMessageFlagsReset code.
+type UserMessageFlagsEvent struct {
+	userEventCode
+	MessageID int
+	Flags     int
+	PeerID    int
+}
+
+// UserMessageEvent is event with UserEventMessageNew or UserEventMessageEdit code.
+type UserMessageEvent struct {
+	userEventCode
+	MessageID int
+	Flags     int
+	PeerID    int
+	Timestamp int
+	Text      string
+	// Extra contains additional fields like title, emoji, from, payload, keyboard.
+	Extra json.RawMessage
+	// Attachments present with UserLongPollModeAttachments mode.
+	Attachments           map[string]string
+	RandomID              int
+	ConversationMessageID int
+	EditTime              int
+}
+
+// UserReadEvent is event with UserEventReadIncoming or UserEventReadOutgoing code.
+type UserReadEvent struct {
+	userEventCode
+	PeerID  int
+	LocalID int
+}
+
+// UserFriendStatusEvent is event with UserEventFriendOnline or UserEventFriendOffline code.
+type UserFriendStatusEvent struct {
+	userEventCode
+	UserID int
+	// Extra is platform ID for UserEventFriendOnline
+	// or 1 if the user is offline by timeout for UserEventFriendOffline.
+	Extra     int
+	Timestamp int
+}
+
+// UserConversationFlagsEvent is event with UserEventConversationFlagsReset,
+// UserEventConversationFlagsReplace or UserEventConversationFlagsSet code.
+type UserConversationFlagsEvent struct {
+	userEventCode
+	PeerID int
+	Flags  int
+}
+
+// UserMessagesDeleteEvent is event with UserEventMessagesDelete or UserEventMessagesRestore code.
+type UserMessagesDeleteEvent struct {
+	userEventCode
+	PeerID  int
+	LocalID int
+}
+
+// UserChatParamsEvent is event with UserEventChatParamsChange code.
+type UserChatParamsEvent struct {
+	userEventCode
+	ChatID int
+	// Self is 1 if the change was made by the user.
+	Self int
+}
+
+// UserChatInfoEvent is event with UserEventChatInfoChange code.
+type UserChatInfoEvent struct {
+	userEventCode
+	TypeID int
+	PeerID int
+	Info   int
+}
+
+// UserTypingEvent is event with UserEventTyping or UserEventChatTyping code.
+type UserTypingEvent struct {
+	userEventCode
+	UserID int
+	// ChatID is present for UserEventChatTyping only.
+	ChatID int
+}
+
+// UserUsersTypingEvent is event with UserEventUsersTyping or UserEventUsersRecording code.
+type UserUsersTypingEvent struct {
+	userEventCode
+	UserIDs    []int
+	PeerID     int
+	TotalCount int
+	Timestamp  int
+}
+
+// UserCallEvent is event with UserEventCall code.
+type UserCallEvent struct {
+	userEventCode
+	UserID int
+	CallID int
+}
+
+// UserUnreadCounterEvent is event with UserEventUnreadCounter code.
+type UserUnreadCounterEvent struct {
+	userEventCode
+	Count                  int
+	CountWithNotifications int
+}
+
+// UserNotificationSettingsEvent is event with UserEventNotificationSettings code.
+type UserNotificationSettingsEvent struct {
+	userEventCode
+	PeerID        int  `json:"peer_id"`
+	Sound         bool `json:"sound"`
+	DisabledUntil int  `json:"disabled_until"`
+}
+
+// UserUnknownEvent is event with code not supported by the SDK.
+type UserUnknownEvent struct {
+	userEventCode
+	Args []json.RawMessage
+}
+
+// userEventArgs reads array-encoded event arguments.
+// Absent arguments are set to zero values, first decoding error is saved.
+type userEventArgs struct {
+	args []json.RawMessage
+	err  error
+}
+
+func (a *userEventArgs) decode(i int, dst interface{}) {
+	if i >= len(a.args) || a.err != nil {
+		return
+	}
+
+	if err := json.Unmarshal(a.args[i], dst); err != nil {
+		a.err = fmt.Errorf("user long poll event argument %d: %w", i, err)
+	}
+}
+
+func (a *userEventArgs) int(i int) (v int) {
+	a.decode(i, &v)
+	return
+}
+
+func (a *userEventArgs) string(i int) (v string) {
+	a.decode(i, &v)
+	return
+}
+
+// ParseUserEvent parses array-encoded User Long Poll event.
+func ParseUserEvent(raw []byte) (UserEvent, error) {
+	a := new(userEventArgs)
+
+	if err := json.Unmarshal(raw, &a.args); err != nil {
+		return nil, err
+	}
+
+	if len(a.args) == 0 {
+		return nil, fmt.Errorf("user long poll event is empty")
+	}
+
+	code := userEventCode(a.int(0))
+
+	var e UserEvent
+
+	switch UserEventCode(code) {
+	case UserEventMessageFlagsReplace, UserEventMessageFlagsSet, UserEventMessageFlagsReset:
+		e = UserMessageFlagsEvent{
+			userEventCode: code,
+			MessageID:     a.int(1),
+			Flags:         a.int(2),
+			PeerID:        a.int(3),
+		}
+	case UserEventMessageNew, UserEventMessageEdit:
+		m := UserMessageEvent{
+			userEventCode:         code,
+			MessageID:             a.int(1),
+			Flags:                 a.int(2),
+			PeerID:                a.int(3),
+			Timestamp:             a.int(4),
+			Text:                  a.string(5),
+			RandomID:              a.int(8),
+			ConversationMessageID: a.int(9),
+			EditTime:              a.int(10),
+		}
+		if len(a.args) > 6 {
+			m.Extra = a.args[6]
+		}
+		a.decode(7, &m.Attachments)
+		e = m
+	case UserEventReadIncoming, UserEventReadOutgoing:
+		e = UserReadEvent{
+			userEventCode: code,
+			PeerID:        a.int(1),
+			LocalID:       a.int(2),
+		}
+	case UserEventFriendOnline, UserEventFriendOffline:
+		e = UserFriendStatusEvent{
+			userEventCode: code,
+			UserID:        -a.int(1),
+			Extra:         a.int(2),
+			Timestamp:     a.int(3),
+		}
+	case UserEventConversationFlagsReset, UserEventConversationFlagsReplace, UserEventConversationFlagsSet:
+		e = UserConversationFlagsEvent{
+			userEventCode: code,
+			PeerID:        a.int(1),
+			Flags:         a.int(2),
+		}
+	case UserEventMessagesDelete, UserEventMessagesRestore:
+		e = UserMessagesDeleteEvent{
+			userEventCode: code,
+			PeerID:        a.int(1),
+			LocalID:       a.int(2),
+		}
+	case UserEventChatParamsChange:
+		e = UserChatParamsEvent{
+			userEventCode: code,
+			ChatID:        a.int(1),
+			Self:          a.int(2),
+		}
+	case UserEventChatInfoChange:
+		e = UserChatInfoEvent{
+			userEventCode: code,
+			TypeID:        a.int(1),
+			PeerID:        a.int(2),
+			Info:          a.int(3),
+		}
+	case UserEventTyping:
+		e = UserTypingEvent{
+			userEventCode: code,
+			UserID:        a.int(1),
+		}
+	case UserEventChatTyping:
+		e = UserTypingEvent{
+			userEventCode: code,
+			UserID:        a.int(1),
+			ChatID:        a.int(2),
+		}
+	case UserEventUsersTyping, UserEventUsersRecording:
+		t := UserUsersTypingEvent{
+			userEventCode: code,
+			PeerID:        a.int(2),
+			TotalCount:    a.int(3),
+			Timestamp:     a.int(4),
+		}
+		a.decode(1, &t.UserIDs)
+		e = t
+	case UserEventCall:
+		e = UserCallEvent{
+			userEventCode: code,
+			UserID:        a.int(1),
+			CallID:        a.int(2),
+		}
+	case UserEventUnreadCounter:
+		e = UserUnreadCounterEvent{
+			userEventCode:          code,
+			Count:                  a.int(1),
+			CountWithNotifications: a.int(2),
+		}
+	case UserEventNotificationSettings:
+		s := UserNotificationSettingsEvent{
+			userEventCode: code,
+		}
+		a.decode(1, &s)
+		e = s
+	default:
+		e = UserUnknownEvent{
+			userEventCode: code,
+			Args:          a.args[1:],
+		}
+	}
+
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	return e, nil
+}
+
+// UserEventHandler handles events received from User Long Poll API.
+type UserEventHandler func(ctx context.Context, e UserEvent)
+
+// UserHistoryHandler handles events lost while reconnecting to User Long Poll server.
+type UserHistoryHandler func(ctx context.Context, history Messages_GetLongPollHistory_Response)
+
+// UserLongPoll is client for User Long Poll API.
+// It allows you to get user (or community) messages events in real time.
+//
+// NOTE:
+// Long Poll requests are done by VK http.Client, so its timeout
+// must be greater than the waiting period.
+//
+// https://dev.vk.com/api/user-long-poll/getting-started
+type UserLongPoll struct {
+	vk             *VK
+	mode           UserLongPollMode
+	wait           int
+	groupID        *int
+	historyHandler UserHistoryHandler
+	server         Messages_LongpollParams
+}
+
+// NewUserLongPoll create and return new UserLongPoll with provided mode.
+// VK must contain user access key with the messages permission or community access key.
+func NewUserLongPoll(vk *VK, mode UserLongPollMode) *UserLongPoll {
+	return &UserLongPoll{
+		vk:   vk,
+		mode: mode,
+		wait: DefaultLongPollWait,
+	}
+}
+
+// SetWait set waiting period in seconds. Maximum is 90.
+func (lp *UserLongPoll) SetWait(wait int) {
+	lp.wait = wait
+}
+
+// SetGroupID set community ID for community messages with user access key.
+func (lp *UserLongPoll) SetGroupID(groupID int) {
+	lp.groupID = &groupID
+}
+
+// SetHistoryHandler set handler for events lost while reconnecting.
+// If handler is set, the missed events are requested by Messages_GetLongPollHistory
+// with previous ts and pts when "failed" with LongPollFailedInfo code is received.
+// Handler is called for every page of the history.
+func (lp *UserLongPoll) SetHistoryHandler(handler UserHistoryHandler) {
+	lp.historyHandler = handler
+}
+
+// Ts returns the number of the last received event.
+func (lp *UserLongPoll) Ts() int {
+	return lp.server.Ts
+}
+
+// Pts returns persistent timestamp of the last received event, if present.
+func (lp *UserLongPoll) Pts() *int {
+	return lp.server.Pts
+}
+
+// updateServer gets new key and server address. Previous ts is kept if withTs is false.
+func (lp *UserLongPoll) updateServer(ctx context.Context, withTs bool) (ApiError, error) {
+	needPts := true
+	version := UserLongPollVersion
+
+	resp, apiErr, err := lp.vk.Messages_GetLongPollServer(ctx, Messages_GetLongPollServer_Request{
+		NeedPts:   &needPts,
+		GroupId:   lp.groupID,
+		LpVersion: &version,
+	})
+
+	if apiErr != nil || err != nil {
+		return apiErr, err
+	}
+
+	prev := lp.server
+
+	lp.server = resp.Response
+
+	if !withTs {
+		lp.server.Ts = prev.Ts
+		lp.server.Pts = prev.Pts
+	}
+
+	return nil, nil
+}
+
+// fillHistory requests events lost since previous ts and pts and calls history handler for every page.
+// Pages are requested while history has more events with new pts and maximum ID of received messages.
+func (lp *UserLongPoll) fillHistory(ctx context.Context, prev Messages_LongpollParams) (ApiError, error) {
+	if lp.historyHandler == nil || prev.Pts == nil {
+		return nil, nil
+	}
+
+	version := UserLongPollVersion
+	pts := *prev.Pts
+
+	var maxMsgID *int
+
+	for {
+		history, apiErr, err := lp.vk.Messages_GetLongPollHistory(ctx, Messages_GetLongPollHistory_Request{
+			Ts:        &prev.Ts,
+			Pts:       &pts,
+			MaxMsgId:  maxMsgID,
+			GroupId:   lp.groupID,
+			LpVersion: &version,
+		})
+
+		if apiErr != nil || err != nil {
+			return apiErr, err
+		}
+
+		newPts := history.Response.NewPts
+
+		if newPts != nil {
+			lp.server.Pts = newPts
+		}
+
+		lp.historyHandler(ctx, history)
+
+		// pts that is not moved forward would return the same page again
+		if more := history.Response.More; more == nil || !*more || newPts == nil || *newPts == pts {
+			return nil, nil
+		}
+
+		pts = *newPts
+
+		if id, ok := maxMessageID(history); ok {
+			maxMsgID = &id
+		}
+
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// maxMessageID returns maximum ID of messages of history page.
+func maxMessageID(history Messages_GetLongPollHistory_Response) (id int, ok bool) {
+	if history.Response.Messages == nil || history.Response.Messages.Items == nil {
+		return 0, false
+	}
+
+	for _, m := range *history.Response.Messages.Items {
+		if !ok || m.Id > id {
+			id, ok = m.Id, true
+		}
+	}
+
+	return id, ok
+}
+
+// Run polls Long Poll server and calls handler for every received event
+// until the context is cancelled or unrecoverable error occurs.
+// The "failed" codes are handled by getting new ts or key.
+// Handler is called synchronously in order of events.
+func (lp *UserLongPoll) Run(ctx context.Context, handler UserEventHandler) (ApiError, error) {
+	if apiErr, err := lp.updateServer(ctx, true); apiErr != nil || err != nil {
+		return apiErr, err
+	}
+
+	for {
+		values := make(url.Values, 6)
+
+		setString(values, "act", "a_check")
+		setString(values, "key", lp.server.Key)
+		setInt(values, "ts", lp.server.Ts)
+		setInt(values, "mode", int(lp.mode))
+		setInt(values, "version", UserLongPollVersion)
+		setLongPollWait(values, lp.wait)
+
+		lpResp, err := doLongPollReq(ctx, lp.vk.client, lp.server.Server, values)
+
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			return nil, err
+		}
+
+		switch lpResp.Failed {
+		case 0:
+		case LongPollFailedHistory:
+			if lp.server.Ts, err = strconv.Atoi(lpResp.Ts.String()); err != nil {
+				return nil, err
+			}
+			continue
+		case LongPollFailedKey:
+			if apiErr, err := lp.updateServer(ctx, false); apiErr != nil || err != nil {
+				return apiErr, err
+			}
+			continue
+		case LongPollFailedInfo:
+			prev := lp.server
+
+			if apiErr, err := lp.updateServer(ctx, true); apiErr != nil || err != nil {
+				return apiErr, err
+			}
+
+			if apiErr, err := lp.fillHistory(ctx, prev); apiErr != nil || err != nil {
+				return apiErr, err
+			}
+			continue
+		default:
+			return nil, LongPollError{
+				Failed:     lpResp.Failed,
+				MinVersion: lpResp.MinVersion,
+				MaxVersion: lpResp.MaxVersion,
+			}
+		}
+
+		if lp.server.Ts, err = strconv.Atoi(lpResp.Ts.String()); err != nil {
+			return nil, err
+		}
+
+		if lpResp.Pts != nil {
+			lp.server.Pts = lpResp.Pts
+		}
+
+		for _, update := range lpResp.Updates {
+			e, err := ParseUserEvent(update)
+
+			if err != nil {
+				return nil, err
+			}
+
+			handler(ctx, e)
+		}
+
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+}
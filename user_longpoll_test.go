@@ -0,0 +1,182 @@
+package vk_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParseUserEvent(t *testing.T) {
+	tests := []struct {
+		raw      string
+		expected UserEvent
+	}{
+		{
+			raw: `[4,10,3,2000000001,1650000000,"hi",{"from":"5"},{"attach1_type":"photo","attach1":"1_2"},7,8]`,
+			expected: UserMessageEvent{
+				userEventCode:         userEventCode(UserEventMessageNew),
+				MessageID:             10,
+				Flags:                 3,
+				PeerID:                2000000001,
+				Timestamp:             1650000000,
+				Text:                  "hi",
+				Extra:                 json.RawMessage(`{"from":"5"}`),
+				Attachments:           map[string]string{"attach1_type": "photo", "attach1": "1_2"},
+				RandomID:              7,
+				ConversationMessageID: 8,
+			},
+		},
+		{
+			raw: `[8,-5,7,1650000000]`,
+			expected: UserFriendStatusEvent{
+				userEventCode: userEventCode(UserEventFriendOnline),
+				UserID:        5,
+				Extra:         7,
+				Timestamp:     1650000000,
+			},
+		},
+		{
+			raw: `[63,[1,2],2000000001,2,1650000000]`,
+			expected: UserUsersTypingEvent{
+				userEventCode: userEventCode(UserEventUsersTyping),
+				UserIDs:       []int{1, 2},
+				PeerID:        2000000001,
+				TotalCount:    2,
+				Timestamp:     1650000000,
+			},
+		},
+		{
+			raw: `[114,{"peer_id":1,"sound":true,"disabled_until":-1}]`,
+			expected: UserNotificationSettingsEvent{
+				userEventCode: userEventCode(UserEventNotificationSettings),
+				PeerID:        1,
+				Sound:         true,
+				DisabledUntil: -1,
+			},
+		},
+		{
+			raw: `[1000,1]`,
+			expected: UserUnknownEvent{
+				userEventCode: 1000,
+				Args:          []json.RawMessage{json.RawMessage(`1`)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		e, err := ParseUserEvent([]byte(test.raw))
+		require.NoError(t, err)
+		assert.Equal(t, test.expected, e)
+	}
+
+	_, err := ParseUserEvent([]byte(`[4,"id"]`))
+	assert.Error(t, err)
+}
+
+// userLongPollAPI is fake API and Long Poll server that replies with responses in order.
+type userLongPollAPI struct {
+	mu sync.Mutex
+
+	url         string
+	servers     []string
+	lpResponses []string
+	history     []string
+
+	lpRequests      []string
+	historyRequests []string
+}
+
+func (a *userLongPollAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var body string
+
+	switch r.URL.Path {
+	case "/lp":
+		a.lpRequests = append(a.lpRequests, r.Form.Get("key")+" "+r.Form.Get("ts"))
+		body, a.lpResponses = a.lpResponses[0], a.lpResponses[1:]
+	case "/" + apiPath + "/messages.getLongPollServer":
+		body, a.servers = fmt.Sprintf(a.servers[0], a.url+"/lp"), a.servers[1:]
+	case "/" + apiPath + "/messages.getLongPollHistory":
+		a.historyRequests = append(a.historyRequests, strings.Join([]string{
+			r.PostForm.Get("ts"), r.PostForm.Get("pts"), r.PostForm.Get("max_msg_id"),
+		}, " "))
+		body, a.history = a.history[0], a.history[1:]
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	_, _ = w.Write([]byte(body))
+}
+
+func TestUserLongPoll_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api := &userLongPollAPI{
+		servers: []string{
+			`{"response":{"key":"first","server":%q,"ts":10,"pts":100}}`,
+			`{"response":{"key":"second","server":%q,"ts":15,"pts":101}}`,
+			`{"response":{"key":"third","server":%q,"ts":20,"pts":120}}`,
+		},
+		lpResponses: []string{
+			`{"failed":1,"ts":11}`,
+			`{"failed":2}`,
+			`{"failed":3}`,
+			`{"ts":21,"updates":[[8,-5,7,1650000000]]}`,
+		},
+		history: []string{
+			`{"response":{"more":true,"new_pts":105,"messages":{"count":2,"items":[{"id":9},{"id":7}]}}}`,
+			`{"response":{"new_pts":110,"messages":{"count":1,"items":[{"id":10}]}}}`,
+		},
+	}
+
+	client, serverURL := newServerTestClient(t, api)
+	api.url = serverURL
+
+	lp := NewUserLongPoll(NewVK(client, "token"), UserLongPollModePts)
+
+	var pages []int
+	lp.SetHistoryHandler(func(ctx context.Context, history Messages_GetLongPollHistory_Response) {
+		pages = append(pages, *history.Response.NewPts)
+	})
+
+	var events []UserEvent
+
+	apiErr, err := lp.Run(ctx, func(ctx context.Context, e UserEvent) {
+		events = append(events, e)
+		cancel()
+	})
+
+	assert.Nil(t, apiErr)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// ts is updated by failed=1, key is updated keeping ts by failed=2, key and ts are updated by failed=3
+	assert.Equal(t, []string{"first 10", "first 11", "second 11", "third 20"}, api.lpRequests)
+	// lost history is requested from previous ts and pts page by page
+	assert.Equal(t, []string{"11 100 ", "11 105 9"}, api.historyRequests)
+	assert.Equal(t, []int{105, 110}, pages)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, UserFriendStatusEvent{
+		userEventCode: userEventCode(UserEventFriendOnline),
+		UserID:        5,
+		Extra:         7,
+		Timestamp:     1650000000,
+	}, events[0])
+	assert.Equal(t, 21, lp.Ts())
+	assert.Equal(t, 110, *lp.Pts())
+}
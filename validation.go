@@ -0,0 +1,93 @@
+package vk_sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidationHandler passes user validation of Error_AuthValidation.
+//
+// https://dev.vk.com/api/validation-required-error
+type ValidationHandler interface {
+	// Validate opens redirectURI for user and returns access token got after the validation.
+	Validate(ctx context.Context, redirectURI string) (token string, err error)
+}
+
+// ValidationHandlerFunc is function adapter for ValidationHandler.
+type ValidationHandlerFunc func(ctx context.Context, redirectURI string) (string, error)
+
+// Validate implements ValidationHandler.
+func (f ValidationHandlerFunc) Validate(ctx context.Context, redirectURI string) (string, error) {
+	return f(ctx, redirectURI)
+}
+
+// ConfirmationHandler approves actions of Error_NeedConfirmation.
+//
+// https://dev.vk.com/api/confirmation-required-error
+type ConfirmationHandler interface {
+	// Confirm shows confirmation text to user and returns true if user approves the action.
+	Confirm(ctx context.Context, text string) (bool, error)
+}
+
+// ConfirmationHandlerFunc is function adapter for ConfirmationHandler.
+type ConfirmationHandlerFunc func(ctx context.Context, text string) (bool, error)
+
+// Confirm implements ConfirmationHandler.
+func (f ConfirmationHandlerFunc) Confirm(ctx context.Context, text string) (bool, error) {
+	return f(ctx, text)
+}
+
+// SetValidationHandler set handler that is called when method returns Error_AuthValidation.
+// The token returned by handler replaces VK token and the method is sent again once.
+// Pass nil to return Error_AuthValidation to the caller.
+func (vk *VK) SetValidationHandler(h ValidationHandler) {
+	vk.validationHandler = h
+}
+
+// SetConfirmationHandler set handler that is called when method returns Error_NeedConfirmation.
+// If handler approves the confirmation text, the method is sent again once with confirm=1.
+// Pass nil to return Error_NeedConfirmation to the caller.
+func (vk *VK) SetConfirmationHandler(h ConfirmationHandler) {
+	vk.confirmationHandler = h
+}
+
+// validate passes validation of apiErr and sets new token to VK and the invocation.
+func (vk *VK) validate(ctx context.Context, inv *Invocation, apiErr ApiError) error {
+	redirectURI := apiErr.RedirectURI()
+
+	if redirectURI == nil {
+		return fmt.Errorf("%s: validation is required but redirect_uri is not provided", inv.Method)
+	}
+
+	token, err := vk.validationHandler.Validate(ctx, *redirectURI)
+
+	if err != nil {
+		return fmt.Errorf("%s: validate: %w", inv.Method, err)
+	}
+
+	vk.SetToken(token)
+	inv.Values.Set(tokenKey, token)
+
+	return nil
+}
+
+// confirm asks for confirmation of apiErr and sets confirm parameter to the invocation if it is approved.
+func (vk *VK) confirm(ctx context.Context, inv *Invocation, apiErr ApiError) (bool, error) {
+	var text string
+
+	if confirmationText := apiErr.ConfirmationText(); confirmationText != nil {
+		text = *confirmationText
+	}
+
+	ok, err := vk.confirmationHandler.Confirm(ctx, text)
+
+	if err != nil {
+		return false, fmt.Errorf("%s: confirm: %w", inv.Method, err)
+	}
+
+	if ok {
+		inv.Values.Set("confirm", "1")
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,159 @@
+package vk_sdk
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// newSequenceTestClient returns client that replies with responses in order
+// and passes request values of every attempt to check.
+func newSequenceTestClient(t *testing.T, responses []string, check func(attempt int, values url.Values)) *http.Client {
+	var attempt int
+
+	return &http.Client{
+		Transport: TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+			reqBody, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			values, err := url.ParseQuery(string(reqBody))
+			require.NoError(t, err)
+
+			check(attempt, values)
+
+			require.Less(t, attempt, len(responses))
+			body := responses[attempt]
+			attempt++
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		}),
+	}
+}
+
+func TestVK_SetValidationHandler(t *testing.T) {
+	responses := []string{
+		`{"error_code":17,"error_msg":"Validation required","redirect_uri":"https://oauth.vk.com/validate"}`,
+		`{"response":[{"id":1}]}`,
+	}
+
+	client := newSequenceTestClient(t, responses, func(attempt int, values url.Values) {
+		if attempt == 0 {
+			assert.Equal(t, "old", values.Get(tokenKey))
+		} else {
+			assert.Equal(t, "new", values.Get(tokenKey))
+		}
+	})
+
+	vk := NewVK(client, "old")
+	vk.SetValidationHandler(ValidationHandlerFunc(func(ctx context.Context, redirectURI string) (string, error) {
+		assert.Equal(t, "https://oauth.vk.com/validate", redirectURI)
+		return "new", nil
+	}))
+
+	resp, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+	require.NoError(t, err)
+	require.Nil(t, apiErr)
+	require.Len(t, resp.Response, 1)
+	assert.Equal(t, "new", vk.getToken())
+}
+
+func TestVK_SetValidationHandler_Concurrent(t *testing.T) {
+	transport := TestRoundTrip(func(req *http.Request) (*http.Response, error) {
+		reqBody, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		values, err := url.ParseQuery(string(reqBody))
+		require.NoError(t, err)
+
+		body := `{"response":[{"id":1}]}`
+		if values.Get(tokenKey) == "old" {
+			body = `{"error_code":17,"error_msg":"Validation required","redirect_uri":"https://oauth.vk.com/validate"}`
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		}, nil
+	})
+
+	vk := NewVK(&http.Client{Transport: transport}, "old")
+	vk.SetValidationHandler(ValidationHandlerFunc(func(ctx context.Context, redirectURI string) (string, error) {
+		return "new", nil
+	}))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, apiErr, err := vk.Users_Get(context.Background(), Users_Get_Request{})
+			assert.NoError(t, err)
+			assert.Nil(t, apiErr)
+			assert.Len(t, resp.Response, 1)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, "new", vk.getToken())
+}
+
+func TestVK_SetConfirmationHandler(t *testing.T) {
+	const confirmationErr = `{"error_code":24,"error_msg":"Confirmation required","confirmation_text":"Are you sure?"}`
+
+	tests := []struct {
+		name      string
+		approve   bool
+		responses []string
+	}{
+		{
+			name:      "Approved",
+			approve:   true,
+			responses: []string{confirmationErr, `{"response":1}`},
+		},
+		{
+			name:      "Declined",
+			approve:   false,
+			responses: []string{confirmationErr},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newSequenceTestClient(t, test.responses, func(attempt int, values url.Values) {
+				if attempt == 0 {
+					assert.Empty(t, values.Get("confirm"))
+				} else {
+					assert.Equal(t, "1", values.Get("confirm"))
+				}
+			})
+
+			vk := NewVK(client)
+			vk.SetConfirmationHandler(ConfirmationHandlerFunc(func(ctx context.Context, text string) (bool, error) {
+				assert.Equal(t, "Are you sure?", text)
+				return test.approve, nil
+			}))
+
+			_, apiErr, err := vk.Groups_Leave(context.Background(), Groups_Leave_Request{GroupId: 1})
+			require.NoError(t, err)
+
+			if test.approve {
+				assert.Nil(t, apiErr)
+			} else if assert.NotNil(t, apiErr) {
+				assert.True(t, apiErr.Is(Error_NeedConfirmation))
+			}
+		})
+	}
+}
@@ -3,10 +3,9 @@ package vk_sdk
 import (
 	"bytes"
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
 type ApiError interface {
@@ -45,8 +44,28 @@ type Captcha interface {
 
 // VK the main structure for calling requests to the API
 type VK struct {
-	client *http.Client
-	token  string
+	client    *http.Client
+	coalescer *coalescer
+	retry     *RetryPolicy
+
+	captchaSolver   CaptchaSolver
+	captchaAttempts int
+
+	validationHandler   ValidationHandler
+	confirmationHandler ConfirmationHandler
+
+	middlewares []Middleware
+	invoker     Invoker
+
+	validateRequests bool
+
+	decodeMode   DecodeMode
+	driftHandler DriftHandler
+	driftReport  *DriftReport
+
+	// token may be replaced by validation handler while other requests are sent
+	tokenMu sync.RWMutex
+	token   string
 }
 
 // NewVK create and return new VK
@@ -62,12 +81,48 @@ func NewVK(client *http.Client, token ...string) *VK {
 	return &vk
 }
 
-// SetToken set access token
+// SetToken set access token. It is safe to call concurrently with method calls.
 func (vk *VK) SetToken(token string) {
+	vk.tokenMu.Lock()
 	vk.token = token
+	vk.tokenMu.Unlock()
+}
+
+// getToken returns access token of VK.
+func (vk *VK) getToken() string {
+	vk.tokenMu.RLock()
+	defer vk.tokenMu.RUnlock()
+
+	return vk.token
 }
 
 func (vk *VK) doReq(methodName string, ctx context.Context, values url.Values, dst interface{}) (ApiError, error) {
+	inv := &Invocation{
+		Method: methodName,
+		Values: values,
+		Dst:    dst,
+	}
+
+	if vk.invoker == nil {
+		return vk.invoke(ctx, inv)
+	}
+
+	return vk.invoker(ctx, inv)
+}
+
+// send sends request to API repeating it by retry policy, if set.
+func (vk *VK) send(methodName string, ctx context.Context, values url.Values, dst interface{}) (ApiError, error) {
+	if vk.retry != nil {
+		return vk.retry.do(ctx, func() (ApiError, error) {
+			return vk.sendReq(methodName, ctx, values, dst)
+		})
+	}
+
+	return vk.sendReq(methodName, ctx, values, dst)
+}
+
+// sendReq sends single request to API and parses response to dst.
+func (vk *VK) sendReq(methodName string, ctx context.Context, values url.Values, dst interface{}) (ApiError, error) {
 	req, err := vk.buildRequest(methodName, ctx, values)
 
 	if err != nil {
@@ -80,7 +135,7 @@ func (vk *VK) doReq(methodName string, ctx context.Context, values url.Values, d
 		return nil, err
 	}
 
-	return vk.parseResponse(resp, dst)
+	return vk.parseResponse(methodName, resp, dst)
 }
 
 const (
@@ -95,7 +150,11 @@ const (
 // buildRequest build request to Vkontakte API with version and access token
 func (vk *VK) buildRequest(methodName string, ctx context.Context, values url.Values) (*http.Request, error) {
 	values.Set(versionKey, Version)
-	values.Set(tokenKey, vk.token)
+
+	// token may be set by middleware
+	if _, ok := values[tokenKey]; !ok {
+		values.Set(tokenKey, vk.getToken())
+	}
 
 	reqBody := bytes.NewBufferString(values.Encode())
 
@@ -115,30 +174,3 @@ func (vk *VK) buildRequest(methodName string, ctx context.Context, values url.Va
 
 	return req, nil
 }
-
-// parseResponse parse response and returns Error if present
-func (vk *VK) parseResponse(resp *http.Response, dst interface{}) (ApiErr ApiError, err error) {
-	defer func() {
-		if closeErr := resp.Body.Close(); err == nil {
-			err = closeErr
-		}
-	}()
-
-	respBody, err := io.ReadAll(resp.Body)
-
-	if err != nil {
-		return nil, err
-	}
-
-	var apiErr apiError
-
-	if err = json.Unmarshal(respBody, &apiErr); apiErr.ErrorCode != 0 || err != nil {
-		return &apiErr, err
-	}
-
-	if err = json.Unmarshal(respBody, &dst); err != nil {
-		return nil, err
-	}
-
-	return nil, nil
-}